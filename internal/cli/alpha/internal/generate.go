@@ -565,6 +565,24 @@ func getDeployImageOptions(resourceData deployimagev1alpha1.ResourceData) []stri
 	if resourceData.Options.RunAsUser != "" {
 		args = append(args, fmt.Sprintf("--run-as-user=%s", resourceData.Options.RunAsUser))
 	}
+	for _, env := range resourceData.Options.ContainerEnv {
+		args = append(args, fmt.Sprintf("--image-container-env=%s", env))
+	}
+	for _, volume := range resourceData.Options.ContainerVolumes {
+		args = append(args, fmt.Sprintf("--image-container-volume=%s", volume))
+	}
+	if resourceData.Options.LivenessProbe != "" {
+		args = append(args, fmt.Sprintf("--image-container-liveness-probe=%s", resourceData.Options.LivenessProbe))
+	}
+	if resourceData.Options.ReadinessProbe != "" {
+		args = append(args, fmt.Sprintf("--image-container-readiness-probe=%s", resourceData.Options.ReadinessProbe))
+	}
+	if resourceData.Options.ContainerResources != "" {
+		args = append(args, fmt.Sprintf("--image-container-resources=%s", resourceData.Options.ContainerResources))
+	}
+	for _, sidecar := range resourceData.Options.Sidecars {
+		args = append(args, fmt.Sprintf("--image-container-sidecar=%s", sidecar))
+	}
 	args = append(args, fmt.Sprintf("--plugins=%s", plugin.KeyFor(deployimagev1alpha1.Plugin{})))
 	return args
 }
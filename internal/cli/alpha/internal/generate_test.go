@@ -514,6 +514,29 @@ var _ = Describe("generate: get-args-helpers", func() {
 				"--run-as-user="+fixtureTest,
 				"--plugins=deploy-image.go.kubebuilder.io/v1-alpha"))
 		})
+
+		It("returns one flag for each container env var, volume and sidecar", func() {
+			rd := deployimagev1alpha1.ResourceData{}
+			rd.Options.Image = "memcached:1.6.26-alpine3.19"
+			rd.Options.ContainerEnv = []string{"MEMCACHED_THREADS=4", "GREETING=hello world"}
+			rd.Options.ContainerVolumes = []string{"configmap:memcached-config:/etc/memcached"}
+			rd.Options.LivenessProbe = "tcp:11211"
+			rd.Options.ReadinessProbe = "exec:cat,/tmp/ready"
+			rd.Options.ContainerResources = "requests.cpu=100m,limits.memory=128Mi"
+			rd.Options.Sidecars = []string{"exporter=prom/memcached-exporter:v0.14.2"}
+			opts := getDeployImageOptions(rd)
+			Expect(opts).To(Equal([]string{
+				"--image=memcached:1.6.26-alpine3.19",
+				"--image-container-env=MEMCACHED_THREADS=4",
+				"--image-container-env=GREETING=hello world",
+				"--image-container-volume=configmap:memcached-config:/etc/memcached",
+				"--image-container-liveness-probe=tcp:11211",
+				"--image-container-readiness-probe=exec:cat,/tmp/ready",
+				"--image-container-resources=requests.cpu=100m,limits.memory=128Mi",
+				"--image-container-sidecar=exporter=prom/memcached-exporter:v0.14.2",
+				"--plugins=deploy-image.go.kubebuilder.io/v1-alpha",
+			}))
+		})
 	})
 
 	// getAPIResourceFlags
@@ -0,0 +1,430 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconstruct
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// sourceFile is a parsed Go file of the project.
+type sourceFile struct {
+	path string
+	// pkgPath is the import path of the package of the file.
+	pkgPath string
+	ast     *ast.File
+	// imports maps the names under which the file refers to its imports to their paths.
+	imports map[string]string
+}
+
+// setup is a Go type that a function of a source file sets up a controller or a webhook for.
+type setup struct {
+	key typeKey
+	// name is the name given to the controller, if any.
+	name string
+}
+
+// goFiles returns the non-test Go files under root that are accepted by include, grouped by directory, and
+// the directories in lexical order. A missing root has no files.
+func (s *scanner) goFiles(root string, include func(name string) bool) (map[string][]string, []string, error) {
+	exists, err := afero.DirExists(s.fs, root)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check %s: %w", root, err)
+	}
+	if !exists {
+		return nil, nil, nil
+	}
+
+	files := map[string][]string{}
+	var dirs []string
+	err = afero.Walk(s.fs, root, func(file string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || !include(name) {
+			return nil
+		}
+
+		dir := filepath.Dir(file)
+		if _, found := files[dir]; !found {
+			dirs = append(dirs, dir)
+		}
+		files[dir] = append(files[dir], file)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to walk %s: %w", root, err)
+	}
+	slices.Sort(dirs)
+	return files, dirs, nil
+}
+
+// parseSources parses the non-test Go files under root that are accepted by include.
+func (s *scanner) parseSources(root string, include func(name string) bool) ([]sourceFile, error) {
+	files, dirs, err := s.goFiles(root, include)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []sourceFile
+	for _, dir := range dirs {
+		for _, file := range files[dir] {
+			f, parseErr := s.parseFile(file)
+			if parseErr != nil {
+				return nil, parseErr
+			}
+			sources = append(sources, sourceFile{
+				path:    file,
+				pkgPath: path.Join(s.repo, filepath.ToSlash(dir)),
+				ast:     f,
+				imports: importNames(f),
+			})
+		}
+	}
+	return sources, nil
+}
+
+// parseFile parses a Go file of the project with its comments.
+func (s *scanner) parseFile(file string) (*ast.File, error) {
+	content, err := afero.ReadFile(s.fs, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), file, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return f, nil
+}
+
+// importNames maps the names under which the file refers to its imports to their paths.
+func importNames(f *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// markers returns the markers of the comment groups, such as kubebuilder:object:root=true, without the
+// leading "+".
+func markers(groups ...*ast.CommentGroup) []string {
+	var found []string
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		for _, comment := range group.List {
+			text, isLine := strings.CutPrefix(comment.Text, "//")
+			if marker, isMarker := strings.CutPrefix(strings.TrimSpace(text), "+"); isLine && isMarker {
+				found = append(found, marker)
+			}
+		}
+	}
+	return found
+}
+
+// markerArgs returns the arguments of the marker if it has the provided name, such as path=admirales and
+// scope=Cluster for kubebuilder:resource:path=admirales,scope=Cluster.
+func markerArgs(marker, name string) (map[string]string, bool) {
+	rest, found := strings.CutPrefix(marker, name)
+	if !found || (rest != "" && rest[0] != ':') {
+		return nil, false
+	}
+
+	args := map[string]string{}
+	if rest == "" {
+		return args, true
+	}
+	for _, arg := range strings.Split(rest[1:], ",") {
+		key, value, _ := strings.Cut(arg, "=")
+		args[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	return args, true
+}
+
+// rootTypes returns the API kinds of the root types of the file, leaving out the lists.
+func rootTypes(f *ast.File) []*apiKind {
+	var kinds []*apiKind
+	prevEnd := f.Name.End()
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		declStart, declEnd := decl.Pos(), decl.End()
+		if ok && gen.Doc != nil {
+			declStart = gen.Doc.Pos()
+		}
+		if !ok || gen.Tok != token.TYPE {
+			prevEnd = declEnd
+			continue
+		}
+
+		// Scaffolded markers are separated from the doc comment by a blank line, so the comment groups
+		// between the previous declaration and this one are read as well
+		var declMarkers []string
+		for _, group := range f.Comments {
+			if group.Pos() > prevEnd && group.End() < declStart {
+				declMarkers = append(declMarkers, markers(group)...)
+			}
+		}
+		declMarkers = append(declMarkers, markers(gen.Doc)...)
+		prevEnd = declEnd
+
+		for _, spec := range gen.Specs {
+			typeSpec, isType := spec.(*ast.TypeSpec)
+			if !isType {
+				continue
+			}
+			typeMarkers := append(slices.Clone(declMarkers), markers(typeSpec.Doc)...)
+			if !isRootType(typeMarkers) || isListType(typeSpec) {
+				continue
+			}
+
+			kind := &apiKind{name: typeSpec.Name.Name, plural: resource.RegularPlural(typeSpec.Name.Name)}
+			for _, marker := range typeMarkers {
+				if marker == "genclient" {
+					kind.ssa = true
+				}
+				if args, found := markerArgs(marker, "kubebuilder:resource"); found {
+					if args["path"] != "" {
+						kind.plural = args["path"]
+					}
+					kind.clusterScoped = args["scope"] == "Cluster"
+				}
+			}
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// isRootType returns true if the markers make a type the root type of an API.
+func isRootType(typeMarkers []string) bool {
+	return slices.Contains(typeMarkers, "kubebuilder:object:root=true") ||
+		slices.Contains(typeMarkers, "kubebuilder:object:root")
+}
+
+// isListType returns true for the root types that hold a list of objects in their Items field.
+func isListType(typeSpec *ast.TypeSpec) bool {
+	structType, ok := typeSpec.Type.(*ast.StructType)
+	if !ok {
+		return false
+	}
+	return slices.ContainsFunc(structType.Fields.List, func(field *ast.Field) bool {
+		return slices.ContainsFunc(field.Names, func(name *ast.Ident) bool { return name.Name == "Items" })
+	})
+}
+
+// groupVersionGroup returns the group of a schema.GroupVersion literal of the file, if any.
+func groupVersionGroup(f *ast.File) string {
+	var group string
+	ast.Inspect(f, func(node ast.Node) bool {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok || group != "" {
+			return group == ""
+		}
+		if typ, isSelector := lit.Type.(*ast.SelectorExpr); !isSelector || typ.Sel.Name != "GroupVersion" {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			keyValue, isKeyValue := elt.(*ast.KeyValueExpr)
+			if !isKeyValue {
+				continue
+			}
+			key, isIdent := keyValue.Key.(*ast.Ident)
+			value, isLit := keyValue.Value.(*ast.BasicLit)
+			if isIdent && isLit && key.Name == "Group" && value.Kind == token.STRING {
+				group, _ = strconv.Unquote(value.Value)
+			}
+		}
+		return false
+	})
+	return group
+}
+
+// methodReceivers returns the names of the types that declare a method with the provided name in the file.
+func methodReceivers(f *ast.File, name string) []string {
+	var receivers []string
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if ok && fn.Name.Name == name {
+			if receiver := receiverType(fn); receiver != "" {
+				receivers = append(receivers, receiver)
+			}
+		}
+	}
+	return receivers
+}
+
+// receiverType returns the name of the type of the receiver of a method, or an empty string for functions.
+func receiverType(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	typ := fn.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// setups returns the types that the functions of the file set up controllers or webhooks for, as the object
+// arguments of calls such as For(&crewv1.Captain{}). Only the functions with the provided name are read,
+// unless it is empty.
+func (file sourceFile) setups(funcName string, callNames ...string) []setup {
+	var found []setup
+	for _, decl := range file.ast.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || (funcName != "" && fn.Name.Name != funcName) {
+			continue
+		}
+
+		var receiverName string
+		if fn.Recv != nil && len(fn.Recv.List) > 0 && len(fn.Recv.List[0].Names) > 0 {
+			receiverName = fn.Recv.List[0].Names[0].Name
+		}
+
+		var current setup
+		for _, call := range calls(fn.Body, callNames...) {
+			for _, arg := range call.Args {
+				if key, isObject := file.objectType(arg, receiverName, receiverType(fn)); isObject {
+					current.key = key
+					break
+				}
+			}
+			if current.key.kind != "" {
+				break
+			}
+		}
+		if current.key.kind == "" {
+			continue
+		}
+
+		for _, call := range calls(fn.Body, "Named") {
+			if len(call.Args) != 1 {
+				continue
+			}
+			if lit, isLit := call.Args[0].(*ast.BasicLit); isLit && lit.Kind == token.STRING {
+				current.name, _ = strconv.Unquote(lit.Value)
+			}
+		}
+		found = append(found, current)
+	}
+	return found
+}
+
+// objectType returns the type of an object argument, such as &crewv1.Captain{}, or the receiver of the
+// method when the argument is the receiver itself.
+func (file sourceFile) objectType(arg ast.Expr, receiverName, receiverKind string) (typeKey, bool) {
+	if ident, ok := arg.(*ast.Ident); ok && receiverName != "" && ident.Name == receiverName {
+		return typeKey{path: file.pkgPath, kind: receiverKind}, receiverKind != ""
+	}
+
+	unary, ok := arg.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return typeKey{}, false
+	}
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return typeKey{}, false
+	}
+
+	switch typ := lit.Type.(type) {
+	case *ast.SelectorExpr:
+		pkg, isIdent := typ.X.(*ast.Ident)
+		if !isIdent {
+			return typeKey{}, false
+		}
+		importPath, found := file.imports[pkg.Name]
+		return typeKey{path: importPath, kind: typ.Sel.Name}, found
+	case *ast.Ident:
+		return typeKey{path: file.pkgPath, kind: typ.Name}, true
+	default:
+		return typeKey{}, false
+	}
+}
+
+// calls returns the calls in node of the functions or methods with any of the provided names, in order.
+func calls(node ast.Node, names ...string) []*ast.CallExpr {
+	var found []*ast.CallExpr
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var name string
+		switch fn := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			name = fn.Sel.Name
+		case *ast.Ident:
+			name = fn.Name
+		}
+		if slices.Contains(names, name) {
+			found = append(found, call)
+		}
+		return true
+	})
+	// Chained calls are visited from the last one, so they are sorted back into source order
+	slices.SortFunc(found, func(a, b *ast.CallExpr) int { return int(a.Pos() - b.Pos()) })
+	return found
+}
+
+// groupHints maps the plurals named by the RBAC and webhook markers of the files to their qualified groups.
+// The group of the first marker that names a plural with a single group is kept.
+func groupHints(files []sourceFile) map[string]string {
+	hints := map[string]string{}
+	for _, file := range files {
+		for _, marker := range markers(file.ast.Comments...) {
+			args, found := markerArgs(marker, "kubebuilder:rbac")
+			if !found {
+				args, found = markerArgs(marker, "kubebuilder:webhook")
+			}
+			if !found || args["groups"] == "" || strings.Contains(args["groups"], ";") {
+				continue
+			}
+			for _, plural := range strings.Split(args["resources"], ";") {
+				if _, known := hints[plural]; !known && plural != "" && !strings.Contains(plural, "/") {
+					hints[plural] = args["groups"]
+				}
+			}
+		}
+	}
+	return hints
+}
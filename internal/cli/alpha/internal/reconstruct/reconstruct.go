@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconstruct
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// Reconstruct contains the options to rebuild the PROJECT file of a project from its code.
+type Reconstruct struct {
+	// InputDir is the root directory of the project. Defaults to the current working directory.
+	InputDir string
+	// Force overwrites an existing PROJECT file.
+	Force bool
+}
+
+// Validate checks that the input directory holds a Go module and that its PROJECT file can be written.
+func (opts *Reconstruct) Validate() error {
+	if opts.InputDir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get working directory: %w", err)
+		}
+		opts.InputDir = cwd
+	}
+
+	if _, err := os.Stat(filepath.Join(opts.InputDir, goModFile)); err != nil {
+		return fmt.Errorf("no Go module found in %q: %w", opts.InputDir, err)
+	}
+
+	projectPath := filepath.Join(opts.InputDir, yaml.DefaultPath)
+	// The link is not followed, so that a link is never replaced by a regular file.
+	info, err := os.Lstat(projectPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to check %q: %w", projectPath, err)
+	case !opts.Force:
+		return fmt.Errorf("%q already exists; use --force to overwrite it", projectPath)
+	case !info.Mode().IsRegular():
+		return fmt.Errorf("%q is not a regular file and cannot be overwritten", projectPath)
+	}
+	return nil
+}
+
+// Reconstruct writes the PROJECT file rebuilt from the code of the project, and returns the values that
+// could not be read from the code and had to be guessed.
+func (opts *Reconstruct) Reconstruct() ([]Guess, error) {
+	fs := afero.NewBasePathFs(afero.NewOsFs(), opts.InputDir)
+
+	projectConfig := yaml.New(machinery.Filesystem{FS: fs})
+	if err := projectConfig.New(cfgv3.Version); err != nil {
+		return nil, fmt.Errorf("failed to create the project configuration: %w", err)
+	}
+
+	guesses, err := Scan(fs, projectConfig.Config())
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct the project configuration: %w", err)
+	}
+
+	// The existing file is only removed once the new configuration could be built
+	if opts.Force {
+		if err = fs.Remove(yaml.DefaultPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to remove the existing PROJECT file: %w", err)
+		}
+	}
+	if err = projectConfig.Save(); err != nil {
+		return nil, fmt.Errorf("failed to write the PROJECT file: %w", err)
+	}
+	return guesses, nil
+}
+
+// PrintReport writes the confidence report of a reconstructed PROJECT file, which lists the values that
+// had to be guessed.
+func PrintReport(w io.Writer, guesses []Guess) {
+	_, _ = fmt.Fprintf(w, "Confidence report: %d value(s) could not be read from the code and were guessed.\n",
+		len(guesses))
+	for _, guess := range guesses {
+		value := guess.Value
+		if value == "" {
+			value = "(unset)"
+		}
+		_, _ = fmt.Fprintf(w, "  - %s: %s\n      %s\n", guess.Field, value, guess.Reason)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconstruct
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ = Describe("Reconstruct", func() {
+	var (
+		dir         string
+		projectPath string
+		opts        Reconstruct
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		projectPath = filepath.Join(dir, yaml.DefaultPath)
+		opts = Reconstruct{InputDir: dir}
+
+		writeFiles(afero.NewBasePathFs(afero.NewOsFs(), dir), map[string]string{
+			goModFile:                     goModContent,
+			mainFile:                      mainContent,
+			"api/v1/groupversion_info.go": groupVersionContent,
+			"api/v1/guestbook_types.go":   typesContent,
+		})
+	})
+
+	Context("Validate", func() {
+		It("should succeed for a project without a PROJECT file", func() {
+			Expect(opts.Validate()).To(Succeed())
+		})
+
+		It("should fail for a directory without a go.mod file", func() {
+			Expect(os.Remove(filepath.Join(dir, goModFile))).To(Succeed())
+			Expect(opts.Validate()).To(MatchError(ContainSubstring("no Go module found")))
+		})
+
+		It("should only accept an existing PROJECT file with --force", func() {
+			Expect(os.WriteFile(projectPath, []byte("version: [\n"), 0o644)).To(Succeed())
+			Expect(opts.Validate()).To(MatchError(ContainSubstring("use --force to overwrite it")))
+
+			opts.Force = true
+			Expect(opts.Validate()).To(Succeed())
+		})
+
+		It("should not overwrite a PROJECT path that is not a regular file", func() {
+			Expect(os.Mkdir(projectPath, 0o755)).To(Succeed())
+			opts.Force = true
+			Expect(opts.Validate()).To(MatchError(ContainSubstring("is not a regular file")))
+		})
+	})
+
+	Context("Reconstruct", func() {
+		It("should write a PROJECT file that can be loaded", func() {
+			Expect(opts.Validate()).To(Succeed())
+			guesses, err := opts.Reconstruct()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(guesses).NotTo(BeEmpty())
+
+			projectConfig := yaml.New(machinery.Filesystem{FS: afero.NewOsFs()})
+			Expect(projectConfig.LoadFrom(projectPath)).To(Succeed())
+			Expect(projectConfig.Config().GetDomain()).To(Equal("my.domain"))
+			Expect(projectConfig.Config().ResourcesLength()).To(Equal(1))
+		})
+
+		It("should overwrite a broken PROJECT file with --force", func() {
+			Expect(os.WriteFile(projectPath, []byte("version: [\n"), 0o644)).To(Succeed())
+			opts.Force = true
+
+			_, err := opts.Reconstruct()
+			Expect(err).NotTo(HaveOccurred())
+
+			projectConfig := yaml.New(machinery.Filesystem{FS: afero.NewOsFs()})
+			Expect(projectConfig.LoadFrom(projectPath)).To(Succeed())
+		})
+
+		It("should keep the existing PROJECT file when the code cannot be read", func() {
+			Expect(os.WriteFile(projectPath, []byte("version: [\n"), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "api", "v1", "broken_types.go"),
+				[]byte("package v1\n\ntype Broken struct {\n"), 0o644)).To(Succeed())
+			opts.Force = true
+
+			_, err := opts.Reconstruct()
+			Expect(err).To(MatchError(ContainSubstring("failed to parse")))
+			Expect(os.ReadFile(projectPath)).To(Equal([]byte("version: [\n")))
+		})
+	})
+})
+
+var _ = Describe("PrintReport", func() {
+	It("should list the guessed values", func() {
+		var out bytes.Buffer
+		PrintReport(&out, []Guess{
+			{Field: "layout", Value: defaultLayout, Reason: "not recorded"},
+			{Field: "cliVersion", Reason: "not recorded either"},
+		})
+
+		Expect(out.String()).To(ContainSubstring("2 value(s) could not be read"))
+		Expect(out.String()).To(ContainSubstring("  - layout: go.kubebuilder.io/v4\n      not recorded\n"))
+		Expect(out.String()).To(ContainSubstring("  - cliVersion: (unset)\n"))
+	})
+})
@@ -0,0 +1,664 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconstruct
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"golang.org/x/mod/modfile"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+)
+
+const (
+	goModFile     = "go.mod"
+	mainFile      = "cmd/main.go"
+	apiDir        = "api"
+	controllerDir = "internal/controller"
+	webhookDir    = "internal/webhook"
+
+	// defaultLayout is the plugin chain of the projects initialized with the default options.
+	defaultLayout = "go.kubebuilder.io/v4"
+	// corePathPrefix is the import path prefix of the packages of the Kubernetes API.
+	corePathPrefix = "k8s.io/api/"
+	// k8sIODomain is the domain of the groups of the Kubernetes API that are not in the legacy domain.
+	k8sIODomain = "k8s.io"
+	// watchNamespaceEnvVar is only read by the manager of namespace-scoped projects.
+	watchNamespaceEnvVar = "WATCH_NAMESPACE"
+)
+
+// leaderElectionIDRegexp matches the leader election ID scaffolded in the entrypoint of the manager, and
+// captures its domain.
+var leaderElectionIDRegexp = regexp.MustCompile(`LeaderElectionID:\s*"[0-9a-f]{8}\.([^"]+)"`)
+
+// Guess describes a value of the reconstructed PROJECT file that could not be read from the code.
+type Guess struct {
+	// Field identifies the PROJECT file field that holds the value.
+	Field string
+	// Value is the value that was written.
+	Value string
+	// Reason explains why the value had to be guessed.
+	Reason string
+}
+
+// typeKey identifies a Go type by its package path and name.
+type typeKey struct {
+	path string
+	kind string
+}
+
+// apiPackage holds the APIs found in a package under the api directory.
+type apiPackage struct {
+	dir        string
+	importPath string
+	version    string
+	// groupDir is the group directory of multi-group layouts, empty for single-group ones.
+	groupDir string
+	// qualifiedGroup is the API group, such as crew.testproject.org.
+	qualifiedGroup string
+	kinds          []*apiKind
+}
+
+// apiKind holds what the markers and methods of a root type tell about its API.
+type apiKind struct {
+	name          string
+	plural        string
+	clusterScoped bool
+	ssa           bool
+	hub           bool
+	spoke         bool
+}
+
+// scanner reads the layout, the markers and the Go code of a project.
+type scanner struct {
+	fs afero.Fs
+
+	repo string
+	// requires maps the modules required in go.mod to their versions.
+	requires map[string]string
+
+	// main holds the entrypoint of the manager, or is empty if it was not found.
+	main string
+
+	packages []*apiPackage
+	domain   string
+
+	// resources are keyed by the package path and name of their Go type, in the order they were found.
+	resources map[typeKey]*resource.Resource
+	order     []typeKey
+	// groupGuessed holds the resources whose group and domain were not read from any marker.
+	groupGuessed map[typeKey]bool
+
+	guesses []Guess
+}
+
+// Scan reads the project in fs and stores what it finds in cfg. It returns the values that could not be
+// read from the code and had to be guessed, so that they can be reviewed.
+func Scan(fs afero.Fs, cfg config.Config) ([]Guess, error) {
+	s := &scanner{
+		fs:           fs,
+		resources:    map[typeKey]*resource.Resource{},
+		groupGuessed: map[typeKey]bool{},
+	}
+
+	if err := s.readGoMod(); err != nil {
+		return nil, err
+	}
+	if err := s.readMain(); err != nil {
+		return nil, err
+	}
+	if err := s.scanAPIs(); err != nil {
+		return nil, err
+	}
+	s.addAPIResources()
+
+	controllers, err := s.parseSources(controllerDir, func(string) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.parseSources(apiDir, isWebhookFile)
+	if err != nil {
+		return nil, err
+	}
+	internalWebhooks, err := s.parseSources(webhookDir, isWebhookFile)
+	if err != nil {
+		return nil, err
+	}
+	webhooks = append(webhooks, internalWebhooks...)
+
+	hints := groupHints(slices.Concat(controllers, webhooks))
+	s.scanControllers(controllers, hints)
+	s.scanWebhooks(webhooks, hints)
+	s.shareExternalGroups()
+
+	if err = s.store(cfg); err != nil {
+		return nil, err
+	}
+	return s.guesses, nil
+}
+
+// isWebhookFile returns true for the files that hold the webhooks of a kind.
+func isWebhookFile(name string) bool {
+	return strings.HasSuffix(name, "_webhook.go")
+}
+
+// guess records a value that had to be guessed.
+func (s *scanner) guess(field, value, reason string) {
+	s.guesses = append(s.guesses, Guess{Field: field, Value: value, Reason: reason})
+}
+
+// readGoMod reads the repository and the required modules from the go.mod file.
+func (s *scanner) readGoMod() error {
+	content, err := afero.ReadFile(s.fs, goModFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", goModFile, err)
+	}
+	modFile, err := modfile.ParseLax(goModFile, content, nil)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", goModFile, err)
+	}
+	if modFile.Module == nil || modFile.Module.Mod.Path == "" {
+		return fmt.Errorf("no module is declared in %s", goModFile)
+	}
+
+	s.repo = modFile.Module.Mod.Path
+	s.requires = make(map[string]string, len(modFile.Require))
+	for _, require := range modFile.Require {
+		s.requires[require.Mod.Path] = require.Mod.Version
+	}
+	return nil
+}
+
+// scanAPIs finds the root types of the packages under the api directory.
+func (s *scanner) scanAPIs() error {
+	files, dirs, err := s.goFiles(apiDir, func(name string) bool {
+		return !strings.HasPrefix(name, "zz_generated")
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		pkg := &apiPackage{
+			dir:        dir,
+			importPath: path.Join(s.repo, filepath.ToSlash(dir)),
+			version:    filepath.Base(dir),
+		}
+		if elements := strings.Split(filepath.ToSlash(dir), "/"); len(elements) > 2 {
+			pkg.groupDir = elements[1]
+		}
+
+		var literalGroup string
+		var hubs, spokes []string
+		for _, file := range files[dir] {
+			f, parseErr := s.parseFile(file)
+			if parseErr != nil {
+				return parseErr
+			}
+			for _, marker := range markers(f.Doc) {
+				if group, found := strings.CutPrefix(marker, "groupName="); found {
+					pkg.qualifiedGroup = group
+				}
+			}
+			if group := groupVersionGroup(f); group != "" {
+				literalGroup = group
+			}
+			pkg.kinds = append(pkg.kinds, rootTypes(f)...)
+			hubs = append(hubs, methodReceivers(f, "Hub")...)
+			spokes = append(spokes, methodReceivers(f, "ConvertTo")...)
+		}
+		if len(pkg.kinds) == 0 {
+			continue
+		}
+
+		for _, kind := range pkg.kinds {
+			kind.hub = slices.Contains(hubs, kind.name)
+			kind.spoke = slices.Contains(spokes, kind.name)
+		}
+
+		if pkg.qualifiedGroup == "" {
+			pkg.qualifiedGroup = literalGroup
+		}
+		if pkg.qualifiedGroup == "" {
+			pkg.qualifiedGroup = pkg.groupDir
+			s.guess(fmt.Sprintf("group (%s)", pkg.importPath), pkg.qualifiedGroup,
+				"no +groupName marker nor GroupVersion was found in the package, "+
+					"so the group was taken from its directory")
+		}
+		s.packages = append(s.packages, pkg)
+	}
+	return nil
+}
+
+// addAPIResources adds the resources of the APIs of the project, once the project domain is known.
+func (s *scanner) addAPIResources() {
+	s.domain = s.projectDomain()
+
+	for _, pkg := range s.packages {
+		group, domain := pkg.split(s.domain)
+		for _, kind := range pkg.kinds {
+			res := &resource.Resource{
+				GVK: resource.GVK{
+					Group:   group,
+					Domain:  domain,
+					Version: pkg.version,
+					Kind:    kind.name,
+				},
+				Plural: kind.plural,
+				Path:   pkg.importPath,
+				API: &resource.API{
+					CRDVersion: "v1",
+					Namespaced: !kind.clusterScoped,
+					SSA:        kind.ssa,
+				},
+			}
+			if kind.hub {
+				res.Webhooks = &resource.Webhooks{
+					WebhookVersion: "v1",
+					Conversion:     true,
+					Spoke:          s.spokeVersions(pkg.qualifiedGroup, kind.name),
+				}
+			}
+			s.add(typeKey{path: pkg.importPath, kind: kind.name}, res)
+		}
+	}
+}
+
+// projectDomain returns the domain of the leader election ID of the manager or, if it was removed, the domain
+// shared by most of the API groups of the project. Groups can hold dots, so every suffix of a group that
+// follows a dot is counted, unless the group directory of a multi-group layout tells the group apart. The
+// longest domain wins ties, as it is the most specific one.
+func (s *scanner) projectDomain() string {
+	// The leader election ID of the manager is scaffolded as <hash>.<domain>
+	if match := leaderElectionIDRegexp.FindStringSubmatch(s.main); match != nil {
+		return match[1]
+	}
+
+	counts := map[string]int{}
+	for _, pkg := range s.packages {
+		if group, domain := pkg.split(""); group == pkg.groupDir && domain != "" {
+			counts[domain]++
+			continue
+		}
+		for i, c := range pkg.qualifiedGroup {
+			if c == '.' {
+				counts[pkg.qualifiedGroup[i+1:]]++
+			}
+		}
+	}
+
+	if len(counts) == 0 {
+		s.guess("domain", "", "no API group with a domain was found, "+
+			"so the domain must be set before creating APIs")
+		return ""
+	}
+
+	domain := slices.MaxFunc(slices.Collect(maps.Keys(counts)), func(a, b string) int {
+		if counts[a] != counts[b] {
+			return counts[a] - counts[b]
+		}
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return strings.Compare(b, a)
+	})
+
+	reason := fmt.Sprintf("no leader election ID was found in %s, so the domain shared by most of the API groups "+
+		"was chosen", mainFile)
+	var others []string
+	for _, pkg := range s.packages {
+		if _, groupDomain := pkg.split(domain); groupDomain != domain && !slices.Contains(others, pkg.qualifiedGroup) {
+			others = append(others, pkg.qualifiedGroup)
+		}
+	}
+	if len(others) > 0 {
+		reason += fmt.Sprintf("; the groups %s are not in that domain", strings.Join(others, ", "))
+	}
+	s.guess("domain", domain, reason)
+	return domain
+}
+
+// split returns the group and domain of the package. The group directory of multi-group layouts is named
+// after the group, so it is preferred to the provided domain.
+func (pkg apiPackage) split(domain string) (string, string) {
+	if pkg.groupDir != "" {
+		if groupDomain, found := strings.CutPrefix(pkg.qualifiedGroup, pkg.groupDir+"."); found {
+			return pkg.groupDir, groupDomain
+		}
+	}
+	return splitGroup(pkg.qualifiedGroup, domain)
+}
+
+// spokeVersions returns the versions in which the kind of the group converts to its hub.
+func (s *scanner) spokeVersions(qualifiedGroup, kind string) []string {
+	var versions []string
+	for _, pkg := range s.packages {
+		if pkg.qualifiedGroup != qualifiedGroup {
+			continue
+		}
+		for _, other := range pkg.kinds {
+			if other.name == kind && other.spoke && !slices.Contains(versions, pkg.version) {
+				versions = append(versions, pkg.version)
+			}
+		}
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+// splitGroup splits a qualified group, such as crew.testproject.org, into a group and a domain. The provided
+// domain is preferred, so that the groups of the project are split as "create api" does.
+func splitGroup(qualifiedGroup, domain string) (string, string) {
+	if domain != "" {
+		if qualifiedGroup == domain {
+			return "", domain
+		}
+		if group, found := strings.CutSuffix(qualifiedGroup, "."+domain); found {
+			return group, domain
+		}
+	}
+	group, groupDomain, _ := strings.Cut(qualifiedGroup, ".")
+	return group, groupDomain
+}
+
+// add tracks a new resource of the project.
+func (s *scanner) add(key typeKey, res *resource.Resource) {
+	s.resources[key] = res
+	s.order = append(s.order, key)
+}
+
+// resolve returns the resource of the Go type, adding the types of the Kubernetes API and of external APIs
+// when they are first used. Groups are read from the hints, which map plurals to qualified groups.
+func (s *scanner) resolve(key typeKey, hints map[string]string, source string) *resource.Resource {
+	if res, found := s.resources[key]; found {
+		return res
+	}
+
+	switch {
+	case strings.HasPrefix(key.path, corePathPrefix):
+		s.add(key, s.coreResource(key))
+	case key.path == s.repo || strings.HasPrefix(key.path, s.repo+"/"):
+		log.Warn("skipping a type of the project that is not a root type of an API",
+			"type", key.kind, "package", key.path, "file", source)
+		return nil
+	default:
+		s.add(key, s.externalResource(key, hints))
+	}
+	return s.resources[key]
+}
+
+// coreResource returns the resource of a type of the Kubernetes API, as "create api" tracks it.
+func (s *scanner) coreResource(key typeKey) *resource.Resource {
+	group := path.Base(path.Dir(key.path))
+	res := &resource.Resource{
+		GVK: resource.GVK{
+			Group:   group,
+			Version: path.Base(key.path),
+			Kind:    key.kind,
+		},
+		Plural: resource.RegularPlural(key.kind),
+		Path:   key.path,
+		Core:   true,
+	}
+
+	domain, found := golang.CoreGroupDomain(group)
+	if !found {
+		domain = k8sIODomain
+		s.guess(resourceField("domain", res), domain,
+			"the group is not a well-known group of the Kubernetes API")
+	}
+	res.Domain = domain
+	return res
+}
+
+// externalResource returns the resource of a type of an API that is not defined in the project.
+func (s *scanner) externalResource(key typeKey, hints map[string]string) *resource.Resource {
+	res := &resource.Resource{
+		GVK: resource.GVK{
+			Version: path.Base(key.path),
+			Kind:    key.kind,
+		},
+		Plural:   resource.RegularPlural(key.kind),
+		Path:     key.path,
+		External: true,
+		Module:   s.module(key.path),
+	}
+
+	if qualifiedGroup, found := hints[res.Plural]; found {
+		res.Group, res.Domain = splitGroup(qualifiedGroup, "")
+	} else {
+		res.Group = path.Base(path.Dir(key.path))
+		s.groupGuessed[key] = true
+	}
+	return res
+}
+
+// shareExternalGroups gives the group of an external API to the kinds of its package that are not used by
+// any RBAC or webhook marker, and reports the groups that are still unknown.
+func (s *scanner) shareExternalGroups() {
+	for _, key := range s.order {
+		if !s.groupGuessed[key] {
+			continue
+		}
+		res := s.resources[key]
+		for _, other := range s.order {
+			if other.path == key.path && !s.groupGuessed[other] {
+				res.Group, res.Domain = s.resources[other].Group, s.resources[other].Domain
+				delete(s.groupGuessed, key)
+				break
+			}
+		}
+		if s.groupGuessed[key] {
+			s.guess(resourceField("group", res), res.Group,
+				"no RBAC or webhook marker names the group of the external API, "+
+					"so it was taken from its package path and the domain is unset")
+		}
+	}
+}
+
+// module returns the required module that provides the package, with its version.
+func (s *scanner) module(importPath string) string {
+	var found string
+	for mod := range s.requires {
+		if (importPath == mod || strings.HasPrefix(importPath, mod+"/")) && len(mod) > len(found) {
+			found = mod
+		}
+	}
+	if found == "" {
+		return ""
+	}
+	return found + "@" + s.requires[found]
+}
+
+// scanControllers adds the controllers set up by the reconcilers of the project to their resources.
+func (s *scanner) scanControllers(files []sourceFile, hints map[string]string) {
+	for _, file := range files {
+		for _, setup := range file.setups("SetupWithManager", "For") {
+			res := s.resolve(setup.key, hints, file.path)
+			if res == nil {
+				continue
+			}
+
+			name := setup.name
+			if name == "" {
+				// controller-runtime names the controllers after the kind they reconcile
+				name = strings.ToLower(setup.key.kind)
+			}
+			if res.Controllers == nil {
+				res.Controllers = &resource.Controllers{}
+			}
+			if !slices.ContainsFunc(*res.Controllers, func(c resource.Controller) bool { return c.Name == name }) {
+				*res.Controllers = append(*res.Controllers, resource.Controller{Name: name})
+			}
+		}
+	}
+}
+
+// scanWebhooks adds the defaulting and validation webhooks of the webhook files to their resources.
+func (s *scanner) scanWebhooks(files []sourceFile, hints map[string]string) {
+	for _, file := range files {
+		setups := file.setups("", "NewWebhookManagedBy", "For")
+		if len(setups) == 0 {
+			continue
+		}
+		res := s.resolve(setups[0].key, hints, file.path)
+		if res == nil {
+			continue
+		}
+
+		for _, marker := range markers(file.ast.Comments...) {
+			args, found := markerArgs(marker, "kubebuilder:webhook")
+			if !found {
+				continue
+			}
+			if res.Webhooks == nil {
+				res.Webhooks = &resource.Webhooks{WebhookVersion: "v1"}
+			}
+			if args["mutating"] == "true" {
+				res.Webhooks.Defaulting = true
+				if args["path"] != defaultWebhookPath("mutate", res) {
+					res.Webhooks.DefaultingPath = args["path"]
+				}
+			} else {
+				res.Webhooks.Validation = true
+				if args["path"] != defaultWebhookPath("validate", res) {
+					res.Webhooks.ValidationPath = args["path"]
+				}
+			}
+		}
+	}
+}
+
+// defaultWebhookPath returns the path that "create webhook" gives to a webhook of the resource.
+func defaultWebhookPath(prefix string, res *resource.Resource) string {
+	group := strings.ReplaceAll(res.QualifiedGroup(), ".", "-")
+	if res.Core && res.QualifiedGroup() == "core" {
+		group = ""
+	}
+	return fmt.Sprintf("/%s-%s-%s-%s", prefix, group, res.Version, strings.ToLower(res.Kind))
+}
+
+// readMain reads the entrypoint of the manager, if any.
+func (s *scanner) readMain() error {
+	content, err := afero.ReadFile(s.fs, mainFile)
+	if errors.Is(err, os.ErrNotExist) {
+		s.guess("namespaced", "false", fmt.Sprintf("%s was not found, so the project is assumed to be "+
+			"cluster-scoped", mainFile))
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to read %s: %w", mainFile, err)
+	}
+	s.main = string(content)
+	return nil
+}
+
+// store sets the project fields and adds the resources to the configuration.
+func (s *scanner) store(cfg config.Config) error {
+	projectName := projectNameFromRepo(s.repo)
+	s.guess("projectName", projectName, "projects are named after their directory when initialized, "+
+		"so the name was taken from the module path")
+	s.guess("layout", defaultLayout, "the plugin chain is not recorded in the code, "+
+		"so plugins such as helm, grafana or deploy-image must be added by hand")
+	s.guess("cliVersion", "", "the Kubebuilder version that scaffolded the project is not recorded in the code, "+
+		"so it must be set before running alpha update")
+
+	if err := cfg.SetRepository(s.repo); err != nil {
+		return fmt.Errorf("failed to set the repository: %w", err)
+	}
+	if err := cfg.SetDomain(s.domain); err != nil {
+		return fmt.Errorf("failed to set the domain: %w", err)
+	}
+	if err := cfg.SetProjectName(projectName); err != nil {
+		return fmt.Errorf("failed to set the project name: %w", err)
+	}
+	if err := cfg.SetPluginChain([]string{defaultLayout}); err != nil {
+		return fmt.Errorf("failed to set the plugin chain: %w", err)
+	}
+	multiGroup := slices.ContainsFunc(s.packages, func(pkg *apiPackage) bool { return pkg.groupDir != "" })
+	if multiGroup {
+		if err := cfg.SetMultiGroup(); err != nil {
+			return fmt.Errorf("failed to set the multi-group layout: %w", err)
+		}
+	}
+	// Only the manager of namespace-scoped projects reads the namespaces to watch from the environment
+	if strings.Contains(s.main, watchNamespaceEnvVar) {
+		if err := cfg.SetNamespaced(); err != nil {
+			return fmt.Errorf("failed to set the namespace-scoped layout: %w", err)
+		}
+	}
+
+	for _, key := range s.order {
+		res := s.resources[key]
+		// A single controller named after the kind is the one scaffolded by "create api --controller"
+		names := res.GetControllerNames()
+		if len(names) == 1 && names[0] == resource.GetControllerName("", res.Kind, res.Group, multiGroup) {
+			res.Controller = true
+			res.Controllers = nil
+		} else if res.Controllers != nil {
+			if multiGroup && res.Group != "" {
+				// The controllers of multi-group projects are named after their group, which is not stored
+				for i, controller := range *res.Controllers {
+					(*res.Controllers)[i].Name = strings.TrimPrefix(controller.Name, strings.ToLower(res.Group)+"-")
+				}
+			}
+			slices.SortFunc(*res.Controllers, func(a, b resource.Controller) int { return strings.Compare(a.Name, b.Name) })
+		}
+
+		if err := res.Validate(); err != nil {
+			return fmt.Errorf("invalid resource reconstructed for %s/%s, Kind %s: %w",
+				res.QualifiedGroup(), res.Version, res.Kind, err)
+		}
+		if err := cfg.AddResource(*res); err != nil {
+			return fmt.Errorf("failed to add resource %s/%s, Kind %s: %w",
+				res.QualifiedGroup(), res.Version, res.Kind, err)
+		}
+	}
+	return nil
+}
+
+// projectNameFromRepo returns the last element of the module path, ignoring a major version suffix.
+func projectNameFromRepo(repo string) string {
+	elements := strings.Split(repo, "/")
+	name := elements[len(elements)-1]
+	if len(elements) > 1 && isMajorVersion(name) {
+		name = elements[len(elements)-2]
+	}
+	return strings.ToLower(name)
+}
+
+// isMajorVersion returns true for major version suffixes of module paths, such as v2.
+func isMajorVersion(element string) bool {
+	digits, found := strings.CutPrefix(element, "v")
+	return found && digits != "" && strings.Trim(digits, "0123456789") == ""
+}
+
+// resourceField returns the name of a field of a resource to report it.
+func resourceField(field string, res *resource.Resource) string {
+	return fmt.Sprintf("%s (%s/%s, Kind %s)", field, res.QualifiedGroup(), res.Version, res.Kind)
+}
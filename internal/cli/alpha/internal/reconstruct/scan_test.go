@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconstruct
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+const (
+	goModContent = `module example.com/guestbook
+
+go 1.24
+
+require github.com/cert-manager/cert-manager v1.18.2
+`
+
+	mainContent = `package main
+
+func main() {
+	_ = ctrl.Options{LeaderElectionID: "1a2b3c4d.my.domain"}
+}
+`
+
+	groupVersionContent = `// Package v1 contains API Schema definitions for the webapp v1 API group.
+// +kubebuilder:object:generate=true
+// +groupName=webapp.my.domain
+package v1
+`
+
+	typesContent = `package v1
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=guestbookes,scope=Cluster
+
+// Guestbook is the Schema for the guestbookes API
+type Guestbook struct {
+	Spec GuestbookSpec ` + "`json:\"spec\"`" + `
+}
+
+// +kubebuilder:object:root=true
+
+// GuestbookList contains a list of Guestbook
+type GuestbookList struct {
+	Items []Guestbook ` + "`json:\"items\"`" + `
+}
+`
+
+	controllerContent = `package controller
+
+import (
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	webappv1 "example.com/guestbook/api/v1"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates/status,verbs=get
+
+func (r *GuestbookReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&webappv1.Guestbook{}).
+		Named("guestbook-backup").
+		Complete(r)
+}
+
+func (r *CertificateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).For(&certmanagerv1.Certificate{}).Complete(r)
+}
+
+func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).For(&corev1.Pod{}).Named("pod").Complete(r)
+}
+`
+)
+
+//nolint:lll
+const webhookContent = `package v1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	webappv1 "example.com/guestbook/api/v1"
+)
+
+func SetupGuestbookWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &webappv1.Guestbook{}).Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-webapp-my-domain-v1-guestbook,mutating=true,failurePolicy=fail,groups=webapp.my.domain,resources=guestbookes,verbs=create;update,versions=v1,name=mguestbook-v1.kb.io,admissionReviewVersions=v1
+
+// +kubebuilder:webhook:path=/custom-validate-guestbook,mutating=false,failurePolicy=fail,groups=webapp.my.domain,resources=guestbookes,verbs=create;update,versions=v1,name=vguestbook-v1.kb.io,admissionReviewVersions=v1
+`
+
+// writeFiles writes the provided files, keyed by path, to fs.
+func writeFiles(fs afero.Fs, files map[string]string) {
+	for path, content := range files {
+		Expect(fs.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(afero.WriteFile(fs, path, []byte(content), 0o644)).To(Succeed())
+	}
+}
+
+// guessedFields returns the fields of the guesses.
+func guessedFields(guesses []Guess) []string {
+	fields := make([]string, 0, len(guesses))
+	for _, guess := range guesses {
+		fields = append(fields, guess.Field)
+	}
+	return fields
+}
+
+var _ = Describe("Scan", func() {
+	var (
+		fs  afero.Fs
+		cfg config.Config
+	)
+
+	BeforeEach(func() {
+		fs = afero.NewMemMapFs()
+		cfg = cfgv3.New()
+	})
+
+	Context("with a single-group project", func() {
+		BeforeEach(func() {
+			writeFiles(fs, map[string]string{
+				goModFile:                                  goModContent,
+				mainFile:                                   mainContent,
+				"api/v1/groupversion_info.go":              groupVersionContent,
+				"api/v1/guestbook_types.go":                typesContent,
+				"internal/controller/controllers.go":       controllerContent,
+				"internal/webhook/v1/guestbook_webhook.go": webhookContent,
+			})
+		})
+
+		It("should read the project fields", func() {
+			guesses, err := Scan(fs, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.GetRepository()).To(Equal("example.com/guestbook"))
+			Expect(cfg.GetDomain()).To(Equal("my.domain"))
+			Expect(cfg.GetProjectName()).To(Equal("guestbook"))
+			Expect(cfg.GetPluginChain()).To(Equal([]string{defaultLayout}))
+			Expect(cfg.IsMultiGroup()).To(BeFalse())
+			Expect(cfg.IsNamespaced()).To(BeFalse())
+			Expect(guessedFields(guesses)).To(ConsistOf("projectName", "layout", "cliVersion"))
+		})
+
+		It("should read the APIs, controllers and webhooks of the project", func() {
+			_, err := Scan(fs, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := cfg.GetResource(resource.GVK{Group: "webapp", Domain: "my.domain", Version: "v1", Kind: "Guestbook"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Path).To(Equal("example.com/guestbook/api/v1"))
+			Expect(res.Plural).To(Equal("guestbookes"))
+			Expect(res.API).To(Equal(&resource.API{CRDVersion: "v1"}))
+			Expect(res.Controller).To(BeFalse())
+			Expect(res.GetControllerNames()).To(Equal([]string{"guestbook-backup"}))
+			Expect(res.Webhooks).To(Equal(&resource.Webhooks{
+				WebhookVersion: "v1",
+				Defaulting:     true,
+				Validation:     true,
+				ValidationPath: "/custom-validate-guestbook",
+			}))
+		})
+
+		It("should read the external and core types that are reconciled", func() {
+			_, err := Scan(fs, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			certificate, err := cfg.GetResource(resource.GVK{
+				Group: "cert-manager", Domain: "io", Version: "v1", Kind: "Certificate",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.External).To(BeTrue())
+			Expect(certificate.Path).To(Equal("github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"))
+			Expect(certificate.Module).To(Equal("github.com/cert-manager/cert-manager@v1.18.2"))
+			Expect(certificate.Controller).To(BeTrue())
+
+			pod, err := cfg.GetResource(resource.GVK{Group: "core", Version: "v1", Kind: "Pod"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Core).To(BeTrue())
+			Expect(pod.Path).To(Equal("k8s.io/api/core/v1"))
+			Expect(pod.Controller).To(BeTrue())
+		})
+
+		It("should read a namespace-scoped project", func() {
+			writeFiles(fs, map[string]string{mainFile: mainContent + "\nconst env = \"WATCH_NAMESPACE\"\n"})
+
+			_, err := Scan(fs, cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.IsNamespaced()).To(BeTrue())
+		})
+
+		It("should read the conversion hub and spokes", func() {
+			writeFiles(fs, map[string]string{
+				"api/v1/guestbook_conversion.go": "package v1\n\nfunc (*Guestbook) Hub() {}\n",
+				"api/v2/groupversion_info.go":    "// +groupName=webapp.my.domain\npackage v2\n",
+				"api/v2/guestbook_types.go":      typesContent,
+				"api/v2/guestbook_conversion.go": "package v2\n\nfunc (src *Guestbook) ConvertTo(dst conversion.Hub) error {\n" +
+					"\treturn nil\n}\n",
+			})
+
+			_, err := Scan(fs, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			hub, err := cfg.GetResource(resource.GVK{Group: "webapp", Domain: "my.domain", Version: "v1", Kind: "Guestbook"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hub.HasConversionWebhook()).To(BeTrue())
+			Expect(hub.Webhooks.Spoke).To(Equal([]string{"v2"}))
+
+			spoke, err := cfg.GetResource(resource.GVK{Group: "webapp", Domain: "my.domain", Version: "v2", Kind: "Guestbook"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spoke.Webhooks).To(BeNil())
+		})
+
+		It("should guess the group of an external API that no marker names", func() {
+			writeFiles(fs, map[string]string{"internal/controller/controllers.go": `package controller
+
+import certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+func (r *IssuerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).For(&certmanagerv1.Issuer{}).Complete(r)
+}
+`})
+
+			guesses, err := Scan(fs, cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(guessedFields(guesses)).To(ContainElement("group (certmanager/v1, Kind Issuer)"))
+
+			issuer, err := cfg.GetResource(resource.GVK{Group: "certmanager", Version: "v1", Kind: "Issuer"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuer.External).To(BeTrue())
+		})
+
+		It("should fail without a go.mod file", func() {
+			Expect(fs.Remove(goModFile)).To(Succeed())
+
+			_, err := Scan(fs, cfg)
+			Expect(err).To(MatchError(ContainSubstring("failed to read go.mod")))
+		})
+
+		It("should fail for a Go file that cannot be parsed", func() {
+			writeFiles(fs, map[string]string{"api/v1/broken_types.go": "package v1\n\ntype Broken struct {\n"})
+
+			_, err := Scan(fs, cfg)
+			Expect(err).To(MatchError(ContainSubstring("failed to parse api/v1/broken_types.go")))
+		})
+	})
+
+	Context("with a multi-group project", func() {
+		BeforeEach(func() {
+			writeFiles(fs, map[string]string{
+				goModFile: goModContent,
+				"api/example.com/v1/groupversion_info.go": "// +groupName=example.com.my.domain\npackage v1\n",
+				"api/example.com/v1/guestbook_types.go":   typesContent,
+				"api/crew/v1/groupversion_info.go":        "// +groupName=crew.my.domain\npackage v1\n",
+				"internal/controller/example.com/guestbook_controller.go": `package examplecom
+
+import examplecomv1 "example.com/guestbook/api/example.com/v1"
+
+func (r *GuestbookReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).For(&examplecomv1.Guestbook{}).Named("example.com-guestbook").Complete(r)
+}
+`,
+			})
+		})
+
+		It("should read the groups from their directories", func() {
+			guesses, err := Scan(fs, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.IsMultiGroup()).To(BeTrue())
+			Expect(cfg.GetDomain()).To(Equal("my.domain"))
+			// Without the entrypoint of the manager, the domain and the scope of the project are guessed
+			Expect(guessedFields(guesses)).To(ContainElements("domain", "namespaced"))
+
+			res, err := cfg.GetResource(resource.GVK{
+				Group: "example.com", Domain: "my.domain", Version: "v1", Kind: "Guestbook",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Path).To(Equal("example.com/guestbook/api/example.com/v1"))
+			Expect(res.Controller).To(BeTrue())
+			Expect(res.Controllers).To(BeNil())
+		})
+	})
+
+	DescribeTable("should reconstruct the PROJECT file of the sample projects",
+		func(project string) {
+			dir := filepath.Join("..", "..", "..", "..", "..", "testdata", project)
+			expected := yaml.New(machinery.Filesystem{FS: afero.NewOsFs()})
+			Expect(expected.LoadFrom(filepath.Join(dir, yaml.DefaultPath))).To(Succeed())
+
+			_, err := Scan(afero.NewReadOnlyFs(afero.NewBasePathFs(afero.NewOsFs(), dir)), cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.GetRepository()).To(Equal(expected.Config().GetRepository()))
+			Expect(cfg.GetDomain()).To(Equal(expected.Config().GetDomain()))
+			Expect(cfg.GetProjectName()).To(Equal(expected.Config().GetProjectName()))
+			Expect(cfg.IsMultiGroup()).To(Equal(expected.Config().IsMultiGroup()))
+			Expect(cfg.IsNamespaced()).To(Equal(expected.Config().IsNamespaced()))
+
+			resources, err := cfg.GetResources()
+			Expect(err).NotTo(HaveOccurred())
+			expectedResources, err := expected.Config().GetResources()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(ConsistOf(expectedResources))
+		},
+		Entry("for project-v4", "project-v4"),
+		Entry("for project-v4-multigroup", "project-v4-multigroup"),
+		Entry("for project-v4-with-plugins", "project-v4-with-plugins"),
+	)
+})
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
+)
+
+// releaseBinaryDir returns a temporary directory holding the kubebuilder binary of the release version.
+// The binary is taken from --from-binary or --to-binary, then from the binary cache, and is downloaded
+// otherwise. Downloaded binaries are stored in the binary cache and, when a checksum manifest is set,
+// every binary must match its checksum. The caller is responsible for removing the directory.
+func (opts *Update) releaseBinaryDir(version string) (string, error) {
+	if err := opts.loadChecksums(); err != nil {
+		return "", err
+	}
+
+	var tempDir string
+	var err error
+	local := opts.localBinary(version)
+	if local != "" {
+		log.Info("Using local Kubebuilder binary", "version", version, "path", local)
+		tempDir, err = helpers.CopyReleaseBinary(version, local)
+		if err != nil {
+			return "", fmt.Errorf("failed to use local release %s binary: %w", version, err)
+		}
+	} else {
+		tempDir, err = helpers.DownloadReleaseVersionWith(version)
+		if err != nil {
+			return "", fmt.Errorf("failed to download release %s binary: %w", version, err)
+		}
+	}
+
+	binaryPath := filepath.Join(tempDir, "kubebuilder")
+	if opts.checksums != nil {
+		if err = opts.checksums.Verify(version, binaryPath); err != nil {
+			if rmErr := os.RemoveAll(tempDir); rmErr != nil {
+				log.Warn("failed to remove temporary directory", "dir", tempDir, "error", rmErr)
+			}
+			return "", fmt.Errorf("failed to verify release %s binary: %w", version, err)
+		}
+	}
+
+	if local == "" && opts.BinaryCacheDir != "" {
+		if err = helpers.StoreReleaseBinary(opts.BinaryCacheDir, version, binaryPath); err != nil {
+			log.Warn("failed to cache the release binary", "version", version, "error", err)
+		}
+	}
+	return tempDir, nil
+}
+
+// localBinary returns the path of a local binary of the release version, either provided with
+// --from-binary or --to-binary or found in the binary cache, or an empty string if there is none.
+func (opts *Update) localBinary(version string) string {
+	if opts.FromBinary != "" && version == opts.FromVersion {
+		return opts.FromBinary
+	}
+	if opts.ToBinary != "" && version == opts.ToVersion {
+		return opts.ToBinary
+	}
+	if opts.BinaryCacheDir != "" {
+		if path, found := helpers.CachedReleaseBinary(opts.BinaryCacheDir, version); found {
+			return path
+		}
+	}
+	return ""
+}
+
+// loadChecksums loads the checksum manifest, if one is set and it was not loaded yet.
+func (opts *Update) loadChecksums() error {
+	if opts.ChecksumManifest == "" || opts.checksums != nil {
+		return nil
+	}
+	checksums, err := helpers.LoadChecksumManifest(opts.ChecksumManifest)
+	if err != nil {
+		return fmt.Errorf("failed to load --checksum-manifest: %w", err)
+	}
+	opts.checksums = checksums
+	return nil
+}
+
+// isOffline returns true when the binaries of both FromVersion and ToVersion are available locally,
+// so that the update does not need to reach the release downloads.
+func (opts *Update) isOffline() bool {
+	return opts.localBinary(opts.FromVersion) != "" && opts.localBinary(opts.ToVersion) != ""
+}
+
+// validateBinaries verifies that the local binaries exist, that they match the checksum manifest when one is
+// set, and that their versions match the versions of the update. The binaries are verified before they run to
+// report their version, and a binary which cannot report its version requires the checksum manifest.
+func (opts *Update) validateBinaries() error {
+	if err := opts.loadChecksums(); err != nil {
+		return err
+	}
+
+	binaries := []struct{ flag, path, version string }{
+		{"--from-binary", opts.FromBinary, opts.FromVersion},
+		{"--to-binary", opts.ToBinary, opts.ToVersion},
+	}
+	for _, binary := range binaries {
+		if binary.path == "" {
+			continue
+		}
+		info, err := os.Stat(binary.path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", binary.flag, err)
+		}
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%s: %s is not a file", binary.flag, binary.path)
+		}
+		if opts.checksums != nil {
+			if err = opts.checksums.Verify(binary.version, binary.path); err != nil {
+				return fmt.Errorf("%s: %w", binary.flag, err)
+			}
+		}
+		version, err := helpers.BinaryVersion(binary.path)
+		if err != nil {
+			// The checksum manifest is then the only way to verify that the binary is the release
+			if opts.checksums == nil {
+				return fmt.Errorf("%s: unable to check the version of the binary, "+
+					"set --checksum-manifest to verify it: %w", binary.flag, err)
+			}
+			log.Info("Unable to check the version of the binary, it matches the checksum of the release",
+				"flag", binary.flag, "version", binary.version, "error", err)
+			continue
+		}
+		if version != binary.version {
+			return fmt.Errorf("%s: the version of %s is %s, which does not match the version %s of the update",
+				binary.flag, binary.path, version, binary.version)
+		}
+	}
+	return nil
+}
+
+// localBinaryVersion returns the release version of a local binary. When a checksum manifest is set, the
+// version is the one whose checksum matches the binary, so that an unverified binary is never run.
+func (opts *Update) localBinaryVersion(path string) (string, error) {
+	if err := opts.loadChecksums(); err != nil {
+		return "", err
+	}
+	if opts.checksums != nil {
+		version, err := opts.checksums.VersionOf(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to verify the binary: %w", err)
+		}
+		return version, nil
+	}
+	version, err := helpers.BinaryVersion(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to check the version of the binary: %w", err)
+	}
+	return version, nil
+}
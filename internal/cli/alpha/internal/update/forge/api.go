@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	log "log/slog"
+	"net/http"
+	"time"
+)
+
+// apiClient calls the REST API of a forge with a token.
+type apiClient struct {
+	// baseURL is the URL that the API paths are relative to, e.g. "https://gitlab.com/api/v4".
+	baseURL string
+	// authHeader is the header that carries the token, and authPrefix is written before it.
+	authHeader string
+	authPrefix string
+	token      string
+
+	client *http.Client
+}
+
+func newAPIClient(baseURL, authHeader, authPrefix, token string) *apiClient {
+	return &apiClient{
+		baseURL:    baseURL,
+		authHeader: authHeader,
+		authPrefix: authPrefix,
+		token:      token,
+		client:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// do sends a request to the API path with the JSON encoding of in, if any, and decodes the JSON
+// response into out, if any.
+func (c *apiClient) do(method, path string, in, out any) error {
+	var reqBody io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set(c.authHeader, c.authPrefix+c.token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s %s: %w", method, path, err)
+	}
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Error("failed to close connection", "error", err)
+		}
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response of %s %s: %w", method, path, err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s %s returned %d: %s", method, path, resp.StatusCode, bytes.TrimSpace(respBody))
+	}
+
+	if out != nil {
+		if err = json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to decode response of %s %s: %w", method, path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// GitHub is the name of the forge for repositories hosted on GitHub. It uses the `gh` CLI.
+	GitHub = "github"
+	// GitLab is the name of the forge for repositories hosted on GitLab. It uses the GitLab REST API.
+	GitLab = "gitlab"
+	// Gitea is the name of the forge for repositories hosted on Gitea or Forgejo. It uses the Gitea REST API.
+	Gitea = "gitea"
+)
+
+// Names returns the names of the supported forges.
+func Names() []string {
+	return []string{GitHub, GitLab, Gitea}
+}
+
+// Forge is the service that hosts the repository of the project. It is used to open the issues and
+// merge requests that track a scaffold update.
+type Forge interface {
+	// Name returns the name of the forge.
+	Name() string
+
+	// CompareURL returns the URL of the page to open a merge request from the head branch into the
+	// base branch.
+	CompareURL(base, head string) (string, error)
+
+	// FindOpenIssue returns the URL of an open issue with the provided title, or an empty string
+	// when there is none.
+	FindOpenIssue(title string) (string, error)
+
+	// CreateIssue opens an issue and returns its URL.
+	CreateIssue(title, body string) (string, error)
+
+	// CreateMergeRequest opens a merge request (a pull request on GitHub and Gitea) from the head
+	// branch into the base branch and returns its URL.
+	CreateMergeRequest(base, head, title, body string) (string, error)
+
+	// FindOpenMergeRequests returns the open merge requests into the base branch.
+	FindOpenMergeRequests(base string) ([]MergeRequest, error)
+
+	// UpdateMergeRequest replaces the title and the description of an open merge request.
+	UpdateMergeRequest(mr MergeRequest, title, body string) error
+}
+
+// MergeRequest is a merge request (a pull request on GitHub and Gitea) opened on a forge.
+type MergeRequest struct {
+	// Number identifies the merge request in the repository. It is the IID on GitLab.
+	Number int
+	// Head is the branch with the changes to merge.
+	Head string
+	// HeadSHA is the commit at the tip of the head branch, as reported by the forge.
+	HeadSHA string
+	// URL is the web page of the merge request.
+	URL string
+}
+
+// New returns the forge with the provided name. The GitLab and Gitea forges read their settings
+// from the environment and fall back to the "origin" remote to find the server and the repository.
+func New(name string) (Forge, error) {
+	switch name {
+	case GitHub:
+		return &gitHub{}, nil
+	case GitLab:
+		return newGitLab()
+	case Gitea:
+		return newGitea()
+	default:
+		return nil, fmt.Errorf("unsupported forge %q: supported forges are %s",
+			name, strings.Join(Names(), ", "))
+	}
+}
+
+// repository identifies a repository hosted on a forge.
+type repository struct {
+	// serverURL is the base URL of the forge, e.g. "https://gitlab.com".
+	serverURL string
+	// path is the path of the repository on the forge, e.g. "group/subgroup/project".
+	path string
+}
+
+// resolveRepository returns the repository described by the provided environment variables. Values
+// that are not set are taken from the "origin" remote of the current git repository.
+func resolveRepository(serverURLEnv, pathEnv string) (repository, error) {
+	repo := repository{
+		serverURL: strings.TrimSuffix(os.Getenv(serverURLEnv), "/"),
+		path:      strings.Trim(os.Getenv(pathEnv), "/"),
+	}
+	if repo.serverURL != "" && repo.path != "" {
+		return repo, nil
+	}
+
+	out, err := exec.Command("git", "remote", "get-url", "origin").Output()
+	if err != nil {
+		return repository{}, fmt.Errorf("failed to get the URL of the origin remote "+
+			"(set %s and %s to skip the detection): %w", serverURLEnv, pathEnv, err)
+	}
+	remote, err := parseRemoteURL(strings.TrimSpace(string(out)))
+	if err != nil {
+		return repository{}, fmt.Errorf("%w (set %s and %s to skip the detection)", err, serverURLEnv, pathEnv)
+	}
+
+	if repo.serverURL == "" {
+		repo.serverURL = remote.serverURL
+	}
+	if repo.path == "" {
+		repo.path = remote.path
+	}
+	return repo, nil
+}
+
+// parseRemoteURL returns the repository of a git remote URL. HTTP(S) URLs, SSH URLs and the
+// scp-like syntax ("git@host:owner/repo.git") are supported. The server URL of SSH remotes is
+// assumed to be served over HTTPS on the same host.
+func parseRemoteURL(remote string) (repository, error) {
+	if !strings.Contains(remote, "://") {
+		// scp-like syntax: [user@]host:path
+		host, path, found := strings.Cut(remote, ":")
+		if !found || path == "" {
+			return repository{}, fmt.Errorf("unable to parse remote URL %q", remote)
+		}
+		if i := strings.LastIndex(host, "@"); i >= 0 {
+			host = host[i+1:]
+		}
+		remote = "ssh://" + host + "/" + path
+	}
+
+	u, err := url.Parse(remote)
+	if err != nil {
+		return repository{}, fmt.Errorf("unable to parse remote URL %q: %w", remote, err)
+	}
+
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if u.Host == "" || path == "" {
+		return repository{}, fmt.Errorf("unable to parse remote URL %q", remote)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return repository{serverURL: u.Scheme + "://" + u.Host, path: path}, nil
+	case "ssh", "git":
+		return repository{serverURL: "https://" + u.Hostname(), path: path}, nil
+	default:
+		return repository{}, fmt.Errorf("unsupported scheme %q in remote URL %q", u.Scheme, remote)
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// giteaTokenEnv is the environment variable with the access token used to call the Gitea API.
+	// Gitea Actions provides one to every job as the GITEA_TOKEN secret.
+	giteaTokenEnv = "GITEA_TOKEN"
+	// giteaServerURLEnv and giteaRepositoryEnv locate the repository, e.g. "https://gitea.com" and
+	// "owner/repo".
+	giteaServerURLEnv  = "GITEA_SERVER_URL"
+	giteaRepositoryEnv = "GITEA_REPOSITORY"
+)
+
+var _ Forge = &gitea{}
+
+// gitea opens issues and pull requests with the Gitea REST API, which is also served by Forgejo.
+type gitea struct {
+	repo repository
+	api  *apiClient
+}
+
+func newGitea() (*gitea, error) {
+	token := os.Getenv(giteaTokenEnv)
+	if token == "" {
+		return nil, fmt.Errorf("%s must be set to an access token with write access to issues "+
+			"and repository to use the %s forge", giteaTokenEnv, Gitea)
+	}
+	repo, err := resolveRepository(giteaServerURLEnv, giteaRepositoryEnv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect Gitea repository: %w", err)
+	}
+	if strings.Count(repo.path, "/") != 1 {
+		return nil, fmt.Errorf("invalid Gitea repository %q: expected <owner>/<repo>", repo.path)
+	}
+
+	return &gitea{
+		repo: repo,
+		api:  newAPIClient(repo.serverURL+"/api/v1", "Authorization", "token ", token),
+	}, nil
+}
+
+func (f *gitea) Name() string {
+	return Gitea
+}
+
+// repoPath returns the API path of the repository.
+func (f *gitea) repoPath() string {
+	return "/repos/" + f.repo.path
+}
+
+func (f *gitea) CompareURL(base, head string) (string, error) {
+	return fmt.Sprintf("%s/%s/compare/%s...%s", f.repo.serverURL, f.repo.path, base, head), nil
+}
+
+func (f *gitea) FindOpenIssue(title string) (string, error) {
+	query := url.Values{}
+	query.Set("state", "open")
+	query.Set("type", "issues")
+	query.Set("q", title)
+
+	var issues []struct {
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+	}
+	if err := f.api.do(http.MethodGet, f.repoPath()+"/issues?"+query.Encode(), nil, &issues); err != nil {
+		return "", fmt.Errorf("failed to list Gitea issues: %w", err)
+	}
+	for _, issue := range issues {
+		if issue.Title == title {
+			return issue.HTMLURL, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *gitea) CreateIssue(title, body string) (string, error) {
+	in := map[string]string{
+		"title": title,
+		"body":  body,
+	}
+	var issue struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := f.api.do(http.MethodPost, f.repoPath()+"/issues", in, &issue); err != nil {
+		return "", fmt.Errorf("failed to create Gitea issue: %w", err)
+	}
+	return issue.HTMLURL, nil
+}
+
+func (f *gitea) CreateMergeRequest(base, head, title, body string) (string, error) {
+	in := map[string]string{
+		"base":  base,
+		"head":  head,
+		"title": title,
+		"body":  body,
+	}
+	var pullRequest struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := f.api.do(http.MethodPost, f.repoPath()+"/pulls", in, &pullRequest); err != nil {
+		return "", fmt.Errorf("failed to create Gitea pull request: %w", err)
+	}
+	return pullRequest.HTMLURL, nil
+}
+
+func (f *gitea) FindOpenMergeRequests(base string) ([]MergeRequest, error) {
+	query := url.Values{}
+	query.Set("state", "open")
+	query.Set("limit", "50")
+
+	var pullRequests []struct {
+		Number int `json:"number"`
+		Head   struct {
+			Ref string `json:"ref"`
+			SHA string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+		HTMLURL string `json:"html_url"`
+	}
+	if err := f.api.do(http.MethodGet, f.repoPath()+"/pulls?"+query.Encode(), nil, &pullRequests); err != nil {
+		return nil, fmt.Errorf("failed to list Gitea pull requests: %w", err)
+	}
+
+	result := make([]MergeRequest, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		// The API does not filter the pull requests by base branch
+		if pr.Base.Ref != base {
+			continue
+		}
+		result = append(result, MergeRequest{Number: pr.Number, Head: pr.Head.Ref, HeadSHA: pr.Head.SHA, URL: pr.HTMLURL})
+	}
+	return result, nil
+}
+
+func (f *gitea) UpdateMergeRequest(mr MergeRequest, title, body string) error {
+	in := map[string]string{
+		"title": title,
+		"body":  body,
+	}
+	path := f.repoPath() + "/pulls/" + strconv.Itoa(mr.Number)
+	if err := f.api.do(http.MethodPatch, path, in, nil); err != nil {
+		return fmt.Errorf("failed to update Gitea pull request %s: %w", mr.URL, err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gitea", func() {
+	var (
+		server   *httptest.Server
+		requests []*http.Request
+		bodies   []map[string]string
+		f        *gitea
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			body := map[string]string{}
+			if r.Body != nil && r.ContentLength > 0 {
+				_ = json.NewDecoder(r.Body).Decode(&body)
+			}
+			bodies = append(bodies, body)
+
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/owner/repo/issues":
+				_, _ = w.Write([]byte(`[{"title":"Upgrade","html_url":"https://gitea.test/owner/repo/issues/1"}]`))
+			case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/owner/repo/issues":
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"html_url":"https://gitea.test/owner/repo/issues/2"}`))
+			case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/owner/repo/pulls":
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"html_url":"https://gitea.test/owner/repo/pulls/3"}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/owner/repo/pulls":
+				_, _ = w.Write([]byte(`[` +
+					`{"number":4,"head":{"ref":"feature"},"base":{"ref":"develop"},"html_url":"https://gitea.test/pulls/4"},` +
+					`{"number":5,"head":{"ref":"kubebuilder-update","sha":"abc123"},"base":{"ref":"main"},` +
+					`"html_url":"https://gitea.test/pulls/5"}]`))
+			case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/repos/owner/repo/pulls/5":
+				_, _ = w.Write([]byte(`{"html_url":"https://gitea.test/pulls/5"}`))
+			default:
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message":"token does not have the required scope"}`))
+			}
+		}))
+
+		GinkgoT().Setenv(giteaTokenEnv, "secret")
+		GinkgoT().Setenv(giteaServerURLEnv, server.URL)
+		GinkgoT().Setenv(giteaRepositoryEnv, "owner/repo")
+
+		var err error
+		f, err = newGitea()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should build the URL to open a pull request", func() {
+		compareURL, err := f.CompareURL("main", "kubebuilder-update")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compareURL).To(Equal(server.URL + "/owner/repo/compare/main...kubebuilder-update"))
+	})
+
+	It("should find an open issue by title", func() {
+		issueURL, err := f.FindOpenIssue("Upgrade")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issueURL).To(Equal("https://gitea.test/owner/repo/issues/1"))
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Query().Get("state")).To(Equal("open"))
+		Expect(requests[0].URL.Query().Get("type")).To(Equal("issues"))
+		Expect(requests[0].URL.Query().Get("q")).To(Equal("Upgrade"))
+		Expect(requests[0].Header.Get("Authorization")).To(Equal("token secret"))
+	})
+
+	It("should create an issue", func() {
+		issueURL, err := f.CreateIssue("Upgrade", "body")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issueURL).To(Equal("https://gitea.test/owner/repo/issues/2"))
+		Expect(bodies[0]).To(Equal(map[string]string{"title": "Upgrade", "body": "body"}))
+	})
+
+	It("should create a pull request", func() {
+		prURL, err := f.CreateMergeRequest("main", "kubebuilder-update", "Upgrade", "body")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prURL).To(Equal("https://gitea.test/owner/repo/pulls/3"))
+		Expect(bodies[0]).To(Equal(map[string]string{
+			"base":  "main",
+			"head":  "kubebuilder-update",
+			"title": "Upgrade",
+			"body":  "body",
+		}))
+	})
+
+	It("should find the open pull requests into a branch", func() {
+		pullRequests, err := f.FindOpenMergeRequests("main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pullRequests).To(Equal([]MergeRequest{
+			{Number: 5, Head: "kubebuilder-update", HeadSHA: "abc123", URL: "https://gitea.test/pulls/5"},
+		}))
+		Expect(requests[0].URL.Query().Get("state")).To(Equal("open"))
+	})
+
+	It("should update a pull request", func() {
+		pr := MergeRequest{Number: 5, Head: "kubebuilder-update", URL: "https://gitea.test/pulls/5"}
+		Expect(f.UpdateMergeRequest(pr, "Upgrade", "new body")).To(Succeed())
+		Expect(requests[0].Method).To(Equal(http.MethodPatch))
+		Expect(bodies[0]).To(Equal(map[string]string{"title": "Upgrade", "body": "new body"}))
+	})
+
+	It("should report the API errors", func() {
+		GinkgoT().Setenv(giteaRepositoryEnv, "owner/forbidden")
+		forbidden, err := newGitea()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = forbidden.CreateMergeRequest("main", "kubebuilder-update", "Upgrade", "body")
+		Expect(err).To(MatchError(ContainSubstring("failed to create Gitea pull request")))
+		Expect(err).To(MatchError(ContainSubstring("returned 403")))
+	})
+})
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+	log "log/slog"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
+)
+
+var _ Forge = &gitHub{}
+
+// gitHub opens issues and pull requests on GitHub with the `gh` CLI, which must be installed and
+// authenticated.
+type gitHub struct {
+	// repo is the "owner/name" of the repository, detected on first use.
+	repo string
+}
+
+func (f *gitHub) Name() string {
+	return GitHub
+}
+
+// repository returns the "owner/name" of the current repository as reported by `gh repo view`.
+func (f *gitHub) repository() (string, error) {
+	if f.repo != "" {
+		return f.repo, nil
+	}
+	repoCmd := exec.Command("gh", "repo", "view", "--json", "nameWithOwner", "--jq", ".nameWithOwner")
+	repoBytes, err := repoCmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to detect GitHub repository via `gh repo view`: %s", err)
+	}
+	f.repo = strings.TrimSpace(string(repoBytes))
+	return f.repo, nil
+}
+
+func (f *gitHub) CompareURL(base, head string) (string, error) {
+	repo, err := f.repository()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://github.com/%s/compare/%s...%s?expand=1", repo, base, head), nil
+}
+
+func (f *gitHub) FindOpenIssue(title string) (string, error) {
+	repo, err := f.repository()
+	if err != nil {
+		return "", err
+	}
+	listCmd := exec.Command("gh", "issue", "list",
+		"--repo", repo,
+		"--state", "open",
+		"--search", fmt.Sprintf("in:title \"%s\"", title),
+		"--json", "title,url")
+	out, err := listCmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to list GitHub issues: %w", err)
+	}
+	if strings.TrimSpace(string(out)) == "" {
+		return "", nil
+	}
+
+	var issues []struct {
+		Title string `json:"title"`
+		URL   string `json:"url"`
+	}
+	if err = json.Unmarshal(out, &issues); err != nil {
+		return "", fmt.Errorf("failed to parse GitHub issues: %w", err)
+	}
+	for _, issue := range issues {
+		if issue.Title == title {
+			return issue.URL, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *gitHub) CreateIssue(title, body string) (string, error) {
+	repo, err := f.repository()
+	if err != nil {
+		return "", err
+	}
+	createCmd := exec.Command("gh", "issue", "create",
+		"--repo", repo,
+		"--title", title,
+		"--body", body,
+	)
+	createOut, createErr := createCmd.CombinedOutput()
+	if createErr != nil {
+		return "", fmt.Errorf("failed to create GitHub issue: %v\n%s", createErr, string(createOut))
+	}
+	outStr := string(createOut)
+
+	// Try to extract the issue URL from stdout
+	if issueURL := helpers.FirstURL(outStr); issueURL != "" {
+		return issueURL, nil
+	}
+
+	// Fallback: query the just-created issue by title
+	issueURL, err := f.FindOpenIssue(title)
+	if err != nil {
+		log.Warn("could not determine issue URL from gh output", "stdout", outStr, "error", err)
+	}
+	return issueURL, nil
+}
+
+func (f *gitHub) CreateMergeRequest(base, head, title, body string) (string, error) {
+	repo, err := f.repository()
+	if err != nil {
+		return "", err
+	}
+	createCmd := exec.Command("gh", "pr", "create",
+		"--repo", repo,
+		"--base", base,
+		"--head", head,
+		"--title", title,
+		"--body", body,
+	)
+	createOut, createErr := createCmd.CombinedOutput()
+	if createErr != nil {
+		return "", fmt.Errorf("failed to create GitHub pull request: %v\n%s", createErr, string(createOut))
+	}
+	return helpers.FirstURL(string(createOut)), nil
+}
+
+func (f *gitHub) FindOpenMergeRequests(base string) ([]MergeRequest, error) {
+	repo, err := f.repository()
+	if err != nil {
+		return nil, err
+	}
+	listCmd := exec.Command("gh", "pr", "list",
+		"--repo", repo,
+		"--state", "open",
+		"--base", base,
+		"--limit", "100",
+		"--json", "number,headRefName,headRefOid,url")
+	out, err := listCmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list GitHub pull requests: %w", err)
+	}
+	if strings.TrimSpace(string(out)) == "" {
+		return nil, nil
+	}
+
+	var pullRequests []struct {
+		Number      int    `json:"number"`
+		HeadRefName string `json:"headRefName"`
+		HeadRefOid  string `json:"headRefOid"`
+		URL         string `json:"url"`
+	}
+	if err = json.Unmarshal(out, &pullRequests); err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub pull requests: %w", err)
+	}
+	mergeRequests := make([]MergeRequest, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		mergeRequests = append(mergeRequests, MergeRequest{
+			Number:  pr.Number,
+			Head:    pr.HeadRefName,
+			HeadSHA: pr.HeadRefOid,
+			URL:     pr.URL,
+		})
+	}
+	return mergeRequests, nil
+}
+
+func (f *gitHub) UpdateMergeRequest(mr MergeRequest, title, body string) error {
+	repo, err := f.repository()
+	if err != nil {
+		return err
+	}
+	editCmd := exec.Command("gh", "pr", "edit", strconv.Itoa(mr.Number),
+		"--repo", repo,
+		"--title", title,
+		"--body", body,
+	)
+	if editOut, editErr := editCmd.CombinedOutput(); editErr != nil {
+		return fmt.Errorf("failed to update GitHub pull request %s: %v\n%s", mr.URL, editErr, string(editOut))
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+const (
+	// gitLabTokenEnv is the environment variable with the access token used to call the GitLab API.
+	gitLabTokenEnv = "GITLAB_TOKEN"
+	// gitLabServerURLEnv and gitLabProjectPathEnv are set by GitLab CI/CD in every job.
+	gitLabServerURLEnv   = "CI_SERVER_URL"
+	gitLabProjectPathEnv = "CI_PROJECT_PATH"
+)
+
+var _ Forge = &gitLab{}
+
+// gitLab opens issues and merge requests with the GitLab REST API.
+type gitLab struct {
+	repo repository
+	api  *apiClient
+}
+
+func newGitLab() (*gitLab, error) {
+	token := os.Getenv(gitLabTokenEnv)
+	if token == "" {
+		return nil, fmt.Errorf("%s must be set to an access token with the api scope to use the %s forge",
+			gitLabTokenEnv, GitLab)
+	}
+	repo, err := resolveRepository(gitLabServerURLEnv, gitLabProjectPathEnv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect GitLab project: %w", err)
+	}
+
+	return &gitLab{
+		repo: repo,
+		api:  newAPIClient(repo.serverURL+"/api/v4", "PRIVATE-TOKEN", "", token),
+	}, nil
+}
+
+func (f *gitLab) Name() string {
+	return GitLab
+}
+
+// projectPath returns the API path of the project, which is identified by its URL-encoded path.
+func (f *gitLab) projectPath() string {
+	return "/projects/" + url.PathEscape(f.repo.path)
+}
+
+func (f *gitLab) CompareURL(base, head string) (string, error) {
+	query := url.Values{}
+	query.Set("merge_request[source_branch]", head)
+	query.Set("merge_request[target_branch]", base)
+	return fmt.Sprintf("%s/%s/-/merge_requests/new?%s", f.repo.serverURL, f.repo.path, query.Encode()), nil
+}
+
+func (f *gitLab) FindOpenIssue(title string) (string, error) {
+	query := url.Values{}
+	query.Set("state", "opened")
+	query.Set("in", "title")
+	query.Set("search", title)
+
+	var issues []struct {
+		Title  string `json:"title"`
+		WebURL string `json:"web_url"`
+	}
+	if err := f.api.do(http.MethodGet, f.projectPath()+"/issues?"+query.Encode(), nil, &issues); err != nil {
+		return "", fmt.Errorf("failed to list GitLab issues: %w", err)
+	}
+	for _, issue := range issues {
+		if issue.Title == title {
+			return issue.WebURL, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *gitLab) CreateIssue(title, body string) (string, error) {
+	in := map[string]string{
+		"title":       title,
+		"description": body,
+	}
+	var issue struct {
+		WebURL string `json:"web_url"`
+	}
+	if err := f.api.do(http.MethodPost, f.projectPath()+"/issues", in, &issue); err != nil {
+		return "", fmt.Errorf("failed to create GitLab issue: %w", err)
+	}
+	return issue.WebURL, nil
+}
+
+func (f *gitLab) CreateMergeRequest(base, head, title, body string) (string, error) {
+	in := map[string]string{
+		"source_branch": head,
+		"target_branch": base,
+		"title":         title,
+		"description":   body,
+	}
+	var mergeRequest struct {
+		WebURL string `json:"web_url"`
+	}
+	if err := f.api.do(http.MethodPost, f.projectPath()+"/merge_requests", in, &mergeRequest); err != nil {
+		return "", fmt.Errorf("failed to create GitLab merge request: %w", err)
+	}
+	return mergeRequest.WebURL, nil
+}
+
+func (f *gitLab) FindOpenMergeRequests(base string) ([]MergeRequest, error) {
+	query := url.Values{}
+	query.Set("state", "opened")
+	query.Set("target_branch", base)
+	query.Set("per_page", "100")
+
+	var mergeRequests []struct {
+		IID          int    `json:"iid"`
+		SourceBranch string `json:"source_branch"`
+		SHA          string `json:"sha"`
+		WebURL       string `json:"web_url"`
+	}
+	if err := f.api.do(http.MethodGet, f.projectPath()+"/merge_requests?"+query.Encode(), nil,
+		&mergeRequests); err != nil {
+		return nil, fmt.Errorf("failed to list GitLab merge requests: %w", err)
+	}
+
+	result := make([]MergeRequest, 0, len(mergeRequests))
+	for _, mr := range mergeRequests {
+		result = append(result, MergeRequest{Number: mr.IID, Head: mr.SourceBranch, HeadSHA: mr.SHA, URL: mr.WebURL})
+	}
+	return result, nil
+}
+
+func (f *gitLab) UpdateMergeRequest(mr MergeRequest, title, body string) error {
+	in := map[string]string{
+		"title":       title,
+		"description": body,
+	}
+	path := f.projectPath() + "/merge_requests/" + strconv.Itoa(mr.Number)
+	if err := f.api.do(http.MethodPut, path, in, nil); err != nil {
+		return fmt.Errorf("failed to update GitLab merge request %s: %w", mr.URL, err)
+	}
+	return nil
+}
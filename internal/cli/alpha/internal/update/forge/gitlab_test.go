@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GitLab", func() {
+	var (
+		server   *httptest.Server
+		requests []*http.Request
+		bodies   []map[string]string
+		f        *gitLab
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			body := map[string]string{}
+			if r.Body != nil && r.ContentLength > 0 {
+				_ = json.NewDecoder(r.Body).Decode(&body)
+			}
+			bodies = append(bodies, body)
+
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/group/project/issues":
+				_, _ = w.Write([]byte(`[{"title":"Other","web_url":"https://gitlab.test/issues/1"},` +
+					`{"title":"Upgrade","web_url":"https://gitlab.test/issues/2"}]`))
+			case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/group/project/issues":
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"web_url":"https://gitlab.test/issues/3"}`))
+			case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/group/project/merge_requests":
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"web_url":"https://gitlab.test/merge_requests/4"}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/group/project/merge_requests":
+				_, _ = w.Write([]byte(`[{"iid":5,"source_branch":"kubebuilder-update","sha":"abc123",` +
+					`"web_url":"https://gitlab.test/merge_requests/5"}]`))
+			case r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/group/project/merge_requests/5":
+				_, _ = w.Write([]byte(`{"web_url":"https://gitlab.test/merge_requests/5"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"404 Project Not Found"}`))
+			}
+		}))
+
+		GinkgoT().Setenv(gitLabTokenEnv, "secret")
+		GinkgoT().Setenv(gitLabServerURLEnv, server.URL)
+		GinkgoT().Setenv(gitLabProjectPathEnv, "group/project")
+
+		var err error
+		f, err = newGitLab()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should build the URL to open a merge request", func() {
+		compareURL, err := f.CompareURL("main", "kubebuilder-update")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compareURL).To(Equal(server.URL + "/group/project/-/merge_requests/new?" +
+			"merge_request%5Bsource_branch%5D=kubebuilder-update&merge_request%5Btarget_branch%5D=main"))
+	})
+
+	It("should find an open issue by title", func() {
+		issueURL, err := f.FindOpenIssue("Upgrade")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issueURL).To(Equal("https://gitlab.test/issues/2"))
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.RawPath).To(Equal("/api/v4/projects/group%2Fproject/issues"))
+		Expect(requests[0].URL.Query().Get("state")).To(Equal("opened"))
+		Expect(requests[0].URL.Query().Get("search")).To(Equal("Upgrade"))
+		Expect(requests[0].Header.Get("PRIVATE-TOKEN")).To(Equal("secret"))
+
+		issueURL, err = f.FindOpenIssue("Missing")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issueURL).To(BeEmpty())
+	})
+
+	It("should create an issue", func() {
+		issueURL, err := f.CreateIssue("Upgrade", "body")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issueURL).To(Equal("https://gitlab.test/issues/3"))
+		Expect(bodies[0]).To(Equal(map[string]string{"title": "Upgrade", "description": "body"}))
+	})
+
+	It("should create a merge request", func() {
+		mrURL, err := f.CreateMergeRequest("main", "kubebuilder-update", "Upgrade", "body")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mrURL).To(Equal("https://gitlab.test/merge_requests/4"))
+		Expect(bodies[0]).To(Equal(map[string]string{
+			"source_branch": "kubebuilder-update",
+			"target_branch": "main",
+			"title":         "Upgrade",
+			"description":   "body",
+		}))
+	})
+
+	It("should find the open merge requests into a branch", func() {
+		mergeRequests, err := f.FindOpenMergeRequests("main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mergeRequests).To(Equal([]MergeRequest{
+			{Number: 5, Head: "kubebuilder-update", HeadSHA: "abc123", URL: "https://gitlab.test/merge_requests/5"},
+		}))
+		Expect(requests[0].URL.Query().Get("state")).To(Equal("opened"))
+		Expect(requests[0].URL.Query().Get("target_branch")).To(Equal("main"))
+	})
+
+	It("should update a merge request", func() {
+		mr := MergeRequest{Number: 5, Head: "kubebuilder-update", URL: "https://gitlab.test/merge_requests/5"}
+		Expect(f.UpdateMergeRequest(mr, "Upgrade", "new body")).To(Succeed())
+		Expect(requests[0].Method).To(Equal(http.MethodPut))
+		Expect(bodies[0]).To(Equal(map[string]string{"title": "Upgrade", "description": "new body"}))
+	})
+
+	It("should report the API errors", func() {
+		GinkgoT().Setenv(gitLabProjectPathEnv, "group/missing")
+		missing, err := newGitLab()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = missing.CreateIssue("Upgrade", "body")
+		Expect(err).To(MatchError(ContainSubstring("failed to create GitLab issue")))
+		Expect(err).To(MatchError(ContainSubstring("returned 404: {\"message\":\"404 Project Not Found\"}")))
+	})
+})
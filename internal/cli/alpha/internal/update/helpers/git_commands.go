@@ -58,6 +58,16 @@ func GitCmd(gitConfig []string, args ...string) *exec.Cmd {
 	return exec.Command("git", gitArgs...)
 }
 
+// ScaffoldCommitMessage returns the commit message for the scaffold of a release version
+func ScaffoldCommitMessage(version string) string {
+	return "(chore) initial scaffold from release version: " + version
+}
+
+// OriginalCommitMessage returns the commit message for the code of the branch being updated
+func OriginalCommitMessage(branch string) string {
+	return fmt.Sprintf("(chore) original code from %s to keep changes", branch)
+}
+
 // MergeCommitMessage returns the commit message for a successful merge update
 func MergeCommitMessage(from, to string) string {
 	return fmt.Sprintf("chore(kubebuilder): update scaffold %s -> %s", from, to)
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PullRequestTitleTmpl is the title template for the pull request.
+const PullRequestTitleTmpl = "Upgrade the Scaffold: %[2]s -> %[1]s"
+
+// UpdateBranchPrefix is the prefix of the default output branch name of the updates.
+const UpdateBranchPrefix = "kubebuilder-update-from-"
+
+// PullRequestBody returns the body of the pull request that proposes the update of the output branch.
+// It summarizes the conflicts found during the merge and the make targets to run.
+//
+//nolint:lll
+func PullRequestBody(toVersion, fromVersion, outputBranch string, conflicts ConflictResult) string {
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, `## Description
+
+Upgrade your project to use the latest scaffold changes introduced in Kubebuilder [%[1]s](https://github.com/kubernetes-sigs/kubebuilder/releases/tag/%[1]s).
+
+See the release notes from [%[2]s](https://github.com/kubernetes-sigs/kubebuilder/releases/tag/%[2]s) to [%[1]s](https://github.com/kubernetes-sigs/kubebuilder/releases/tag/%[1]s) for details about the changes included in this upgrade.
+
+The changes were generated by `+"`kubebuilder alpha update`"+` in the branch %[3]s.
+
+`, toVersion, fromVersion, outputBranch)
+
+	targets := DecideMakeTargets(conflicts.Summary)
+	hasConflicts := len(conflicts.SourceFiles) > 0 || len(conflicts.GeneratedFiles) > 0 ||
+		conflicts.Summary != ConflictSummary{}
+
+	b.WriteString("## Conflicts\n\n")
+	if !hasConflicts {
+		b.WriteString(":white_check_mark: No conflicts were detected during the merge.\n\n")
+	} else {
+		b.WriteString(":warning: **Conflicts were detected during the merge.** " +
+			"The files were committed with conflict markers that must be resolved before merging.\n\n")
+		b.WriteString("| Area | Conflicts |\n|------|-----------|\n")
+		_, _ = fmt.Fprintf(&b, "| Makefile | %s |\n", yesNo(conflicts.Summary.Makefile))
+		_, _ = fmt.Fprintf(&b, "| APIs (`api/`, `apis/`) | %s |\n", yesNo(conflicts.Summary.API))
+		_, _ = fmt.Fprintf(&b, "| Go files | %s |\n\n", yesNo(conflicts.Summary.AnyGo))
+		writeFileList(&b, "Source files to resolve:", conflicts.SourceFiles)
+		writeFileList(&b, "Generated files (regenerate them with the make targets below):", conflicts.GeneratedFiles)
+	}
+
+	b.WriteString("## Make targets\n\n")
+	switch {
+	case !hasConflicts:
+		_, _ = fmt.Fprintf(&b, "The update ran `make %s`.\n\n", strings.Join(targets, " "))
+	case conflicts.Summary.Makefile:
+		b.WriteString("No make targets were run because the Makefile has conflicts.\n\n")
+	case len(targets) == 0:
+		b.WriteString("No make targets were run because of the conflicts in the APIs and Go files.\n\n")
+	default:
+		_, _ = fmt.Fprintf(&b, "Because of the conflicts, the update only ran `make %s`.\n\n", strings.Join(targets, " "))
+	}
+	if hasConflicts {
+		b.WriteString("After fixing conflicts, run:\n~~~bash\nmake manifests generate fmt vet lint-fix\n~~~\n\n")
+	}
+
+	b.WriteString(`## Next steps
+
+**Verify the changes**
+- Build the project
+- Run tests
+- Confirm everything still works
+
+:book: **More info:** https://kubebuilder.io/reference/commands/alpha_update
+`)
+	return b.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// writeFileList writes a title followed by the files as a list, if there are any.
+func writeFileList(b *strings.Builder, title string, files []string) {
+	if len(files) == 0 {
+		return
+	}
+	b.WriteString(title + "\n")
+	for _, f := range files {
+		_, _ = fmt.Fprintf(b, "- `%s`\n", f)
+	}
+	b.WriteString("\n")
+}
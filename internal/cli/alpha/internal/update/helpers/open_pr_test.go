@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Open Pull Request Helpers", func() {
+	Describe("PullRequestBody", func() {
+		It("should report that no conflicts were found", func() {
+			body := PullRequestBody("v4.8.0", "v4.7.0", "kubebuilder-update-from-v4.7.0-to-v4.8.0", ConflictResult{})
+
+			Expect(body).To(ContainSubstring("releases/tag/v4.8.0"))
+			Expect(body).To(ContainSubstring("in the branch kubebuilder-update-from-v4.7.0-to-v4.8.0"))
+			Expect(body).To(ContainSubstring("No conflicts were detected during the merge."))
+			Expect(body).To(ContainSubstring("The update ran `make manifests generate fmt vet lint-fix`."))
+			Expect(body).NotTo(ContainSubstring("After fixing conflicts"))
+		})
+
+		It("should summarize the conflicts and the make targets that were run", func() {
+			body := PullRequestBody("v4.8.0", "v4.7.0", "out", ConflictResult{
+				Summary:        ConflictSummary{API: true},
+				SourceFiles:    []string{"api/v1/cronjob_types.go"},
+				GeneratedFiles: []string{"config/crd/bases/batch.tutorial.kubebuilder.io_cronjobs.yaml"},
+			})
+
+			Expect(body).To(ContainSubstring("Conflicts were detected during the merge."))
+			Expect(body).To(ContainSubstring("| Makefile | no |"))
+			Expect(body).To(ContainSubstring("| APIs (`api/`, `apis/`) | yes |"))
+			Expect(body).To(ContainSubstring("Source files to resolve:\n- `api/v1/cronjob_types.go`"))
+			Expect(body).To(ContainSubstring("- `config/crd/bases/batch.tutorial.kubebuilder.io_cronjobs.yaml`"))
+			Expect(body).To(ContainSubstring("the update only ran `make fmt vet lint-fix`."))
+			Expect(body).To(ContainSubstring("make manifests generate fmt vet lint-fix\n~~~"))
+		})
+
+		It("should explain why no make targets were run", func() {
+			body := PullRequestBody("v4.8.0", "v4.7.0", "out", ConflictResult{
+				Summary:     ConflictSummary{Makefile: true},
+				SourceFiles: []string{"Makefile"},
+			})
+			Expect(body).To(ContainSubstring("No make targets were run because the Makefile has conflicts."))
+
+			body = PullRequestBody("v4.8.0", "v4.7.0", "out", ConflictResult{
+				Summary: ConflictSummary{API: true, AnyGo: true},
+			})
+			Expect(body).To(ContainSubstring("No make targets were run because of the conflicts in the APIs and Go files."))
+		})
+	})
+})
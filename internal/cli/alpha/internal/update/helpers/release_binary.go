@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	log "log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// ReleaseAssetName returns the name of the release binary for the current platform,
+// e.g. "kubebuilder_linux_amd64".
+func ReleaseAssetName() string {
+	return fmt.Sprintf("kubebuilder_%s_%s", runtime.GOOS, runtime.GOARCH)
+}
+
+// ReleaseBinaryKey returns the path of the release binary of the version relative to the release
+// downloads, e.g. "v4.7.1/kubebuilder_linux_amd64". It is used as the layout of the binary cache
+// and as the name of the binaries in checksum manifests.
+func ReleaseBinaryKey(version string) string {
+	return version + "/" + ReleaseAssetName()
+}
+
+// CachedReleaseBinary returns the path of the release binary of the version in the cache directory,
+// and whether it exists.
+func CachedReleaseBinary(cacheDir, version string) (string, bool) {
+	path := filepath.Join(cacheDir, filepath.FromSlash(ReleaseBinaryKey(version)))
+	info, err := os.Stat(path)
+	return path, err == nil && info.Mode().IsRegular()
+}
+
+// LatestCachedRelease returns the most recent release version with a binary in the cache directory,
+// or an empty string if there is none.
+func LatestCachedRelease(cacheDir string) string {
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		return ""
+	}
+	latest := ""
+	for _, entry := range entries {
+		version := entry.Name()
+		if !entry.IsDir() || !semver.IsValid(version) {
+			continue
+		}
+		if _, found := CachedReleaseBinary(cacheDir, version); !found {
+			continue
+		}
+		if latest == "" || semver.Compare(version, latest) > 0 {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// StoreReleaseBinary copies the binary of the release version into the cache directory.
+func StoreReleaseBinary(cacheDir, version, binaryPath string) error {
+	path := filepath.Join(cacheDir, filepath.FromSlash(ReleaseBinaryKey(version)))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create the cache directory: %w", err)
+	}
+	if err := copyExecutable(binaryPath, path); err != nil {
+		return fmt.Errorf("failed to store the binary in the cache: %w", err)
+	}
+	return nil
+}
+
+// CopyReleaseBinary copies a local kubebuilder binary into a temporary directory with executable
+// permissions, as DownloadReleaseVersionWith does for downloaded binaries.
+// Returns the temporary directory path containing the binary.
+func CopyReleaseBinary(version, binaryPath string) (string, error) {
+	tempDir, err := os.MkdirTemp("", "kubebuilder"+version+"-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	if err = copyExecutable(binaryPath, filepath.Join(tempDir, "kubebuilder")); err != nil {
+		if rmErr := os.RemoveAll(tempDir); rmErr != nil {
+			log.Error("failed to remove temporary directory", "dir", tempDir, "error", rmErr)
+		}
+		return "", fmt.Errorf("failed to copy the binary %s: %w", binaryPath, err)
+	}
+	return tempDir, nil
+}
+
+// copyExecutable copies the file at src to dst with executable permissions.
+func copyExecutable(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer func() {
+		if closeErr := in.Close(); closeErr != nil {
+			log.Error("failed to close the file", "path", src, "error", closeErr)
+		}
+	}()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("failed to write %s: %w", dst, err)
+	}
+	if err = out.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", dst, err)
+	}
+	return nil
+}
+
+// binaryVersionRegexp matches the version printed by `kubebuilder version`, both in the current format
+// ("KubeBuilder:  v4.7.1") and in the format of older releases (`KubeBuilderVersion:"4.5.2"`).
+var binaryVersionRegexp = regexp.MustCompile(`KubeBuilder(?:Version)?:\s*"?(v?\d+\.\d+\.\d+[^\s",]*)`)
+
+// BinaryVersion returns the version reported by `kubebuilder version` for the binary, with the v prefix.
+func BinaryVersion(binaryPath string) (string, error) {
+	out, err := exec.Command(binaryPath, "version").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to run %s version: %w", binaryPath, err)
+	}
+	match := binaryVersionRegexp.FindSubmatch(out)
+	if match == nil {
+		return "", fmt.Errorf("unable to find the version in the output of %s version", binaryPath)
+	}
+	version := string(match[1])
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return version, nil
+}
+
+// ChecksumManifest holds the expected SHA-256 checksums of release binaries, keyed by ReleaseBinaryKey.
+type ChecksumManifest map[string]string
+
+// LoadChecksumManifest reads a checksum manifest in the format of `sha256sum`, with one
+// "<checksum>  <version>/kubebuilder_<os>_<arch>" line per binary. Empty lines and lines starting
+// with # are ignored.
+func LoadChecksumManifest(path string) (ChecksumManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open checksum manifest: %w", err)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Error("failed to close the checksum manifest", "error", closeErr)
+		}
+	}()
+
+	manifest := ChecksumManifest{}
+	sc := bufio.NewScanner(f)
+	for lineNumber := 1; sc.Scan(); lineNumber++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid line %d in checksum manifest %s: expected <checksum> <name>",
+				lineNumber, path)
+		}
+		checksum := strings.ToLower(fields[0])
+		if sum, decodeErr := hex.DecodeString(checksum); decodeErr != nil || len(sum) != sha256.Size {
+			return nil, fmt.Errorf("invalid SHA-256 checksum at line %d in checksum manifest %s", lineNumber, path)
+		}
+		// sha256sum marks the files read in binary mode with a leading '*'
+		manifest[strings.TrimPrefix(fields[1], "*")] = checksum
+	}
+	if err = sc.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read checksum manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// Verify checks that the binary matches the checksum of the release version in the manifest.
+func (m ChecksumManifest) Verify(version, binaryPath string) error {
+	key := ReleaseBinaryKey(version)
+	expected, found := m[key]
+	if !found {
+		return fmt.Errorf("no checksum found for %s in the checksum manifest", key)
+	}
+
+	actual, err := fileChecksum(binaryPath)
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", key, expected, actual)
+	}
+	return nil
+}
+
+// VersionOf returns the release version whose binary for the current OS and architecture has the checksum
+// of the provided binary, without running it.
+func (m ChecksumManifest) VersionOf(binaryPath string) (string, error) {
+	actual, err := fileChecksum(binaryPath)
+	if err != nil {
+		return "", err
+	}
+	for key, expected := range m {
+		version, asset, found := strings.Cut(key, "/")
+		if found && asset == ReleaseAssetName() && expected == actual {
+			return version, nil
+		}
+	}
+	return "", fmt.Errorf("the checksum of %s does not match any %s release binary in the checksum manifest",
+		binaryPath, ReleaseAssetName())
+}
+
+// fileChecksum returns the hex encoded SHA-256 checksum of a file.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil && !errors.Is(closeErr, fs.ErrClosed) {
+			log.Error("failed to close the binary", "error", closeErr)
+		}
+	}()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to compute the checksum of %s: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Release Binary Helpers", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	writeBinary := func(path, content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o755)).To(Succeed())
+	}
+
+	checksum := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+
+	Describe("binary cache", func() {
+		It("should find, store and list cached release binaries", func() {
+			_, found := CachedReleaseBinary(tmpDir, "v4.7.0")
+			Expect(found).To(BeFalse())
+			Expect(LatestCachedRelease(tmpDir)).To(BeEmpty())
+
+			src := filepath.Join(tmpDir, "kubebuilder")
+			writeBinary(src, "binary")
+			Expect(StoreReleaseBinary(filepath.Join(tmpDir, "cache"), "v4.7.0", src)).To(Succeed())
+			Expect(StoreReleaseBinary(filepath.Join(tmpDir, "cache"), "v4.10.0", src)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(tmpDir, "cache", "v4.11.0"), 0o755)).To(Succeed())
+
+			path, found := CachedReleaseBinary(filepath.Join(tmpDir, "cache"), "v4.7.0")
+			Expect(found).To(BeTrue())
+			Expect(path).To(Equal(filepath.Join(tmpDir, "cache", "v4.7.0", ReleaseAssetName())))
+			Expect(LatestCachedRelease(filepath.Join(tmpDir, "cache"))).To(Equal("v4.10.0"))
+		})
+
+		It("should copy a local binary into a temporary directory", func() {
+			src := filepath.Join(tmpDir, "kubebuilder-local")
+			writeBinary(src, "binary")
+
+			dir, err := CopyReleaseBinary("v4.7.0", src)
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+
+			info, err := os.Stat(filepath.Join(dir, "kubebuilder"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm() & 0o111).NotTo(BeZero())
+			Expect(src).To(BeAnExistingFile())
+		})
+
+		It("should fail to copy a missing binary", func() {
+			_, err := CopyReleaseBinary("v4.7.0", filepath.Join(tmpDir, "missing"))
+			Expect(err).To(MatchError(ContainSubstring("failed to copy the binary")))
+		})
+	})
+
+	Describe("BinaryVersion", func() {
+		It("should parse the version of current and older releases", func() {
+			current := filepath.Join(tmpDir, "current")
+			writeBinary(current, "#!/bin/sh\necho 'KubeBuilder:          v4.7.1'\n")
+			version, err := BinaryVersion(current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("v4.7.1"))
+
+			older := filepath.Join(tmpDir, "older")
+			writeBinary(older, "#!/bin/sh\necho 'Version: main.version{KubeBuilderVersion:\"4.5.2\", GoOs:\"linux\"}'\n")
+			version, err = BinaryVersion(older)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("v4.5.2"))
+		})
+
+		It("should fail when the version is not printed", func() {
+			binary := filepath.Join(tmpDir, "other")
+			writeBinary(binary, "#!/bin/sh\necho 'something else'\n")
+			_, err := BinaryVersion(binary)
+			Expect(err).To(MatchError(ContainSubstring("unable to find the version")))
+		})
+	})
+
+	Describe("ChecksumManifest", func() {
+		var binary, manifestPath string
+
+		BeforeEach(func() {
+			binary = filepath.Join(tmpDir, "kubebuilder")
+			writeBinary(binary, "binary")
+			manifestPath = filepath.Join(tmpDir, "SHA256SUMS")
+		})
+
+		It("should verify binaries against the manifest", func() {
+			content := "# kubebuilder releases\n\n" +
+				checksum("binary") + " *" + ReleaseBinaryKey("v4.7.0") + "\n" +
+				checksum("other") + "  " + ReleaseBinaryKey("v4.8.0") + "\n"
+			Expect(os.WriteFile(manifestPath, []byte(content), 0o644)).To(Succeed())
+
+			manifest, err := LoadChecksumManifest(manifestPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifest).To(HaveLen(2))
+
+			Expect(manifest.Verify("v4.7.0", binary)).To(Succeed())
+			Expect(manifest.Verify("v4.8.0", binary)).To(MatchError(ContainSubstring("checksum mismatch")))
+			Expect(manifest.Verify("v4.9.0", binary)).To(MatchError(ContainSubstring("no checksum found")))
+		})
+
+		It("should reject invalid manifests", func() {
+			Expect(os.WriteFile(manifestPath, []byte("abc "+ReleaseBinaryKey("v4.7.0")+"\n"), 0o644)).To(Succeed())
+			_, err := LoadChecksumManifest(manifestPath)
+			Expect(err).To(MatchError(ContainSubstring("invalid SHA-256 checksum at line 1")))
+
+			Expect(os.WriteFile(manifestPath, []byte(checksum("binary")+"\n"), 0o644)).To(Succeed())
+			_, err = LoadChecksumManifest(manifestPath)
+			Expect(err).To(MatchError(ContainSubstring("invalid line 1")))
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/common"
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config/store"
 )
 
@@ -56,7 +57,10 @@ func (opts *Update) Prepare() error {
 	if err != nil {
 		return fmt.Errorf("failed to determine the version to use for the upgrade from: %w", err)
 	}
-	opts.ToVersion = opts.defineToVersion()
+	opts.ToVersion, err = opts.defineToVersion()
+	if err != nil {
+		return fmt.Errorf("failed to determine the version to use for the upgrade to: %w", err)
+	}
 	return nil
 }
 
@@ -80,17 +84,40 @@ func (opts *Update) defineFromVersion(config store.Store) (string, error) {
 	return fromVersion, nil
 }
 
-func (opts *Update) defineToVersion() string {
+// defineToVersion will return the CLI version to update to with the v prefix. Without --to-version, it is the
+// version of --to-binary, or else the latest release.
+func (opts *Update) defineToVersion() (string, error) {
 	if len(opts.ToVersion) != 0 {
 		if !strings.HasPrefix(opts.ToVersion, "v") {
-			return "v" + opts.ToVersion
+			return "v" + opts.ToVersion, nil
+		}
+		return opts.ToVersion, nil
+	}
+
+	if len(opts.ToBinary) != 0 {
+		version, err := opts.localBinaryVersion(opts.ToBinary)
+		if err != nil {
+			return "", fmt.Errorf("unable to determine the version of --to-binary, "+
+				"please use --to-version flag to specify it: %w", err)
 		}
-		return opts.ToVersion
+		log.Info("Using the version of --to-binary as --to-version", "version", version)
+		return version, nil
 	}
 
-	opts.ToVersion, _ = fetchLatestRelease()
+	latestVersion, err := fetchLatestRelease()
+	if err != nil {
+		// Offline, fall back to the most recent release in the binary cache
+		if len(opts.BinaryCacheDir) != 0 {
+			if cached := helpers.LatestCachedRelease(opts.BinaryCacheDir); cached != "" {
+				log.Info("Unable to fetch the latest release, using the latest cached release", "version", cached)
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("unable to determine the latest release, "+
+			"please use --to-version flag to specify the version to update to: %w", err)
+	}
 
-	return opts.ToVersion
+	return latestVersion, nil
 }
 
 func fetchLatestRelease() (string, error) {
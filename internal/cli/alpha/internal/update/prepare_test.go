@@ -19,15 +19,19 @@ limitations under the License.
 package update
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/h2non/gock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/common"
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
 	v3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
@@ -194,7 +198,8 @@ exit 0`
 	Context("DefineToVersion", func() {
 		DescribeTable("Should succeed.",
 			func(options *Update) {
-				toVersion := options.defineToVersion()
+				toVersion, errDefine := options.defineToVersion()
+				Expect(errDefine).NotTo(HaveOccurred())
 				Expect(toVersion).To(BeEquivalentTo("v1.1.0"))
 			},
 			Entry("options", &Update{ToVersion: "1.1.0"}),
@@ -209,7 +214,7 @@ exit 0`
 			opts.FromVersion = "v4.5.1"
 			opts.ToVersion = "v4.8.0"
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).ToNot(HaveOccurred())
 
 			logs, readErr := os.ReadFile(logFile)
@@ -231,7 +236,7 @@ exit 0`
 			opts.FromVersion = "v4.5.2"
 			opts.ToVersion = "v4.10.0"
 
-			err = opts.openGitHubIssue(true)
+			err = opts.openIssue(true)
 			Expect(err).ToNot(HaveOccurred())
 
 			logs, _ := os.ReadFile(logFile)
@@ -249,7 +254,7 @@ fi
 exit 0`
 			Expect(mockBinResponse(failRepo, mockGh)).To(Succeed())
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to detect GitHub repository"))
 		})
@@ -271,17 +276,187 @@ exit 0`
 			opts.FromVersion = testFromVersion
 			opts.ToVersion = testToVersion
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to create GitHub issue: exit status 1"))
 		})
 	})
 
+	Context("OpenPullRequest", func() {
+		var mockGit string
+
+		// ghWithPullRequests returns a gh mock that lists the provided open pull requests
+		ghWithPullRequests := func(prs string) string {
+			return `#!/bin/bash
+echo "$@" >> "` + logFile + `"
+if [[ "$1" == "repo" && "$2" == "view" ]]; then
+  echo "acme/repo"
+  exit 0
+fi
+if [[ "$1" == "pr" && "$2" == "list" ]]; then
+  echo '` + prs + `'
+  exit 0
+fi
+if [[ "$1" == "pr" && "$2" == "create" ]]; then
+  echo "https://github.com/acme/repo/pull/8"
+  exit 0
+fi
+exit 0`
+		}
+
+		BeforeEach(func() {
+			opts.FromBranch = defaultBranch
+			opts.FromVersion = testFromVersion
+			opts.ToVersion = testToVersion
+
+			mockGit = filepath.Join(tmpDir, "git")
+			Expect(mockBinResponse(`#!/bin/bash
+echo "git $@" >> "`+logFile+`"
+exit 0`, mockGit)).To(Succeed())
+		})
+
+		It("creates a pull request from the output branch", func() {
+			Expect(mockBinResponse(ghWithPullRequests(`[]`), mockGh)).To(Succeed())
+
+			err = opts.openPullRequest()
+			Expect(err).ToNot(HaveOccurred())
+
+			logs, readErr := os.ReadFile(logFile)
+			Expect(readErr).ToNot(HaveOccurred())
+			s := string(logs)
+			Expect(s).To(ContainSubstring("pr list --repo acme/repo --state open --base " + defaultBranch))
+			Expect(s).To(ContainSubstring(fmt.Sprintf("pr create --repo acme/repo --base %s --head %s",
+				defaultBranch, opts.getOutputBranchName())))
+			Expect(s).To(ContainSubstring("Upgrade the Scaffold: v4.5.0 -> v4.6.0"))
+			Expect(s).To(ContainSubstring("No conflicts were detected during the merge."))
+			Expect(s).To(ContainSubstring("The update ran `make manifests generate fmt vet lint-fix`."))
+			Expect(s).NotTo(ContainSubstring("pr edit"))
+		})
+
+		It("includes the conflicts in the pull request body", func() {
+			Expect(mockBinResponse(ghWithPullRequests(`[]`), mockGh)).To(Succeed())
+			opts.conflicts = helpers.ConflictResult{
+				Summary:     helpers.ConflictSummary{AnyGo: true},
+				SourceFiles: []string{"cmd/main.go"},
+			}
+
+			err = opts.openPullRequest()
+			Expect(err).ToNot(HaveOccurred())
+
+			logs, _ := os.ReadFile(logFile)
+			s := string(logs)
+			Expect(s).To(ContainSubstring("- `cmd/main.go`"))
+			Expect(s).To(ContainSubstring("the update only ran `make manifests generate`."))
+		})
+
+		It("updates the pull request opened for the same output branch", func() {
+			out := opts.getOutputBranchName()
+			Expect(mockBinResponse(ghWithPullRequests(
+				`[{"number":7,"headRefName":"`+out+`","url":"https://github.com/acme/repo/pull/7"}]`), mockGh)).To(Succeed())
+
+			err = opts.openPullRequest()
+			Expect(err).ToNot(HaveOccurred())
+
+			logs, _ := os.ReadFile(logFile)
+			s := string(logs)
+			Expect(s).To(ContainSubstring("pr edit 7 --repo acme/repo --title Upgrade the Scaffold: v4.5.0 -> v4.6.0"))
+			Expect(s).NotTo(ContainSubstring("pr create"))
+			Expect(s).NotTo(ContainSubstring("git push"))
+		})
+
+		It("replaces the branch of the pull request of an earlier update", func() {
+			const earlier = "kubebuilder-update-from-v4.4.0-to-v4.5.0"
+			Expect(mockBinResponse(ghWithPullRequests(
+				`[{"number":3,"headRefName":"feature","url":"https://github.com/acme/repo/pull/3"},`+
+					`{"number":7,"headRefName":"`+earlier+`","headRefOid":"abc123",`+
+					`"url":"https://github.com/acme/repo/pull/7"}]`),
+				mockGh)).To(Succeed())
+			Expect(mockBinResponse(`#!/bin/bash
+echo "git $@" >> "`+logFile+`"
+if [[ "$1" == "log" ]]; then
+  echo "1a2b3c4 chore(kubebuilder): update scaffold v4.4.0 -> v4.5.0"
+  echo "5d6e7f8 (chore) initial scaffold from release version: v4.5.0"
+fi
+exit 0`, mockGit)).To(Succeed())
+
+			err = opts.openPullRequest()
+			Expect(err).ToNot(HaveOccurred())
+
+			logs, _ := os.ReadFile(logFile)
+			s := string(logs)
+			Expect(s).To(ContainSubstring("git fetch origin " + earlier))
+			Expect(s).To(ContainSubstring("git log --format=%h %s abc123 --not " + defaultBranch))
+			Expect(s).To(ContainSubstring("git push --force-with-lease=refs/heads/" + earlier + ":abc123 origin " +
+				opts.getOutputBranchName() + ":refs/heads/" + earlier))
+			Expect(s).To(ContainSubstring("pr edit 7 --repo acme/repo"))
+			Expect(s).NotTo(ContainSubstring("pr edit 3"))
+			Expect(s).NotTo(ContainSubstring("pr create"))
+		})
+
+		It("refuses to replace the branch of an earlier update with commits it did not create", func() {
+			const earlier = "kubebuilder-update-from-v4.4.0-to-v4.5.0"
+			Expect(mockBinResponse(ghWithPullRequests(
+				`[{"number":7,"headRefName":"`+earlier+`","headRefOid":"abc123",`+
+					`"url":"https://github.com/acme/repo/pull/7"}]`),
+				mockGh)).To(Succeed())
+			Expect(mockBinResponse(`#!/bin/bash
+echo "git $@" >> "`+logFile+`"
+if [[ "$1" == "log" ]]; then
+  echo "9f8e7d6 fix the conflicts in cmd/main.go"
+  echo "1a2b3c4 chore(kubebuilder): update scaffold v4.4.0 -> v4.5.0"
+fi
+exit 0`, mockGit)).To(Succeed())
+
+			err = opts.openPullRequest()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"has commits that were not created by an update (9f8e7d6)"))
+
+			logs, _ := os.ReadFile(logFile)
+			s := string(logs)
+			Expect(s).NotTo(ContainSubstring("git push"))
+			Expect(s).NotTo(ContainSubstring("pr edit"))
+		})
+
+		It("creates a new pull request for a custom output branch", func() {
+			opts.OutputBranch = "my-update"
+			Expect(mockBinResponse(ghWithPullRequests(
+				`[{"number":7,"headRefName":"kubebuilder-update-from-v4.4.0-to-v4.5.0","url":"u"}]`),
+				mockGh)).To(Succeed())
+
+			err = opts.openPullRequest()
+			Expect(err).ToNot(HaveOccurred())
+
+			logs, _ := os.ReadFile(logFile)
+			s := string(logs)
+			Expect(s).To(ContainSubstring("pr create --repo acme/repo --base " + defaultBranch + " --head my-update"))
+			Expect(s).NotTo(ContainSubstring("pr edit"))
+		})
+
+		It("fails when the pull request cannot be created", func() {
+			failPR := `#!/bin/bash
+if [[ "$1" == "repo" && "$2" == "view" ]]; then
+  echo "acme/repo"
+  exit 0
+fi
+if [[ "$1" == "pr" && "$2" == "create" ]]; then
+  exit 1
+fi
+exit 0`
+			Expect(mockBinResponse(failPR, mockGh)).To(Succeed())
+
+			err = opts.openPullRequest()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to create GitHub pull request: exit status 1"))
+		})
+	})
+
 	Context("Version Handling Edge Cases", func() {
 		DescribeTable("Should handle version prefix normalization in defineToVersion",
 			func(inputVersion, expectedVersion string) {
 				opts := &Update{ToVersion: inputVersion}
-				normalizedVersion := opts.defineToVersion()
+				normalizedVersion, errDefine := opts.defineToVersion()
+				Expect(errDefine).NotTo(HaveOccurred())
 				Expect(normalizedVersion).To(Equal(expectedVersion))
 			},
 			Entry("adds v prefix when missing", "1.0.0", "v1.0.0"),
@@ -291,6 +466,47 @@ exit 0`
 			Entry("handles build metadata", "1.0.0+build.1", "v1.0.0+build.1"),
 		)
 
+		It("Should use the version of --to-binary when --to-version is not set", func() {
+			binary := filepath.Join(GinkgoT().TempDir(), "kubebuilder")
+			Expect(mockBinResponse("#!/bin/bash\necho 'KubeBuilder:          v4.9.0'", binary)).To(Succeed())
+
+			opts := &Update{ToBinary: binary}
+			Expect(opts.defineToVersion()).To(Equal("v4.9.0"))
+		})
+
+		It("Should take the version of --to-binary from the checksum manifest without running it", func() {
+			dir := GinkgoT().TempDir()
+			binary := filepath.Join(dir, "kubebuilder")
+			logFile := filepath.Join(dir, "bin.log")
+			script := "#!/bin/bash\necho run >> " + logFile + "\necho 'KubeBuilder:          v4.9.0'"
+			Expect(mockBinResponse(script, binary)).To(Succeed())
+			sum := sha256.Sum256([]byte(script))
+			manifest := filepath.Join(dir, "SHA256SUMS")
+			Expect(os.WriteFile(manifest, []byte(strings.Repeat("0", 64)+"  "+helpers.ReleaseBinaryKey("v4.9.0")+"\n"+
+				hex.EncodeToString(sum[:])+"  "+helpers.ReleaseBinaryKey("v4.8.0")+"\n"), 0o644)).To(Succeed())
+
+			opts := &Update{ToBinary: binary, ChecksumManifest: manifest}
+			Expect(opts.defineToVersion()).To(Equal("v4.8.0"))
+			Expect(logFile).NotTo(BeAnExistingFile())
+
+			By("failing when the binary is not in the checksum manifest")
+			Expect(os.WriteFile(manifest, []byte(hex.EncodeToString(sum[:])+"  v4.8.0/other\n"), 0o644)).To(Succeed())
+			opts = &Update{ToBinary: binary, ChecksumManifest: manifest}
+			_, err = opts.defineToVersion()
+			Expect(err).To(MatchError(ContainSubstring("please use --to-version flag")))
+			Expect(logFile).NotTo(BeAnExistingFile())
+		})
+
+		It("Should require --to-version when the version of --to-binary cannot be determined", func() {
+			binary := filepath.Join(GinkgoT().TempDir(), "kubebuilder")
+			Expect(mockBinResponse("#!/bin/bash\necho 'something else'", binary)).To(Succeed())
+
+			opts := &Update{ToBinary: binary}
+			_, err = opts.defineToVersion()
+			Expect(err).To(MatchError(ContainSubstring(
+				"unable to determine the version of --to-binary, please use --to-version flag to specify it")))
+		})
+
 		DescribeTable("Should handle malformed versions gracefully during validation",
 			func(invalidFromVersion, invalidToVersion string) {
 				const version = `version: "3"`
@@ -331,7 +547,7 @@ fi
 exit 0`
 			Expect(mockBinResponse(noGh, mockGh)).To(Succeed())
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to detect GitHub repository"))
 		})
@@ -346,7 +562,7 @@ fi
 exit 0`
 			Expect(mockBinResponse(authFailGh, mockGh)).To(Succeed())
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to detect GitHub repository"))
 		})
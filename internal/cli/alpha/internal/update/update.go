@@ -28,6 +28,7 @@ import (
 	"strings"
 	"time"
 
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/forge"
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 )
@@ -91,6 +92,36 @@ type Update struct {
 	// CLI (`gh`) to be installed and authenticated in the local environment.
 	OpenGhIssue bool
 
+	// OpenIssue, when true, automatically creates an issue on the forge that hosts the repository
+	// after the update completes, with the same content as OpenGhIssue.
+	OpenIssue bool
+
+	// OpenPR, when true, opens a pull request (a merge request on GitLab) from the output branch into
+	// the base branch (--from-branch) after the update is pushed. The body summarizes the conflicts and
+	// the make targets to run. When a pull request from an earlier update is still open, its branch is
+	// replaced with the output branch and its title and body are updated instead. This requires Push.
+	OpenPR bool
+
+	// Forge is the name of the service that hosts the repository: "github" (default), "gitlab" or
+	// "gitea". The GitHub forge uses the `gh` CLI, while the others call the REST API of the server
+	// with the token found in GITLAB_TOKEN or GITEA_TOKEN.
+	Forge string
+
+	// BinaryCacheDir is a directory holding the release binaries, laid out as the release downloads:
+	// "<dir>/<version>/kubebuilder_<os>_<arch>". Binaries found in it are used instead of being
+	// downloaded, and downloaded binaries are stored in it for the next runs.
+	BinaryCacheDir string
+
+	// FromBinary and ToBinary are paths to local kubebuilder binaries of FromVersion and ToVersion.
+	// When set, they are used instead of the release binaries so that no download is needed.
+	FromBinary string
+	ToBinary   string
+
+	// ChecksumManifest is the path to a file with the SHA-256 checksums of the release binaries, in the
+	// format of `sha256sum` (e.g. "<checksum>  v4.7.1/kubebuilder_linux_amd64"). When set, every binary
+	// used for the update, whether downloaded, cached or local, must match its checksum.
+	ChecksumManifest string
+
 	// GitConfig holds per-invocation Git settings applied to every `git` command via
 	// `git -c key=value`.
 	//
@@ -120,6 +151,12 @@ type Update struct {
 	OriginalBranch string
 	UpgradeBranch  string
 	MergeBranch    string
+
+	// conflicts are the conflicts found while merging the original branch into the upgrade branch.
+	conflicts helpers.ConflictResult
+
+	// checksums are the checksums loaded from ChecksumManifest.
+	checksums helpers.ChecksumManifest
 }
 
 // Update a project using a default three-way Git merge.
@@ -211,38 +248,44 @@ func (opts *Update) Update() error {
 
 	log.Info("Update completed successfully")
 
-	if opts.OpenGhIssue {
-		if err := opts.openGitHubIssue(hasConflicts); err != nil {
-			return fmt.Errorf("failed to open GitHub issue: %w", err)
+	if opts.OpenIssue || opts.OpenGhIssue {
+		if err := opts.openIssue(hasConflicts); err != nil {
+			return fmt.Errorf("failed to open issue: %w", err)
+		}
+	}
+
+	if opts.OpenPR {
+		if err := opts.openPullRequest(); err != nil {
+			return fmt.Errorf("failed to open pull request: %w", err)
 		}
 	}
 
 	return nil
 }
 
-func (opts *Update) openGitHubIssue(hasConflicts bool) error {
-	log.Info("Creating GitHub Issue to track the need to update the project")
+// openIssue opens an issue on the forge that hosts the repository to track the update. The issue
+// links to the page to open a merge request from the output branch into the base branch.
+func (opts *Update) openIssue(hasConflicts bool) error {
+	f, err := forge.New(opts.forgeName())
+	if err != nil {
+		return fmt.Errorf("failed to set up the %s forge: %w", opts.forgeName(), err)
+	}
+
+	log.Info("Creating issue to track the need to update the project", "forge", f.Name())
 	out := opts.getOutputBranchName()
 
-	// Detect repo "owner/name"
-	repoCmd := exec.Command("gh", "repo", "view", "--json", "nameWithOwner", "--jq", ".nameWithOwner")
-	repoBytes, err := repoCmd.Output()
+	createPRURL, err := f.CompareURL(opts.FromBranch, out)
 	if err != nil {
-		return fmt.Errorf("failed to detect GitHub repository via `gh repo view`: %s", err)
+		return fmt.Errorf("failed to build the compare URL: %w", err)
 	}
-	repo := strings.TrimSpace(string(repoBytes))
-
-	createPRURL := fmt.Sprintf("https://github.com/%s/compare/%s...%s?expand=1", repo, opts.FromBranch, out)
 	title := fmt.Sprintf(helpers.IssueTitleTmpl, opts.ToVersion, opts.FromVersion)
 
 	// Skip if an open issue with same title already exists
-	checkCmd := exec.Command("gh", "issue", "list",
-		"--repo", repo,
-		"--state", "open",
-		"--search", fmt.Sprintf("in:title \"%s\"", title),
-		"--json", "title")
-	if checkOut, checkErr := checkCmd.Output(); checkErr == nil && strings.Contains(string(checkOut), title) {
-		log.Info("GitHub Issue already exists, skipping creation", "title", title)
+	existing, err := f.FindOpenIssue(title)
+	if err != nil {
+		log.Warn("could not check for an existing issue", "forge", f.Name(), "error", err)
+	} else if existing != "" {
+		log.Info("Issue already exists, skipping creation", "title", title, "url", existing)
 		return nil
 	}
 
@@ -254,41 +297,175 @@ func (opts *Update) openGitHubIssue(hasConflicts bool) error {
 		body = fmt.Sprintf(helpers.IssueBodyTmpl, opts.ToVersion, createPRURL, opts.FromVersion, out)
 	}
 
-	log.Info("Creating GitHub Issue")
-	createCmd := exec.Command("gh", "issue", "create",
-		"--repo", repo,
-		"--title", title,
-		"--body", body,
-	)
-	createOut, createErr := createCmd.CombinedOutput()
-	if createErr != nil {
-		return fmt.Errorf("failed to create GitHub issue: %v\n%s", createErr, string(createOut))
-	}
-	outStr := string(createOut)
-
-	// Try to extract the issue URL from stdout
-	issueURL := helpers.FirstURL(outStr)
-
-	// Fallback: query the just-created issue by title
-	if issueURL == "" {
-		viewCmd := exec.Command("gh", "issue", "list",
-			"--repo", repo,
-			"--state", "open",
-			"--search", fmt.Sprintf("in:title \"%s\"", title),
-			"--json", "url",
-			"--jq", ".[0].url",
-		)
-		urlBytes, vErr := viewCmd.Output()
-		if vErr != nil {
-			log.Warn("could not determine issue URL from gh output", "stdout", outStr, "error", vErr)
+	issueURL, err := f.CreateIssue(title, body)
+	if err != nil {
+		return fmt.Errorf("failed to create the issue: %w", err)
+	}
+	log.Info("Issue created to track the update", "forge", f.Name(), "url", issueURL, "compare", createPRURL)
+
+	return nil
+}
+
+// openPullRequest opens a pull request from the output branch into the base branch. When a pull
+// request from an earlier update is still open, the output branch is pushed to its branch and its
+// title and body are updated, so there is a single pull request to review.
+func (opts *Update) openPullRequest() error {
+	f, err := forge.New(opts.forgeName())
+	if err != nil {
+		return fmt.Errorf("failed to set up the %s forge: %w", opts.forgeName(), err)
+	}
+
+	out := opts.getOutputBranchName()
+	title := fmt.Sprintf(helpers.PullRequestTitleTmpl, opts.ToVersion, opts.FromVersion)
+	body := helpers.PullRequestBody(opts.ToVersion, opts.FromVersion, out, opts.conflicts)
+
+	previous, err := opts.findUpdatePullRequest(f)
+	if err != nil {
+		return err
+	}
+	if previous == nil {
+		log.Info("Creating pull request", "forge", f.Name(), "head", out, "base", opts.FromBranch)
+		prURL, createErr := f.CreateMergeRequest(opts.FromBranch, out, title, body)
+		if createErr != nil {
+			return fmt.Errorf("failed to create the pull request: %w", createErr)
 		}
-		issueURL = strings.TrimSpace(string(urlBytes))
+		log.Info("Pull request created", "forge", f.Name(), "url", prURL)
+		return nil
 	}
-	log.Info("GitHub Issue created to track the update", "url", issueURL, "compare", createPRURL)
 
+	if previous.Head != out {
+		// The branch of a pull request cannot be changed, so the update replaces its content
+		if err = opts.replacePullRequestBranch(*previous); err != nil {
+			return err
+		}
+	}
+	if err = f.UpdateMergeRequest(*previous, title, body); err != nil {
+		return fmt.Errorf("failed to update the pull request: %w", err)
+	}
+	log.Info("Pull request updated", "forge", f.Name(), "url", previous.URL)
 	return nil
 }
 
+// replacePullRequestBranch pushes the output branch to the branch of the pull request of an earlier
+// update. It refuses to do so when the branch has commits that were not created by an update, such as
+// fixes pushed by a reviewer, and the push only succeeds while the branch is still at the commit
+// reported by the forge, so commits pushed in the meantime are not lost either.
+func (opts *Update) replacePullRequestBranch(previous forge.MergeRequest) error {
+	out := opts.getOutputBranchName()
+	if previous.HeadSHA == "" {
+		return fmt.Errorf("failed to find the head commit of the pull request %s", previous.URL)
+	}
+	if err := helpers.GitCmd(opts.GitConfig, "fetch", "origin", previous.Head).Run(); err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", previous.Head, err)
+	}
+
+	foreign, err := opts.foreignCommits(previous.HeadSHA)
+	if err != nil {
+		return err
+	}
+	if len(foreign) > 0 {
+		return fmt.Errorf("the branch %s of the pull request %s has commits that were not created by an update "+
+			"(%s); merge or close the pull request, or use --output-branch to open a new one",
+			previous.Head, previous.URL, strings.Join(foreign, ", "))
+	}
+
+	log.Warn("Replacing the branch of the pull request of an earlier update",
+		"url", previous.URL, "branch", previous.Head, "output_branch", out)
+	lease := fmt.Sprintf("--force-with-lease=refs/heads/%s:%s", previous.Head, previous.HeadSHA)
+	if err = helpers.GitCmd(opts.GitConfig, "push", lease, "origin", out+":refs/heads/"+previous.Head).Run(); err != nil {
+		return fmt.Errorf("failed to push %s to %s: %w", out, previous.Head, err)
+	}
+	return nil
+}
+
+// foreignCommits returns the short hashes of the commits reachable from the provided commit, and not
+// from the base branch, whose subject is not one of the subjects of the commits created by an update.
+func (opts *Update) foreignCommits(head string) ([]string, error) {
+	output, err := helpers.GitCmd(opts.GitConfig,
+		"log", "--format=%h %s", head, "--not", opts.FromBranch).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the commits of %s: %w", head, err)
+	}
+
+	var foreign []string
+	for line := range strings.Lines(string(output)) {
+		hash, subject, _ := strings.Cut(strings.TrimSpace(line), " ")
+		if hash != "" && !opts.isUpdateCommit(subject) {
+			foreign = append(foreign, hash)
+		}
+	}
+	return foreign, nil
+}
+
+// updateCommitVersion matches the release versions in the subjects of the commits created by an update.
+const updateCommitVersion = `v?\d+\.\d+\.\d+[0-9A-Za-z.+-]*`
+
+// updateCommitSubjects matches the default subjects of the commits created by an update of any versions:
+// the commits of the ancestor and upgrade branches, and the merge commit.
+var updateCommitSubjects = func() []*regexp.Regexp {
+	const placeholder = "\x00"
+	subjects := []string{
+		helpers.ScaffoldCommitMessage(placeholder),
+		helpers.MergeCommitMessage(placeholder, placeholder),
+		helpers.ConflictCommitMessage(placeholder, placeholder),
+	}
+	patterns := make([]*regexp.Regexp, 0, len(subjects))
+	for _, subject := range subjects {
+		pattern := strings.ReplaceAll(regexp.QuoteMeta(subject), placeholder, updateCommitVersion)
+		patterns = append(patterns, regexp.MustCompile("^"+pattern+"$"))
+	}
+	return patterns
+}()
+
+// isUpdateCommit returns true if the subject is exactly the one of a commit created by an update: the commits
+// of the ancestor, original and upgrade branches, and the merge commit with the default or configured message.
+func (opts *Update) isUpdateCommit(subject string) bool {
+	if subject == helpers.OriginalCommitMessage(opts.FromBranch) {
+		return true
+	}
+	for _, pattern := range updateCommitSubjects {
+		if pattern.MatchString(subject) {
+			return true
+		}
+	}
+	for _, msg := range []string{opts.CommitMessage, opts.CommitMessageConflict} {
+		if first, _, _ := strings.Cut(msg, "\n"); first != "" && subject == strings.TrimSpace(first) {
+			return true
+		}
+	}
+	return false
+}
+
+// findUpdatePullRequest returns the open pull request into the base branch that was opened by this
+// or an earlier update, or nil if there is none. When the output branch name is the default one, pull
+// requests from the default output branches of the other updates are considered as well.
+func (opts *Update) findUpdatePullRequest(f forge.Forge) (*forge.MergeRequest, error) {
+	out := opts.getOutputBranchName()
+	mergeRequests, err := f.FindOpenMergeRequests(opts.FromBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look for open pull requests: %w", err)
+	}
+
+	var previous *forge.MergeRequest
+	for i, mr := range mergeRequests {
+		if mr.Head == out {
+			return &mergeRequests[i], nil
+		}
+		if previous == nil && opts.OutputBranch == "" && strings.HasPrefix(mr.Head, helpers.UpdateBranchPrefix) {
+			previous = &mergeRequests[i]
+		}
+	}
+	return previous, nil
+}
+
+// forgeName returns the name of the forge that hosts the repository, which defaults to GitHub.
+func (opts *Update) forgeName() string {
+	if opts.Forge == "" {
+		return forge.GitHub
+	}
+	return opts.Forge
+}
+
 func (opts *Update) cleanupTempBranches() {
 	_ = helpers.GitCmd(opts.GitConfig, "checkout", opts.getOutputBranchName()).Run()
 
@@ -317,7 +494,7 @@ func (opts *Update) getOutputBranchName() string {
 	if opts.OutputBranch != "" {
 		return opts.OutputBranch
 	}
-	return fmt.Sprintf("kubebuilder-update-from-%s-to-%s", opts.FromVersion, opts.ToVersion)
+	return fmt.Sprintf("%s%s-to-%s", helpers.UpdateBranchPrefix, opts.FromVersion, opts.ToVersion)
 }
 
 // preservePaths checks out the paths specified in RestorePath
@@ -370,12 +547,12 @@ func (opts *Update) squashToOutputBranch(hasConflicts bool) error {
 	return nil
 }
 
-// regenerateProjectWithVersion downloads the release binary for the specified version,
+// regenerateProjectWithVersion gets the release binary for the specified version,
 // and runs the `alpha generate` command to re-scaffold the project
-func regenerateProjectWithVersion(version string) error {
-	tempDir, err := helpers.DownloadReleaseVersionWith(version)
+func (opts *Update) regenerateProjectWithVersion(version string) error {
+	tempDir, err := opts.releaseBinaryDir(version)
 	if err != nil {
-		return fmt.Errorf("failed to download release %s binary: %w", version, err)
+		return err
 	}
 	defer func() {
 		if err := os.RemoveAll(tempDir); err != nil {
@@ -397,15 +574,14 @@ func (opts *Update) prepareAncestorBranch() error {
 	if err := cleanupBranch(); err != nil {
 		return fmt.Errorf("failed to cleanup the %s : %w", opts.AncestorBranch, err)
 	}
-	if err := regenerateProjectWithVersion(opts.FromVersion); err != nil {
+	if err := opts.regenerateProjectWithVersion(opts.FromVersion); err != nil {
 		return fmt.Errorf("failed to regenerate project with fromVersion %s: %w", opts.FromVersion, err)
 	}
 	gitCmd := helpers.GitCmd(opts.GitConfig, "add", "--all")
 	if err := gitCmd.Run(); err != nil {
 		return fmt.Errorf("failed to stage changes in %s: %w", opts.AncestorBranch, err)
 	}
-	commitMessage := "(chore) initial scaffold from release version: " + opts.FromVersion
-	if err := helpers.CommitIgnoreEmpty(commitMessage, "ancestor"); err != nil {
+	if err := helpers.CommitIgnoreEmpty(helpers.ScaffoldCommitMessage(opts.FromVersion), "ancestor"); err != nil {
 		return fmt.Errorf("failed to commit ancestor branch: %w", err)
 	}
 	return nil
@@ -579,7 +755,7 @@ func (opts *Update) prepareOriginalBranch() error {
 		return fmt.Errorf("failed to stage all changes in current: %w", err)
 	}
 	if err := helpers.CommitIgnoreEmpty(
-		fmt.Sprintf("(chore) original code from %s to keep changes", opts.FromBranch),
+		helpers.OriginalCommitMessage(opts.FromBranch),
 		"original",
 	); err != nil {
 		return fmt.Errorf("failed to commit original branch: %w", err)
@@ -605,7 +781,7 @@ func (opts *Update) prepareUpgradeBranch() error {
 	if err := cleanupBranch(); err != nil {
 		return fmt.Errorf("failed to cleanup the %s branch: %w", opts.UpgradeBranch, err)
 	}
-	if err := regenerateProjectWithVersion(opts.ToVersion); err != nil {
+	if err := opts.regenerateProjectWithVersion(opts.ToVersion); err != nil {
 		return fmt.Errorf("failed to regenerate project with version %s: %w", opts.ToVersion, err)
 	}
 	gitCmd = helpers.GitCmd(opts.GitConfig, "add", "--all")
@@ -613,7 +789,7 @@ func (opts *Update) prepareUpgradeBranch() error {
 		return fmt.Errorf("failed to stage changes in %s: %w", opts.UpgradeBranch, err)
 	}
 	if err := helpers.CommitIgnoreEmpty(
-		"(chore) initial scaffold from release version: "+opts.ToVersion, "upgrade"); err != nil {
+		helpers.ScaffoldCommitMessage(opts.ToVersion), "upgrade"); err != nil {
 		return fmt.Errorf("failed to commit upgrade branch: %w", err)
 	}
 	return nil
@@ -647,6 +823,7 @@ func (opts *Update) mergeOriginalToUpgrade() (bool, error) {
 				return hasConflicts, fmt.Errorf("merge stopped due to conflicts")
 			}
 			log.Warn("Merge completed with conflicts. Conflict markers will be committed.")
+			opts.conflicts = helpers.FindConflictFiles()
 		} else {
 			return hasConflicts, fmt.Errorf("merge failed unexpectedly: %w", err)
 		}
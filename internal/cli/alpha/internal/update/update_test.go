@@ -19,6 +19,8 @@ limitations under the License.
 package update
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -202,7 +204,7 @@ exit 0`, logFile, out)
 
 	Context("RegenerateProjectWithVersion", func() {
 		It("succeeds downloading binary and running `alpha generate`", func() {
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -210,7 +212,7 @@ exit 0`, logFile, out)
 			tmpBase := GinkgoT().TempDir()
 			GinkgoT().Setenv("TMPDIR", tmpBase)
 
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).ToNot(HaveOccurred())
 
 			entries, err := filepath.Glob(filepath.Join(tmpBase, "kubebuilder*"))
@@ -230,7 +232,7 @@ exit 1`
 			tmpBase := GinkgoT().TempDir()
 			GinkgoT().Setenv("TMPDIR", tmpBase)
 
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).To(HaveOccurred())
 
 			entries, err := filepath.Glob(filepath.Join(tmpBase, "kubebuilder*"))
@@ -244,7 +246,7 @@ exit 1`
 				Get("/kubernetes-sigs/kubebuilder/releases/download").
 				Times(2).Reply(401).Body(strings.NewReader(""))
 
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(
 				fmt.Sprintf("failed to download release %s binary", opts.FromVersion),
@@ -260,7 +262,7 @@ exit 1`
 				Get("/kubernetes-sigs/kubebuilder/releases/download").
 				Times(2).Reply(200).Body(strings.NewReader(fail))
 
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(
 				fmt.Sprintf("failed to run alpha generate for version %s", opts.FromVersion),
@@ -268,6 +270,107 @@ exit 1`
 		})
 	})
 
+	Context("RegenerateProjectWithVersion offline", func() {
+		var binary string
+
+		BeforeEach(func() {
+			// No release download must happen
+			gock.Off()
+			gock.New("https://github.com").
+				Get("/kubernetes-sigs/kubebuilder/releases/download").
+				Times(2).Reply(500).Body(strings.NewReader(""))
+
+			binary = filepath.Join(tmpDir, "kubebuilder-local")
+			Expect(mockBinResponse(`#!/bin/bash
+echo "local $@" >> "`+logFile+`"
+exit 0`, binary)).To(Succeed())
+		})
+
+		It("uses the binary provided with --from-binary", func() {
+			opts.FromBinary = binary
+			Expect(opts.regenerateProjectWithVersion(opts.FromVersion)).To(Succeed())
+
+			logs, readErr := os.ReadFile(logFile)
+			Expect(readErr).NotTo(HaveOccurred())
+			Expect(string(logs)).To(ContainSubstring("local alpha generate"))
+			Expect(binary).To(BeAnExistingFile())
+		})
+
+		It("uses the binary found in the binary cache", func() {
+			opts.BinaryCacheDir = filepath.Join(tmpDir, "cache")
+			Expect(helpers.StoreReleaseBinary(opts.BinaryCacheDir, opts.FromVersion, binary)).To(Succeed())
+
+			Expect(opts.regenerateProjectWithVersion(opts.FromVersion)).To(Succeed())
+			logs, readErr := os.ReadFile(logFile)
+			Expect(readErr).NotTo(HaveOccurred())
+			Expect(string(logs)).To(ContainSubstring("local alpha generate"))
+		})
+
+		It("stores downloaded binaries in the binary cache", func() {
+			gock.Off()
+			mockURLResponse("#!/bin/bash\nexit 0", "https://github.com/kubernetes-sigs/kubebuilder/releases/download", 2, 200)
+			opts.BinaryCacheDir = filepath.Join(tmpDir, "cache")
+
+			Expect(opts.regenerateProjectWithVersion(opts.FromVersion)).To(Succeed())
+			_, found := helpers.CachedReleaseBinary(opts.BinaryCacheDir, opts.FromVersion)
+			Expect(found).To(BeTrue())
+		})
+
+		It("fails when the binary does not match the checksum manifest", func() {
+			opts.FromBinary = binary
+			opts.ChecksumManifest = filepath.Join(tmpDir, "SHA256SUMS")
+			Expect(os.WriteFile(opts.ChecksumManifest,
+				[]byte(strings.Repeat("0", 64)+"  "+helpers.ReleaseBinaryKey(opts.FromVersion)+"\n"), 0o644)).To(Succeed())
+
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("checksum mismatch"))
+		})
+
+		It("requires the checksum manifest for a binary that cannot report its version", func() {
+			opts.FromBinary = binary
+
+			err = opts.validateBinaries()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"--from-binary: unable to check the version of the binary, set --checksum-manifest to verify it"))
+
+			content, readErr := os.ReadFile(binary)
+			Expect(readErr).NotTo(HaveOccurred())
+			sum := sha256.Sum256(content)
+			opts.ChecksumManifest = filepath.Join(tmpDir, "SHA256SUMS")
+			Expect(os.WriteFile(opts.ChecksumManifest,
+				[]byte(hex.EncodeToString(sum[:])+"  "+helpers.ReleaseBinaryKey(opts.FromVersion)+"\n"), 0o644)).To(Succeed())
+			Expect(opts.validateBinaries()).To(Succeed())
+		})
+
+		It("does not run a binary that does not match the checksum manifest", func() {
+			opts.FromBinary = binary
+			opts.ChecksumManifest = filepath.Join(tmpDir, "SHA256SUMS")
+			Expect(os.WriteFile(opts.ChecksumManifest,
+				[]byte(strings.Repeat("0", 64)+"  "+helpers.ReleaseBinaryKey(opts.FromVersion)+"\n"), 0o644)).To(Succeed())
+
+			err = opts.validateBinaries()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--from-binary: checksum mismatch"))
+			Expect(logFile).NotTo(BeAnExistingFile())
+		})
+
+		It("fails when the version of the binary does not match the version of the update", func() {
+			Expect(mockBinResponse(`#!/bin/bash
+echo 'Version: cmd.version{KubeBuilderVersion:"4.4.0"}'`, binary)).To(Succeed())
+			opts.FromBinary = binary
+
+			err = opts.validateBinaries()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"the version of " + binary + " is v4.4.0, which does not match the version v4.5.0 of the update"))
+
+			opts.FromVersion = "v4.4.0"
+			Expect(opts.validateBinaries()).To(Succeed())
+		})
+	})
+
 	Context("PrepareAncestorBranch", func() {
 		It("succeeds", func() {
 			err = opts.prepareAncestorBranch()
@@ -648,4 +751,28 @@ exit 1`
 			Expect(msg).To(Equal(helpers.ConflictCommitMessage(opts.FromVersion, opts.ToVersion)))
 		})
 	})
+
+	Context("isUpdateCommit", func() {
+		BeforeEach(func() {
+			opts.FromBranch = "main"
+			opts.CommitMessage = "chore: custom update message\n\nWith a body"
+			opts.CommitMessageConflict = ""
+		})
+
+		DescribeTable("matches the exact subjects of the commits created by an update",
+			func(subject string, expected bool) {
+				Expect(opts.isUpdateCommit(subject)).To(Equal(expected))
+			},
+			Entry("ancestor and upgrade commits", "(chore) initial scaffold from release version: v4.4.0", true),
+			Entry("original commit", "(chore) original code from main to keep changes", true),
+			Entry("merge commit", "chore(kubebuilder): update scaffold v4.4.0 -> v4.5.0", true),
+			Entry("merge commit with conflicts",
+				"chore(kubebuilder): (:warning: manual conflict resolution required) update scaffold v4.4.0 -> v4.5.0", true),
+			Entry("configured merge commit", "chore: custom update message", true),
+			Entry("original commit of another branch", "(chore) original code from dev to keep changes", false),
+			Entry("commit with the same prefix", "chore(kubebuilder): fix the conflicts in cmd/main.go", false),
+			Entry("merge commit with a suffix", "chore(kubebuilder): update scaffold v4.4.0 -> v4.5.0 and more", false),
+			Entry("unrelated commit", "fix the conflicts in cmd/main.go", false),
+		)
+	})
 })
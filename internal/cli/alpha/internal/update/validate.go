@@ -22,10 +22,12 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 
 	"golang.org/x/mod/semver"
 
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/forge"
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
 )
 
@@ -43,20 +45,55 @@ func (opts *Update) Validate() error {
 	if err := opts.validateSemanticVersions(); err != nil {
 		return fmt.Errorf("failed to validate the versions: %w", err)
 	}
-	if err := validateReleaseAvailability(opts.FromVersion); err != nil {
-		return fmt.Errorf("unable to find release %s: %w", opts.FromVersion, err)
+	if err := opts.validateForge(); err != nil {
+		return fmt.Errorf("failed to validate --forge: %w", err)
 	}
-	if err := validateReleaseAvailability(opts.ToVersion); err != nil {
-		return fmt.Errorf("unable to find release %s: %w", opts.ToVersion, err)
+
+	if err := opts.validateBinaries(); err != nil {
+		return fmt.Errorf("failed to validate the release binaries: %w", err)
+	}
+
+	for _, version := range []string{opts.FromVersion, opts.ToVersion} {
+		if opts.localBinary(version) != "" {
+			log.Info("Using local binary for release", "version", version)
+			continue
+		}
+		if err := validateReleaseAvailability(version); err != nil {
+			return fmt.Errorf("unable to find release %s: %w", version, err)
+		}
 	}
 
-	if opts.OpenGhIssue {
+	return nil
+}
+
+// validateForge verifies that the forge used to open issues and pull requests is supported and can be used.
+func (opts *Update) validateForge() error {
+	if opts.Forge != "" && !slices.Contains(forge.Names(), opts.Forge) {
+		return fmt.Errorf("unsupported forge %q: supported forges are %s",
+			opts.Forge, strings.Join(forge.Names(), ", "))
+	}
+	if opts.OpenGhIssue && opts.forgeName() != forge.GitHub {
+		return fmt.Errorf("--open-gh-issue can only be used with the %s forge, use --open-issue instead",
+			forge.GitHub)
+	}
+	if opts.OpenPR && !opts.Push {
+		return fmt.Errorf("--open-pr requires --push to push the output branch")
+	}
+	if !opts.OpenIssue && !opts.OpenGhIssue && !opts.OpenPR {
+		return nil
+	}
+
+	if opts.forgeName() == forge.GitHub {
 		if err := exec.Command("gh", "--version").Run(); err != nil {
 			return fmt.Errorf("`gh` CLI not found or not authenticated. "+
-				"You must have gh instaled to use the --open-gh-issue option: %s", err)
+				"You must have gh instaled to open GitHub issues and pull requests: %s", err)
 		}
+		return nil
+	}
+	// Check the token and the repository before running the update
+	if _, err := forge.New(opts.forgeName()); err != nil {
+		return fmt.Errorf("failed to set up the %s forge: %w", opts.forgeName(), err)
 	}
-
 	return nil
 }
 
@@ -139,7 +176,11 @@ func (opts *Update) validateEqualVersions() error {
 		// Check if this is the latest version to provide appropriate message
 		latestVersion, err := fetchLatestRelease()
 		if err != nil {
-			return fmt.Errorf("failed to fetch latest release for messaging: %w", err)
+			if !opts.isOffline() {
+				return fmt.Errorf("failed to fetch latest release for messaging: %w", err)
+			}
+			// Offline, the latest release is unknown
+			latestVersion = ""
 		}
 
 		if opts.ToVersion == latestVersion {
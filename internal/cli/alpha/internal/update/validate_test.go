@@ -168,4 +168,39 @@ var _ = Describe("Prepare for internal update", func() {
 			Expect(err.Error()).To(ContainSubstring("unexpected response"))
 		})
 	})
+
+	Context("ValidateForge", func() {
+		It("Should succeed without opening issues", func() {
+			opts.Forge = "gitlab"
+			err := opts.validateForge()
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should fail for an unsupported forge", func() {
+			opts.Forge = "bitbucket"
+			err := opts.validateForge()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unsupported forge "bitbucket"`))
+		})
+		It("Should fail when --open-gh-issue is used with another forge", func() {
+			opts.Forge = "gitea"
+			opts.OpenGhIssue = true
+			err := opts.validateForge()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--open-gh-issue can only be used with the github forge"))
+		})
+		It("Should fail when --open-pr is used without --push", func() {
+			opts.OpenPR = true
+			err := opts.validateForge()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--open-pr requires --push"))
+		})
+		It("Should fail when the token of the forge is not set", func() {
+			GinkgoT().Setenv("GITLAB_TOKEN", "")
+			opts.Forge = "gitlab"
+			opts.OpenIssue = true
+			err := opts.validateForge()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("GITLAB_TOKEN must be set"))
+		})
+	})
 })
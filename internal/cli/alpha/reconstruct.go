@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/reconstruct"
+)
+
+// NewReconstructCommand returns a new command, providing the `kubebuilder alpha reconstruct-project`
+// feature to rebuild the PROJECT file of a project from its code.
+func NewReconstructCommand() *cobra.Command {
+	opts := reconstruct.Reconstruct{}
+
+	reconstructCmd := &cobra.Command{
+		Use:   "reconstruct-project",
+		Short: "Rebuild the PROJECT file of a project from its code",
+		Long: `The 'reconstruct-project' command writes the PROJECT file of a project that lost it, or whose
+PROJECT file no longer matches its code, so that the other commands, such as 'alpha generate'
+and 'alpha update', can be used again.
+
+The configuration is read from the code of the project:
+  • go.mod: the repository and the modules of external APIs.
+  • api/**: the groups (+groupName), kinds (+kubebuilder:object:root), plurals and scopes
+    (+kubebuilder:resource), Server-Side Apply (+genclient) and conversion hubs and spokes.
+  • internal/controller/**: the controllers set up by the reconcilers and their names.
+  • *_webhook.go: the defaulting and validation webhooks (+kubebuilder:webhook) and their custom paths.
+
+Values that cannot be read from the code, such as the plugin chain or the Kubebuilder version
+that scaffolded the project, are guessed and listed in a confidence report, so that they can be
+reviewed before the PROJECT file is used.`,
+		Example: `
+  # Rebuild the PROJECT file of the project in the current directory
+  kubebuilder alpha reconstruct-project
+
+  # Overwrite the PROJECT file of the project in ./path/to/project
+  kubebuilder alpha reconstruct-project --input-dir="./path/to/project" --force
+`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Validate()
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			guesses, err := opts.Reconstruct()
+			if err != nil {
+				slog.Error("failed to reconstruct the PROJECT file", "error", err)
+				os.Exit(1)
+			}
+			slog.Info("PROJECT file reconstructed", "dir", opts.InputDir)
+			reconstruct.PrintReport(cmd.OutOrStdout(), guesses)
+		},
+	}
+
+	reconstructCmd.Flags().StringVar(&opts.InputDir, "input-dir", "",
+		"Path to the root directory of the project, which holds its go.mod file. "+
+			"Defaults to the current working directory if unset")
+
+	reconstructCmd.Flags().BoolVar(&opts.Force, "force", false,
+		"Overwrite the PROJECT file if it already exists")
+
+	return reconstructCmd
+}
@@ -56,9 +56,19 @@ Other options:
   • --merge-message: custom commit message for clean merges.
   • --conflict-message: custom commit message for merges with conflicts.
   • --push: push the output branch to 'origin' after the update.
+  • --open-issue: open an issue to track the update on the forge set with --forge
+      (github, gitlab or gitea; --open-gh-issue is the same as --open-issue --forge github).
+  • --open-pr: open a pull request from the output branch (requires --push). A pull request from an
+      earlier update that is still open is updated instead.
   • --git-config: pass per-invocation Git config as -c key=value (repeatable). When not set,
       defaults are set to improve detection during merges.
 
+Offline and air-gapped runs:
+  • --from-binary / --to-binary: use local kubebuilder binaries instead of downloading the releases.
+  • --binary-cache-dir: use the binaries cached in <dir>/<version>/kubebuilder_<os>_<arch> and cache
+      the downloaded ones.
+  • --checksum-manifest: verify every binary against a sha256sum file.
+
 Defaults:
   • --from-version / --to-version: resolved from PROJECT and the latest release if unset.
   • --from-branch: defaults to 'main' if not specified.`,
@@ -92,6 +102,19 @@ Defaults:
   # Create an issue to track the update
   kubebuilder alpha update --open-gh-issue
 
+  # Push the update and create an issue on GitLab (requires GITLAB_TOKEN)
+  kubebuilder alpha update --force --push --forge gitlab --open-issue
+
+  # Push the update and open a pull request with the conflict summary
+  kubebuilder alpha update --force --push --open-pr
+
+  # Update offline with local binaries verified against a checksum manifest
+  kubebuilder alpha update --from-version v4.6.0 --from-binary ./bin/kubebuilder-v4.6.0 \
+    --to-binary ./bin/kubebuilder-v4.7.0 --checksum-manifest ./bin/SHA256SUMS
+
+  # Reuse the release binaries cached by earlier runs
+  kubebuilder alpha update --binary-cache-dir ~/.cache/kubebuilder/releases
+
   # Add extra Git configs (no need to re-specify defaults)
   kubebuilder alpha update --git-config merge.conflictStyle=diff3 --git-config rerere.enabled=true
                                           
@@ -170,6 +193,28 @@ Defaults:
 	updateCmd.Flags().BoolVar(&opts.OpenGhIssue, "open-gh-issue", false,
 		"If set, create a GitHub issue with a pre-filled checklist and compare link after the update "+
 			"completes (requires `gh`)")
+	updateCmd.Flags().BoolVar(&opts.OpenIssue, "open-issue", false,
+		"If set, create an issue with a pre-filled checklist and compare link on the forge set with --forge "+
+			"after the update completes")
+	updateCmd.Flags().BoolVar(&opts.OpenPR, "open-pr", false,
+		"If set, open a pull request from the output branch into the base branch on the forge set with --forge, "+
+			"with a summary of the conflicts and the make targets to run (requires --push). "+
+			"A pull request from an earlier update that is still open is updated instead")
+	updateCmd.Flags().StringVar(&opts.Forge, "forge", "github",
+		"Forge that hosts the repository, used by --open-issue and --open-pr: github (requires `gh`), "+
+			"gitlab (requires GITLAB_TOKEN) or gitea (requires GITEA_TOKEN)")
+	updateCmd.Flags().StringVar(&opts.BinaryCacheDir, "binary-cache-dir", "",
+		"Directory with the release binaries laid out as <version>/kubebuilder_<os>_<arch>. "+
+			"Cached binaries are used instead of being downloaded, and downloaded binaries are cached")
+	updateCmd.Flags().StringVar(&opts.FromBinary, "from-binary", "",
+		"Path to a local kubebuilder binary of --from-version, used instead of downloading the release")
+	updateCmd.Flags().StringVar(&opts.ToBinary, "to-binary", "",
+		"Path to a local kubebuilder binary of --to-version, used instead of downloading the release. "+
+			"If --to-version is not set, it defaults to the version of the binary")
+	updateCmd.Flags().StringVar(&opts.ChecksumManifest, "checksum-manifest", "",
+		"Path to a sha256sum file with lines '<checksum>  <version>/kubebuilder_<os>_<arch>'. "+
+			"If set, every binary used for the update must match its checksum before it runs. "+
+			"Required for the local binaries that cannot report their version")
 	updateCmd.Flags().StringArrayVar(
 		&gitCfg,
 		"git-config",
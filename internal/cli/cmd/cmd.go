@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"errors"
 	"log/slog"
 	"os"
 
@@ -68,6 +69,7 @@ func Run() {
 	c, err := cli.New(
 		cli.WithCommandName("kubebuilder"),
 		cli.WithVersion(v.PrintVersion()),
+		cli.WithVersionInfo(v),
 		cli.WithCliVersion(v.GetKubeBuilderVersion()),
 		cli.WithPlugins(
 			golangv4.Plugin{},
@@ -89,7 +91,11 @@ func Run() {
 		os.Exit(1)
 	}
 	if err := c.Run(); err != nil {
-		slog.Error("CLI run failed", "error", err)
+		// Errors reported in the JSON output must not be printed again
+		var reported cli.ReportedError
+		if !errors.As(err, &reported) {
+			slog.Error("CLI run failed", "error", err)
+		}
 		os.Exit(1)
 	}
 }
@@ -33,6 +33,7 @@ var alphaCommands = []*cobra.Command{
 	newAlphaCommand(),
 	alpha.NewScaffoldCommand(),
 	alpha.NewUpdateCommand(),
+	alpha.NewReconstructCommand(),
 }
 
 func newAlphaCommand() *cobra.Command {
@@ -59,29 +59,30 @@ Run this command from an initialized project.`,
 	}
 
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.CreateAPI.
-	subcommands := c.filterSubcommands(
-		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.CreateAPI)
-			return isValid
-		},
-		func(p plugin.Plugin) plugin.Subcommand {
-			return p.(plugin.CreateAPI).GetCreateAPISubcommand()
-		},
-	)
-
-	// Verify that there is at least one remaining plugin.
+	filter := func(p plugin.Plugin) bool {
+		_, isValid := plugin.AsCreateAPI(p)
+		return isValid
+	}
+	extract := func(p plugin.Plugin) plugin.Subcommand {
+		return p.(plugin.CreateAPI).GetCreateAPISubcommand()
+	}
+	subcommands := c.filterSubcommands(filter, extract)
+
+	// Verify that there is at least one remaining plugin, hiding the subcommand otherwise.
 	if len(subcommands) == 0 {
+		cmd.Hidden = true
 		cmdErr(cmd, noAvailablePluginError{"API creation"})
 		return cmd
 	}
 
+	// Plugins tracked in the project configuration run after the plugin chain to update their scaffolds.
+	subcommands = append(subcommands,
+		c.attachedSubcommands(plugin.CreateAPISubcommandName, subcommands, filter, extract)...)
+
 	c.applySubcommandHooks(cmd, subcommands, apiErrorMsg, false)
 
 	// Append plugin table after metadata updates
-	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.CreateAPI)
-		return isValid
-	}, "Available plugins that support 'create api'")
+	c.appendPluginTable(cmd, filter, "Available plugins that support 'create api'")
 
 	return cmd
 }
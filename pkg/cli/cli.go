@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 )
 
 const (
@@ -42,6 +43,8 @@ const (
 	pluginsFlag        = "plugins"
 	pluginsFlagArg     = "--plugins"
 	projectVersionFlag = "project-version"
+	dryRunFlag         = "dry-run"
+	dryRunFlagArg      = "--dry-run"
 	helpFlagArg        = "--help"
 	helpShorthandArg   = "-h"
 	shellZsh           = "zsh"
@@ -51,15 +54,20 @@ const (
 	kubebuilderSubcommandInit       = "init"
 	kubebuilderSubcommandVersion    = "version"
 	kubebuilderSubcommandCompletion = "completion"
+	kubebuilderSubcommandPlugins    = "plugins"
+	kubebuilderSubcommandDoctor     = "doctor"
 	pluginGoKubebuilderV4           = "go.kubebuilder.io/v4"
 	pluginGoKubebuilderV3           = "go.kubebuilder.io/v3"
 	pluginGoKubebuilderV2           = "go.kubebuilder.io/v2"
 	generateSubcommand              = "generate"
+	reconstructProjectSubcommand    = "reconstruct-project"
 
 	pluginsFlagDescription = "Comma-separated list of plugin keys to use. " +
 		"If unset, Kubebuilder uses the plugin chain from PROJECT or the CLI default"
 	projectVersionFlagDescription = "Project config version used to select compatible plugins and write PROJECT " +
 		"(e.g., 3). If unset, Kubebuilder uses the version from PROJECT or the CLI default"
+	dryRunFlagDescription = "Print the changes that would be made, including the PROJECT file, as a unified diff " +
+		"without writing them to disk. Post-scaffold steps such as 'make generate' are not run"
 )
 
 // CLI is the command line utility that is used to scaffold kubebuilder project files.
@@ -72,6 +80,8 @@ type CLI struct {
 	version string
 	// CLI version string (just the CLI version number, no extra information).
 	cliVersion string
+	// Structured CLI version information, written by the version command with --output json.
+	versionInfo any
 	// CLI root's command description.
 	description string
 	// Plugins registered in the CLI.
@@ -104,6 +114,8 @@ type CLI struct {
 
 	// A filtered set of plugins that should be used by command constructors.
 	resolvedPlugins []plugin.Plugin
+	// Plugins tracked in the project configuration that run after the resolved plugins.
+	attachedPlugins []plugin.Plugin
 
 	// Root command.
 	cmd *cobra.Command
@@ -166,9 +178,22 @@ func newCLI(options ...Option) (*CLI, error) {
 		}
 	}
 
+	c.removeUnsupportedExternalPlugins()
+
 	return c, nil
 }
 
+// removeUnsupportedExternalPlugins removes the external plugins that require a newer version of the CLI.
+func (c *CLI) removeUnsupportedExternalPlugins() {
+	for key, p := range c.plugins {
+		if ep, isExternal := p.(external.Plugin); isExternal && !ep.SupportsCLIVersion(c.cliVersion) {
+			log.Warn("external plugin requires a newer CLI version and is not available",
+				"plugin", key, "minCLIVersion", ep.MinCLIVersion, "cliVersion", c.cliVersion)
+			delete(c.plugins, key)
+		}
+	}
+}
+
 // defaultArgs returns the command-line arguments of the running program without its name.
 func defaultArgs() []string {
 	if len(os.Args) < 2 {
@@ -283,6 +308,7 @@ func (c *CLI) forgetPluginChain() {
 	c.pluginKeys = nil
 	c.projectVersion = config.Version{}
 	c.resolvedPlugins = nil
+	c.attachedPlugins = nil
 }
 
 // flagError stores a command-line parsing error.
@@ -434,15 +460,24 @@ func isAlphaGenerateCommand(args []string) bool {
 	return false
 }
 
-// subcommandsWithoutConfig are the subcommands that run without a project configuration file.
+// subcommandsWithoutConfig are the subcommands that do not need the project configuration to build their
+// command tree. The doctor command reads it by itself, so that a broken plugin chain can be reported.
 var subcommandsWithoutConfig = []string{
 	kubebuilderSubcommandHelp,
 	kubebuilderSubcommandVersion,
 	kubebuilderSubcommandCompletion,
+	kubebuilderSubcommandPlugins,
+	kubebuilderSubcommandDoctor,
 	cobra.ShellCompRequestCmd,
 	cobra.ShellCompNoDescRequestCmd,
 }
 
+// alphaSubcommandsWithoutConfig are the alpha subcommands that do not need the project configuration.
+// The reconstruct-project command writes the PROJECT file, so it must run when the file is missing or broken.
+var alphaSubcommandsWithoutConfig = []string{
+	reconstructProjectSubcommand,
+}
+
 // isSubcommandWithoutConfig returns true for invocations that do not need the PROJECT file to build
 // their command tree.
 func isSubcommandWithoutConfig(args []string) bool {
@@ -470,6 +505,9 @@ func isSubcommandPathWithoutConfig(path []string) bool {
 		return true
 	}
 
+	if len(path) > 1 && path[0] == alphaCommand && slices.Contains(alphaSubcommandsWithoutConfig, path[1]) {
+		return true
+	}
 	return slices.Contains(subcommandsWithoutConfig, path[0])
 }
 
@@ -532,9 +570,32 @@ func (c *CLI) getInfoFromConfig(projectConfig config.Config) error {
 		}
 	}
 
+	c.attachedPlugins = c.trackedAttachedPlugins(projectConfig)
+
 	return nil
 }
 
+// trackedAttachedPlugins returns the plugins that implement plugin.Attached and whose configuration is
+// tracked in the project configuration, sorted by key.
+func (c *CLI) trackedAttachedPlugins(projectConfig config.Config) []plugin.Plugin {
+	var attached []plugin.Plugin
+	for _, key := range c.sortedPluginKeys() {
+		p := c.plugins[key]
+		if _, isAttached := p.(plugin.Attached); !isAttached {
+			continue
+		}
+		if !plugin.SupportsVersion(p, projectConfig.GetVersion()) {
+			continue
+		}
+		var pluginConfig any
+		if err := projectConfig.DecodePluginConfig(key, &pluginConfig); err != nil {
+			continue
+		}
+		attached = append(attached, p)
+	}
+	return attached
+}
+
 // getInfoFromFlags obtains the project version and plugin keys from flags.
 func (c *CLI) getInfoFromFlags(hasConfigFile bool) error {
 	// Check if --plugins is followed by --help or -h to avoid parsing help as a plugin value
@@ -757,12 +818,30 @@ func (c *CLI) addSubcommands() {
 		c.cmd.AddCommand(createCmd)
 	}
 
+	// kubebuilder doctor
+	c.cmd.AddCommand(c.newDoctorCmd())
+
+	// kubebuilder delete
+	deleteCmd := c.newDeleteCmd()
+	// kubebuilder delete api
+	deleteCmd.AddCommand(c.newDeleteAPICmd())
+	deleteCmd.AddCommand(c.newDeleteWebhookCmd())
+	if deleteCmd.HasAvailableSubCommands() {
+		c.cmd.AddCommand(deleteCmd)
+	}
+
 	// kubebuilder edit
-	c.cmd.AddCommand(c.newEditCmd())
+	editCmd := c.newEditCmd()
+	// kubebuilder edit resource
+	editCmd.AddCommand(c.newEditResourceCmd())
+	c.cmd.AddCommand(editCmd)
 
 	// kubebuilder init
 	c.cmd.AddCommand(c.newInitCmd())
 
+	// kubebuilder plugins
+	c.cmd.AddCommand(c.newPluginsCmd())
+
 	// kubebuilder version
 	// Only add version if a version string was provided
 	if c.version != "" {
@@ -806,6 +885,17 @@ func (c CLI) metadata() plugin.CLIMetadata {
 //
 // If an error is found, command help and examples will be printed.
 func (c CLI) Run() error {
+	// External plugins running in the persistent mode are stopped once the command has been executed.
+	defer func() {
+		if err := external.CloseSessions(); err != nil {
+			log.Warn("failed to stop external plugins", "error", err)
+		}
+	}()
+
+	if c.outputFormat() == outputJSON {
+		return c.runWithJSONOutput()
+	}
+
 	if err := c.cmd.Execute(); err != nil {
 		// Don't return error if help was displayed (from --plugins --help pattern)
 		if err == errHelpDisplayed {
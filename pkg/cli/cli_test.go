@@ -615,6 +615,35 @@ plugins:
 			})
 		})
 
+		When("having attached plugins tracked in the plugins field", func() {
+			var attached *mockAttachedPlugin
+
+			BeforeEach(func() {
+				attached = &mockAttachedPlugin{
+					mockPluginWithSubcommand: newMockPluginWithSubcommand(
+						"attached.kubebuilder.io", []config.Version{projectVersion}, nil),
+				}
+				c.plugins = map[string]plugin.Plugin{plugin.KeyFor(attached): attached}
+			})
+
+			It("should run the tracked ones attached to the plugin chain", func() {
+				projectConfig := cfgv3.New()
+				Expect(projectConfig.SetPluginChain([]string{pluginGoKubebuilderV4})).To(Succeed())
+				Expect(projectConfig.EncodePluginConfig(plugin.KeyFor(attached), struct{}{})).To(Succeed())
+
+				Expect(c.getInfoFromConfig(projectConfig)).To(Succeed())
+				Expect(c.attachedPlugins).To(ConsistOf(attached))
+			})
+
+			It("should not run the ones that are not tracked", func() {
+				projectConfig := cfgv3.New()
+				Expect(projectConfig.SetPluginChain([]string{pluginGoKubebuilderV4})).To(Succeed())
+
+				Expect(c.getInfoFromConfig(projectConfig)).To(Succeed())
+				Expect(c.attachedPlugins).To(BeEmpty())
+			})
+		})
+
 		When("having invalid plugin keys in the layout field", func() {
 			It("should fail", func() {
 				pluginChain := []string{"_/v1"}
@@ -1667,6 +1696,7 @@ var _ = Describe("isSubcommandWithoutConfig", func() {
 		Entry("for the completion subcommand", kubebuilderSubcommandCompletion, shellZsh),
 		Entry("for help on the version subcommand", kubebuilderSubcommandHelp, kubebuilderSubcommandVersion),
 		Entry("for help on the completion subcommand", kubebuilderSubcommandHelp, kubebuilderSubcommandCompletion),
+		Entry("for the alpha subcommand that writes the project file", alphaCommand, reconstructProjectSubcommand),
 	)
 
 	DescribeTable("should read the project configuration",
@@ -1682,6 +1712,7 @@ var _ = Describe("isSubcommandWithoutConfig", func() {
 		Entry("with a dangling plugins flag", kubebuilderSubcommandInit, pluginsFlagArg),
 		Entry("for a subcommand that requires a project", kubebuilderSubcommandInit),
 		Entry("for a subcommand added to the CLI", "docs"),
+		Entry("for an alpha subcommand that reads the project file", alphaCommand, generateSubcommand),
 	)
 })
 
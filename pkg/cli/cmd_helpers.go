@@ -19,6 +19,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	log "log/slog"
 	"os"
 	"slices"
 	"strings"
@@ -94,6 +95,29 @@ func (c *CLI) filterSubcommands(
 	return tuples
 }
 
+// attachedSubcommands returns the subcommands of the plugins tracked in the project configuration that run
+// attached to the plugin chain for the subcommand with the provided name. Plugins that already provide one of
+// the chain subcommands are skipped.
+func (c *CLI) attachedSubcommands(
+	name string,
+	chain []keySubcommandTuple,
+	filter func(plugin.Plugin) bool,
+	extract func(plugin.Plugin) plugin.Subcommand,
+) []keySubcommandTuple {
+	tuples := make([]keySubcommandTuple, 0, len(c.attachedPlugins))
+	for _, p := range c.attachedPlugins {
+		key := plugin.KeyFor(p)
+		if attached, isAttached := p.(plugin.Attached); !isAttached || !attached.RunsAttached(name) {
+			continue
+		}
+		if slices.ContainsFunc(chain, func(tuple keySubcommandTuple) bool { return tuple.key == key }) {
+			continue
+		}
+		tuples = append(tuples, collectSubcommands(p, key, filter, extract)...)
+	}
+	return tuples
+}
+
 func collectSubcommands(
 	p plugin.Plugin,
 	configKey string,
@@ -165,9 +189,9 @@ func (c *CLI) applySubcommandHooks(
 		cliVersion:          c.cliVersion,
 		duplicateFlagValues: result.duplicateFlagValues,
 	}
-	cmd.PreRunE = factory.preRunEFunc(result.options, createConfig)
-	cmd.RunE = factory.runEFunc()
-	cmd.PostRunE = factory.postRunEFunc()
+	cmd.PreRunE = factory.rollbackOnError(factory.preRunEFunc(result.options, createConfig))
+	cmd.RunE = factory.rollbackOnError(factory.runEFunc())
+	cmd.PostRunE = factory.rollbackOnError(factory.postRunEFunc())
 }
 
 // appendPluginTable appends a filtered plugin table to the command's Long description.
@@ -253,6 +277,26 @@ func mergeFlagSetInto(
 	return err
 }
 
+// registerFlagValuesCompletion offers in shell completion the values of the flags annotated
+// by the plugins with the values they accept.
+func registerFlagValuesCompletion(cmd *cobra.Command) error {
+	var err error
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		values, found := flag.Annotations[plugin.FlagValuesAnnotation]
+		if err != nil || !found {
+			return
+		}
+		if _, registered := cmd.GetFlagCompletionFunc(flag.Name); registered {
+			return
+		}
+		completion := cobra.FixedCompletions(values, cobra.ShellCompDirectiveNoFileComp)
+		if regErr := cmd.RegisterFlagCompletionFunc(flag.Name, completion); regErr != nil {
+			err = fmt.Errorf("failed to register completion for flag %q: %w", flag.Name, regErr)
+		}
+	})
+	return err
+}
+
 // syncDuplicateFlags copies the parsed value of each flag to all duplicate Values from merge.
 // Call after the command has parsed flags (e.g. at the start of PreRunE).
 func syncDuplicateFlags(flags *pflag.FlagSet, duplicateValues map[string][]pflag.Value) {
@@ -336,9 +380,59 @@ func initializationHooks(
 		}
 	}
 
+	if err := registerFlagValuesCompletion(cmd); err != nil {
+		return nil, err
+	}
+
 	return &initHooksResult{options: options, duplicateFlagValues: duplicateValues}, nil
 }
 
+// postScaffoldSnapshot are the files saved before running the post-scaffold hooks, so that the changes made to
+// them by external commands are rolled back.
+var postScaffoldSnapshot = []string{"go.mod", "go.sum"}
+
+// rollbackError is returned when a command failed after modifying files and those changes were rolled back.
+type rollbackError struct {
+	err error
+	// restored are the files that were restored to their previous state or removed.
+	restored []machinery.RestoredFile
+	// rollbackErr is set if some of the changes could not be rolled back.
+	rollbackErr error
+	// postScaffold is true if the command failed while running the post-scaffold hooks, whose external
+	// commands may have changed files that are not recorded.
+	postScaffold bool
+}
+
+// Error implements error interface.
+func (e rollbackError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.err.Error())
+	if len(e.restored) != 0 {
+		b.WriteString("\nThe following changes were rolled back:")
+		for _, file := range e.restored {
+			action := "restored"
+			if file.Removed {
+				action = "removed"
+			}
+			fmt.Fprintf(&b, "\n  %s (%s)", file.Path, action)
+		}
+	}
+	if e.rollbackErr != nil {
+		fmt.Fprintf(&b, "\nSome changes could not be rolled back, the project may be left in an inconsistent state: %v",
+			e.rollbackErr)
+	}
+	if e.postScaffold {
+		b.WriteString("\nChanges made by the post-scaffold commands (e.g. make) to files other than " +
+			strings.Join(postScaffoldSnapshot, " and ") + " were not rolled back")
+	}
+	return b.String()
+}
+
+// Unwrap implements the errors.Unwrap interface.
+func (e rollbackError) Unwrap() error {
+	return e.err
+}
+
 type executionHooksFactory struct {
 	// fs is the filesystem abstraction to scaffold files to.
 	fs machinery.Filesystem
@@ -357,6 +451,36 @@ type executionHooksFactory struct {
 	cliVersion string
 	// duplicateFlagValues maps flag names to Values to sync from the parsed flag in PreRunE.
 	duplicateFlagValues map[string][]pflag.Value
+	// dryRun keeps the changes in memory when the --dry-run flag is set. It is nil otherwise.
+	dryRun *machinery.DryRun
+	// transaction records the changes done to the filesystem so that they can be rolled back if any hook fails.
+	// It is nil in dry-run mode.
+	transaction *machinery.Transaction
+	// postScaffold is true once the post-scaffold hooks started running.
+	postScaffold bool
+	// resources are the resources tracked in the project configuration before running the hooks,
+	// used to report the changes in the JSON output.
+	resources []resource.Resource
+}
+
+// rollbackOnError wraps a cobra RunE function so that the files modified by the command are restored
+// to their previous state if it returns an error.
+func (factory *executionHooksFactory) rollbackOnError(
+	runE func(*cobra.Command, []string) error,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := runE(cmd, args)
+		if err == nil || factory.transaction == nil {
+			return err
+		}
+
+		restored, rollbackErr := factory.transaction.Rollback()
+		factory.transaction = nil
+		if len(restored) == 0 && rollbackErr == nil && !factory.postScaffold {
+			return err
+		}
+		return rollbackError{err: err, restored: restored, rollbackErr: rollbackErr, postScaffold: factory.postScaffold}
+	}
 }
 
 func (factory *executionHooksFactory) forEach(cb func(subcommand plugin.Subcommand) error, errorMessage string) error {
@@ -457,6 +581,19 @@ func (factory *executionHooksFactory) preRunEFunc(
 		if len(factory.duplicateFlagValues) > 0 {
 			syncDuplicateFlags(cmd.Flags(), factory.duplicateFlagValues)
 		}
+
+		// In dry-run mode, both the scaffolded files and the project configuration are kept in memory.
+		if dryRun, _ := cmd.Flags().GetBool(dryRunFlag); dryRun {
+			factory.dryRun = machinery.NewDryRun(factory.fs)
+			factory.fs = factory.dryRun.Filesystem()
+			factory.store = yamlstore.New(factory.fs)
+		} else {
+			// Otherwise, the changes are written as they happen but recorded, so that they can be rolled back.
+			factory.transaction = machinery.NewTransaction(factory.fs)
+			factory.fs = factory.transaction.Filesystem()
+			factory.store = yamlstore.New(factory.fs)
+		}
+
 		if createConfig {
 			// Check if a project configuration is already present.
 			if err := factory.store.Load(); err == nil || !errors.Is(err, os.ErrNotExist) {
@@ -477,6 +614,7 @@ func (factory *executionHooksFactory) preRunEFunc(
 			}
 		}
 		cfg := factory.store.Config()
+		factory.resources = resourceSnapshot(cfg)
 
 		// Set the CLI version if creating a new project configuration.
 		if createConfig {
@@ -559,11 +697,32 @@ func (factory *executionHooksFactory) runEFunc() func(*cobra.Command, []string)
 // postRunEFunc returns a cobra RunE function that saves the configuration
 // and executes the post-scaffold hook.
 func (factory *executionHooksFactory) postRunEFunc() func(*cobra.Command, []string) error {
-	return func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
 		if err := factory.store.Save(); err != nil {
 			return fmt.Errorf("%s: failed to save configuration file: %w", factory.errorMessage, err)
 		}
 
+		result := commandResultFrom(cmd.Context())
+
+		// Post-scaffold hooks act on the files on disk, so a dry run stops after printing the changes.
+		if factory.dryRun != nil {
+			if result != nil {
+				return factory.recordDryRun(result)
+			}
+			if err := factory.dryRun.WriteDiff(cmd.OutOrStdout()); err != nil {
+				return fmt.Errorf("%s: failed to print the dry-run changes: %w", factory.errorMessage, err)
+			}
+			log.Info("Dry run: no changes were written to disk and post-scaffold steps were skipped")
+			return nil
+		}
+
+		// The post-scaffold hooks run external commands, e.g. go mod tidy, whose changes to the module files
+		// are rolled back as well.
+		if err := factory.transaction.Snapshot(postScaffoldSnapshot...); err != nil {
+			return fmt.Errorf("%s: failed to save the module files: %w", factory.errorMessage, err)
+		}
+		factory.postScaffold = true
+
 		// Post-scaffold hook.
 		//nolint:revive
 		if err := factory.forEach(func(subcommand plugin.Subcommand) error {
@@ -575,6 +734,35 @@ func (factory *executionHooksFactory) postRunEFunc() func(*cobra.Command, []stri
 			return err
 		}
 
+		if result != nil {
+			changes, err := factory.transaction.Changes()
+			if err != nil {
+				return fmt.Errorf("%s: failed to list the changed files: %w", factory.errorMessage, err)
+			}
+			result.recordChanges(changes, factory.transaction.Skipped(),
+				factory.resources, resourceSnapshot(factory.store.Config()))
+		}
+
+		// Every hook succeeded, so the changes are kept.
+		factory.transaction = nil
+
 		return nil
 	}
 }
+
+// recordDryRun records in the result the changes that the command would do.
+func (factory *executionHooksFactory) recordDryRun(result *commandResult) error {
+	changes, err := factory.dryRun.Changes()
+	if err != nil {
+		return fmt.Errorf("%s: failed to list the dry-run changes: %w", factory.errorMessage, err)
+	}
+	var diff strings.Builder
+	if err = factory.dryRun.WriteDiff(&diff); err != nil {
+		return fmt.Errorf("%s: failed to print the dry-run changes: %w", factory.errorMessage, err)
+	}
+
+	result.DryRun = true
+	result.Diff = diff.String()
+	result.recordChanges(changes, factory.dryRun.Skipped(), factory.resources, resourceSnapshot(factory.store.Config()))
+	return nil
+}
@@ -17,16 +17,21 @@ limitations under the License.
 package cli
 
 import (
+	"bytes"
 	"errors"
+	"slices"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 )
 
@@ -293,6 +298,73 @@ var _ = Describe("cmd_helpers", func() {
 		})
 	})
 
+	Context("attachedSubcommands", func() {
+		var (
+			testSubcommand *mockTestSubcommand
+			attached       *mockAttachedPlugin
+			cli            *CLI
+		)
+
+		extract := func(p plugin.Plugin) plugin.Subcommand {
+			if mp, ok := p.(*mockAttachedPlugin); ok {
+				return mp.subcommand
+			}
+			return nil
+		}
+		filter := func(p plugin.Plugin) bool { return extract(p) != nil }
+
+		BeforeEach(func() {
+			testSubcommand = &mockTestSubcommand{}
+			attached = &mockAttachedPlugin{
+				mockPluginWithSubcommand: newMockPluginWithSubcommand(
+					"attached", []config.Version{{Number: 1}}, testSubcommand),
+				subcommandNames: []string{plugin.CreateAPISubcommandName},
+			}
+
+			cli = &CLI{
+				attachedPlugins: []plugin.Plugin{attached},
+			}
+		})
+
+		It("should collect the subcommands of the plugins that run attached", func() {
+			result := cli.attachedSubcommands(plugin.CreateAPISubcommandName, nil, filter, extract)
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].key).To(Equal("attached/v1"))
+			Expect(result[0].subcommand).To(Equal(testSubcommand))
+		})
+
+		It("should skip the plugins that do not run attached for the subcommand", func() {
+			result := cli.attachedSubcommands(plugin.CreateWebhookSubcommandName, nil, filter, extract)
+			Expect(result).To(BeEmpty())
+		})
+
+		It("should skip the plugins that are already part of the chain", func() {
+			chain := []keySubcommandTuple{{key: "attached/v1", subcommand: testSubcommand}}
+			result := cli.attachedSubcommands(plugin.CreateAPISubcommandName, chain, filter, extract)
+			Expect(result).To(BeEmpty())
+		})
+	})
+
+	Context("registerFlagValuesCompletion", func() {
+		It("should complete the values of the annotated flags", func() {
+			cmd := &cobra.Command{Use: "edit"}
+			cmd.Flags().String("mode", "", "the mode")
+			cmd.Flags().String("name", "", "the name")
+			Expect(cmd.Flags().SetAnnotation("mode", plugin.FlagValuesAnnotation, []string{"fast", "safe"})).To(Succeed())
+
+			Expect(registerFlagValuesCompletion(cmd)).To(Succeed())
+
+			completion, found := cmd.GetFlagCompletionFunc("mode")
+			Expect(found).To(BeTrue())
+			values, directive := completion(cmd, nil, "")
+			Expect(values).To(Equal([]cobra.Completion{"fast", "safe"}))
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+
+			_, found = cmd.GetFlagCompletionFunc("name")
+			Expect(found).To(BeFalse())
+		})
+	})
+
 	Context("duplicate flag handling (mergeFlagSetInto, syncDuplicateFlags)", func() {
 		It("should not panic when merging two FlagSets that define the same flag name (same type)", func() {
 			dest := pflag.NewFlagSet("dest", pflag.ExitOnError)
@@ -512,8 +584,223 @@ var _ = Describe("cmd_helpers", func() {
 			Expect(pluginB.Force).To(BeTrue(), "second plugin (duplicate) receives same value after sync")
 		})
 	})
+
+	Context("dry run", func() {
+		const (
+			scaffoldedFile = "scaffolded.txt"
+			projectFile    = `version: "3"
+domain: example.com
+layout:
+- go.kubebuilder.io/v4
+projectName: test
+repo: example.com/test
+`
+		)
+
+		var (
+			fs         machinery.Filesystem
+			subcommand *mockWritingSubcommand
+			factory    *executionHooksFactory
+			cmd        *cobra.Command
+			out        *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, yamlstore.DefaultPath, []byte(projectFile),
+				machinery.DefaultFilePermission)).To(Succeed())
+
+			subcommand = &mockWritingSubcommand{path: scaffoldedFile}
+			factory = &executionHooksFactory{
+				fs:           fs,
+				store:        yamlstore.New(fs),
+				subcommands:  []keySubcommandTuple{{key: "test.kubebuilder.io/v1", subcommand: subcommand}},
+				errorMessage: "test",
+				pluginChain:  []string{"test.kubebuilder.io/v1"},
+			}
+
+			out = &bytes.Buffer{}
+			cmd = &cobra.Command{}
+			cmd.SetOut(out)
+			cmd.Flags().Bool(dryRunFlag, false, "")
+		})
+
+		run := func() {
+			Expect(factory.preRunEFunc(nil, false)(cmd, nil)).To(Succeed())
+			Expect(factory.runEFunc()(cmd, nil)).To(Succeed())
+			Expect(factory.postRunEFunc()(cmd, nil)).To(Succeed())
+		}
+
+		It("should print a diff and leave the filesystem untouched when --dry-run is set", func() {
+			Expect(cmd.Flags().Set(dryRunFlag, flagValueTrue)).To(Succeed())
+			run()
+
+			exists, err := afero.Exists(fs.FS, scaffoldedFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+
+			content, err := afero.ReadFile(fs.FS, yamlstore.DefaultPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(projectFile))
+
+			Expect(out.String()).To(ContainSubstring("--- /dev/null\n+++ b/" + scaffoldedFile))
+			Expect(out.String()).To(ContainSubstring("--- a/PROJECT\n+++ b/PROJECT"))
+			Expect(out.String()).To(ContainSubstring("+- test.kubebuilder.io/v1"))
+			Expect(subcommand.postScaffolded).To(BeFalse())
+		})
+
+		It("should write the changes when --dry-run is not set", func() {
+			run()
+
+			exists, err := afero.Exists(fs.FS, scaffoldedFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(out.String()).To(BeEmpty())
+			Expect(subcommand.postScaffolded).To(BeTrue())
+		})
+
+		It("should roll back the changes and report them when a post-scaffold hook fails", func() {
+			subcommand.postScaffoldErr = errors.New("post-scaffold failure")
+
+			Expect(factory.rollbackOnError(factory.preRunEFunc(nil, false))(cmd, nil)).To(Succeed())
+			Expect(factory.rollbackOnError(factory.runEFunc())(cmd, nil)).To(Succeed())
+			err := factory.rollbackOnError(factory.postRunEFunc())(cmd, nil)
+			Expect(err).To(MatchError(subcommand.postScaffoldErr))
+
+			var rbErr rollbackError
+			Expect(errors.As(err, &rbErr)).To(BeTrue())
+			Expect(rbErr.restored).To(Equal([]machinery.RestoredFile{
+				{Path: scaffoldedFile, Removed: true},
+				{Path: yamlstore.DefaultPath},
+			}))
+			Expect(err.Error()).To(ContainSubstring(scaffoldedFile + " (removed)"))
+			Expect(err.Error()).To(ContainSubstring(yamlstore.DefaultPath + " (restored)"))
+
+			exists, err := afero.Exists(fs.FS, scaffoldedFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+
+			content, err := afero.ReadFile(fs.FS, yamlstore.DefaultPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(projectFile))
+		})
+
+		It("should restore the files edited with the plugin util helpers when a post-scaffold hook fails", func() {
+			const (
+				editedFile = "config/manager/manager.yaml"
+				manifest   = "env:\n- name: A\n"
+			)
+			Expect(afero.WriteFile(fs.FS, editedFile, []byte(manifest), machinery.DefaultFilePermission)).To(Succeed())
+			subcommand.editPath = editedFile
+			subcommand.postScaffoldErr = errors.New("post-scaffold failure")
+
+			Expect(factory.rollbackOnError(factory.preRunEFunc(nil, false))(cmd, nil)).To(Succeed())
+			Expect(factory.rollbackOnError(factory.runEFunc())(cmd, nil)).To(Succeed())
+
+			content, err := afero.ReadFile(fs.FS, editedFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("- name: B"))
+
+			err = factory.rollbackOnError(factory.postRunEFunc())(cmd, nil)
+			Expect(err).To(MatchError(subcommand.postScaffoldErr))
+
+			var rbErr rollbackError
+			Expect(errors.As(err, &rbErr)).To(BeTrue())
+			Expect(rbErr.restored).To(ContainElement(machinery.RestoredFile{Path: editedFile}))
+
+			content, err = afero.ReadFile(fs.FS, editedFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(manifest))
+		})
+
+		It("should restore the module files changed by the post-scaffold commands when a hook fails", func() {
+			const goMod = "module example.com/test\n"
+			Expect(afero.WriteFile(fs.FS, "go.mod", []byte(goMod), machinery.DefaultFilePermission)).To(Succeed())
+			subcommand.postScaffold = func() {
+				// External commands write to disk without going through the transaction
+				Expect(afero.WriteFile(fs.FS, "go.mod", []byte(goMod+"\nrequire example.com/dep v1.0.0\n"),
+					machinery.DefaultFilePermission)).To(Succeed())
+				Expect(afero.WriteFile(fs.FS, "go.sum", []byte("example.com/dep v1.0.0 h1:abc=\n"),
+					machinery.DefaultFilePermission)).To(Succeed())
+			}
+			subcommand.postScaffoldErr = errors.New("post-scaffold failure")
+
+			Expect(factory.rollbackOnError(factory.preRunEFunc(nil, false))(cmd, nil)).To(Succeed())
+			Expect(factory.rollbackOnError(factory.runEFunc())(cmd, nil)).To(Succeed())
+			err := factory.rollbackOnError(factory.postRunEFunc())(cmd, nil)
+			Expect(err).To(MatchError(subcommand.postScaffoldErr))
+
+			var rbErr rollbackError
+			Expect(errors.As(err, &rbErr)).To(BeTrue())
+			Expect(rbErr.restored).To(ContainElements(
+				machinery.RestoredFile{Path: "go.mod"},
+				machinery.RestoredFile{Path: "go.sum", Removed: true},
+			))
+			Expect(err.Error()).To(ContainSubstring(
+				"Changes made by the post-scaffold commands (e.g. make) to files other than go.mod and go.sum " +
+					"were not rolled back"))
+
+			content, err := afero.ReadFile(fs.FS, "go.mod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(goMod))
+			exists, err := afero.Exists(fs.FS, "go.sum")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should return the original error when nothing was changed", func() {
+			scaffoldErr := errors.New("scaffold failure")
+			factory.subcommands = []keySubcommandTuple{{
+				key:        "test.kubebuilder.io/v1",
+				subcommand: &mockFailingSubcommand{err: scaffoldErr},
+			}}
+
+			Expect(factory.rollbackOnError(factory.preRunEFunc(nil, false))(cmd, nil)).To(Succeed())
+			err := factory.rollbackOnError(factory.runEFunc())(cmd, nil)
+			Expect(err).To(MatchError(scaffoldErr))
+			Expect(errors.As(err, &rollbackError{})).To(BeFalse())
+		})
+	})
 })
 
+// mockFailingSubcommand fails when scaffolding.
+type mockFailingSubcommand struct {
+	err error
+}
+
+func (m *mockFailingSubcommand) Scaffold(machinery.Filesystem) error {
+	return m.err
+}
+
+// mockWritingSubcommand writes a file when scaffolding, optionally edits an existing one with the
+// plugin util helpers, and records the post-scaffold hook.
+type mockWritingSubcommand struct {
+	path            string
+	editPath        string
+	postScaffolded  bool
+	postScaffoldErr error
+	// postScaffold simulates the external commands run by the post-scaffold hook.
+	postScaffold func()
+}
+
+func (m *mockWritingSubcommand) Scaffold(fs machinery.Filesystem) error {
+	if err := afero.WriteFile(fs.FS, m.path, []byte("content\n"), machinery.DefaultFilePermission); err != nil {
+		return err
+	}
+	if m.editPath == "" {
+		return nil
+	}
+	return util.NewFileEditor(fs).InsertCode(m.editPath, "- name: A\n", "- name: B\n")
+}
+
+func (m *mockWritingSubcommand) PostScaffold() error {
+	m.postScaffolded = true
+	if m.postScaffold != nil {
+		m.postScaffold()
+	}
+	return m.postScaffoldErr
+}
+
 type mockTestSubcommand struct{}
 
 func (m *mockTestSubcommand) Scaffold(machinery.Filesystem) error {
@@ -563,6 +850,15 @@ func (m *mockPluginWithSubcommand) SupportedProjectVersions() []config.Version {
 	return m.supportedProjectVersions
 }
 
+type mockAttachedPlugin struct {
+	*mockPluginWithSubcommand
+	subcommandNames []string
+}
+
+func (m *mockAttachedPlugin) RunsAttached(name string) bool {
+	return slices.Contains(m.subcommandNames, name)
+}
+
 type mockPluginBundle struct {
 	name                     string
 	supportedProjectVersions []config.Version
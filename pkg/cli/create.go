@@ -38,8 +38,8 @@ Available plugins that support 'create' subcommands:
 
 %s
 `, c.getPluginTableFilteredForSubcommand(func(p plugin.Plugin) bool {
-			_, hasCreateAPI := p.(plugin.CreateAPI)
-			_, hasCreateWebhook := p.(plugin.CreateWebhook)
+			_, hasCreateAPI := plugin.AsCreateAPI(p)
+			_, hasCreateWebhook := plugin.AsCreateWebhook(p)
 			return hasCreateAPI || hasCreateWebhook
 		})),
 	}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+//nolint:dupl
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+const (
+	deleteAPIErrorMsg     = "failed to delete API"
+	deleteWebhookErrorMsg = "failed to delete webhook"
+)
+
+func (c CLI) newDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete",
+		Short: "Remove a scaffolded Kubernetes API or webhook",
+		Long: fmt.Sprintf(`Remove a scaffolded Kubernetes API or webhook.
+
+Use "delete api" to remove a resource and its controllers from the project.
+Use "delete webhook" to remove the webhooks of a resource.
+
+Available plugins that support 'delete' subcommands:
+
+%s
+`, c.getPluginTableFilteredForSubcommand(func(p plugin.Plugin) bool {
+			_, hasDeleteAPI := plugin.AsDeleteAPI(p)
+			_, hasDeleteWebhook := plugin.AsDeleteWebhook(p)
+			return hasDeleteAPI || hasDeleteWebhook
+		})),
+	}
+}
+
+func (c CLI) newDeleteAPICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "api",
+		Short: "Remove a scaffolded Kubernetes API",
+		Long: `Remove a scaffolded Kubernetes API, undoing what "create api" scaffolded for the resource
+and its controllers, and drop the resource from the PROJECT file.
+
+Run this command from an initialized project.`,
+		RunE: errCmdFunc(
+			fmt.Errorf("api subcommand requires an existing project"),
+		),
+	}
+
+	// In case no plugin was resolved, instead of failing the construction of the CLI, fail the execution of
+	// this subcommand. This allows the use of subcommands that do not require resolved plugins like help.
+	if len(c.resolvedPlugins) == 0 {
+		cmdErr(cmd, noResolvedPluginError{})
+		return cmd
+	}
+
+	// Obtain the plugin keys and subcommands from the plugins that implement plugin.DeleteAPI.
+	subcommands := c.filterSubcommands(
+		func(p plugin.Plugin) bool {
+			_, isValid := plugin.AsDeleteAPI(p)
+			return isValid
+		},
+		func(p plugin.Plugin) plugin.Subcommand {
+			return p.(plugin.DeleteAPI).GetDeleteAPISubcommand()
+		},
+	)
+
+	// Verify that there is at least one remaining plugin, hiding the subcommand otherwise.
+	if len(subcommands) == 0 {
+		cmd.Hidden = true
+		cmdErr(cmd, noAvailablePluginError{"API deletion"})
+		return cmd
+	}
+
+	c.applySubcommandHooks(cmd, subcommands, deleteAPIErrorMsg, false)
+
+	// Append plugin table after metadata updates
+	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
+		_, isValid := plugin.AsDeleteAPI(p)
+		return isValid
+	}, "Available plugins that support 'delete api'")
+
+	return cmd
+}
+
+func (c CLI) newDeleteWebhookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Remove the scaffolded webhooks of an API resource",
+		Long: `Remove the scaffolded webhooks of an API resource, undoing what "create webhook" scaffolded,
+and drop them from the PROJECT file.
+
+Run this command from an initialized project.`,
+		RunE: errCmdFunc(
+			fmt.Errorf("webhook subcommand requires an existing project"),
+		),
+	}
+
+	// In case no plugin was resolved, instead of failing the construction of the CLI, fail the execution of
+	// this subcommand. This allows the use of subcommands that do not require resolved plugins like help.
+	if len(c.resolvedPlugins) == 0 {
+		cmdErr(cmd, noResolvedPluginError{})
+		return cmd
+	}
+
+	// Obtain the plugin keys and subcommands from the plugins that implement plugin.DeleteWebhook.
+	subcommands := c.filterSubcommands(
+		func(p plugin.Plugin) bool {
+			_, isValid := plugin.AsDeleteWebhook(p)
+			return isValid
+		},
+		func(p plugin.Plugin) plugin.Subcommand {
+			return p.(plugin.DeleteWebhook).GetDeleteWebhookSubcommand()
+		},
+	)
+
+	// Verify that there is at least one remaining plugin, hiding the subcommand otherwise.
+	if len(subcommands) == 0 {
+		cmd.Hidden = true
+		cmdErr(cmd, noAvailablePluginError{"webhook deletion"})
+		return cmd
+	}
+
+	c.applySubcommandHooks(cmd, subcommands, deleteWebhookErrorMsg, false)
+
+	// Append plugin table after metadata updates
+	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
+		_, isValid := plugin.AsDeleteWebhook(p)
+		return isValid
+	}, "Available plugins that support 'delete webhook'")
+
+	return cmd
+}
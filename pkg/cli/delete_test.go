@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+// mockDeleteSubcommand is a delete api and delete webhook subcommand.
+type mockDeleteSubcommand struct {
+	mockTestSubcommand
+}
+
+func (m *mockDeleteSubcommand) InjectResource(*resource.Resource) error {
+	return nil
+}
+
+// mockDeletePlugin is a plugin that provides the delete api subcommand.
+type mockDeletePlugin struct {
+	mockPluginWithSubcommand
+}
+
+func (m *mockDeletePlugin) GetDeleteAPISubcommand() plugin.DeleteAPISubcommand {
+	return m.subcommand.(plugin.DeleteAPISubcommand)
+}
+
+var _ = Describe("delete", func() {
+	Context("constants", func() {
+		It("should have correct error messages", func() {
+			Expect(deleteAPIErrorMsg).To(Equal("failed to delete API"))
+			Expect(deleteWebhookErrorMsg).To(Equal("failed to delete webhook"))
+		})
+	})
+
+	Context("subcommands", func() {
+		var c *CLI
+
+		BeforeEach(func() {
+			deletePlugin := &mockDeletePlugin{
+				mockPluginWithSubcommand: *newMockPluginWithSubcommand(
+					"delete.test.io", []config.Version{{Number: 3}}, &mockDeleteSubcommand{}),
+			}
+			c = &CLI{
+				commandName:     "kubebuilder",
+				plugins:         map[string]plugin.Plugin{plugin.KeyFor(deletePlugin): deletePlugin},
+				resolvedPlugins: []plugin.Plugin{deletePlugin},
+			}
+		})
+
+		It("should bind the resource flags of the plugins that can delete APIs", func() {
+			cmd := c.newDeleteAPICmd()
+			Expect(cmd.Hidden).To(BeFalse())
+			Expect(cmd.Flags().Lookup("kind")).NotTo(BeNil())
+			Expect(cmd.Long).To(ContainSubstring("delete.test/v1"))
+		})
+
+		It("should list the default scaffold plugins when they are the only ones that can delete APIs", func() {
+			c.plugins = map[string]plugin.Plugin{pluginGoKubebuilderV4: c.resolvedPlugins[0]}
+
+			cmd := c.newDeleteAPICmd()
+			Expect(cmd.Long).NotTo(ContainSubstring("No plugins available"))
+			Expect(cmd.Long).To(ContainSubstring("go/v4"))
+		})
+
+		It("should hide the subcommands that no plugin provides", func() {
+			cmd := c.newDeleteWebhookCmd()
+			Expect(cmd.Hidden).To(BeTrue())
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(noAvailablePluginError{"webhook deletion"}))
+		})
+	})
+})
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+const (
+	fixFlag = "fix"
+
+	checkPluginChain = "plugin-chain"
+	checkCliVersion  = "cli-version"
+)
+
+// diagnosis is a problem found in the project by the doctor command.
+type diagnosis struct {
+	Check string `json:"check"`
+	// Plugin is the key of the plugin that found the problem. It is empty for the checks of the CLI.
+	Plugin  string `json:"plugin,omitempty"`
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+	Fixable bool   `json:"fixable"`
+	Fixed   bool   `json:"fixed"`
+
+	fix func(machinery.Filesystem) error
+}
+
+func (c CLI) newDoctorCmd() *cobra.Command {
+	var fix bool
+	cmd := &cobra.Command{
+		Use:   kubebuilderSubcommandDoctor,
+		Short: "Check the project for drift from its PROJECT file",
+		Long: `Check that the project is consistent with its PROJECT file.
+
+The plugin chain is checked against the installed plugins, and the CLI version that scaffolded
+the project against the running one. The plugins of the chain then check the files they scaffold,
+such as the Go types of the APIs, the CRDs listed in config/crd/kustomization.yaml, and the
+controllers and webhooks registered in cmd/main.go.
+
+Each problem is reported with a hint to fix it. With --fix, the problems that can be fixed safely
+are fixed with the same scaffolding used by the other subcommands, and the rest are reported.
+The command fails if any problem is left.`,
+		Example: fmt.Sprintf(`  # Check the project
+  %[1]s doctor
+
+  # Fix the problems that can be fixed safely
+  %[1]s doctor --fix
+
+  # Preview the fixes without writing them to disk
+  %[1]s doctor --fix --dry-run`, c.commandName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return c.runDoctor(cmd, fix)
+		},
+	}
+	cmd.Flags().BoolVar(&fix, fixFlag, false, "Fix the problems that can be fixed safely")
+	return cmd
+}
+
+// runDoctor checks the project in the current directory and optionally fixes the problems found.
+func (c CLI) runDoctor(cmd *cobra.Command, fix bool) error {
+	store := yamlstore.New(c.fs)
+	if err := store.Load(); errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to find configuration file, project must be initialized")
+	} else if err != nil {
+		return fmt.Errorf("failed to load configuration file: %w", err)
+	}
+	cfg := store.Config()
+
+	diagnoses, plugins := c.diagnosePluginChain(cfg)
+	diagnoses = append(diagnoses, c.diagnoseCliVersion(cfg)...)
+	pluginDiagnoses, err := diagnosePlugins(cfg, c.fs, plugins)
+	if err != nil {
+		return err
+	}
+	diagnoses = append(diagnoses, pluginDiagnoses...)
+
+	if fix && slices.ContainsFunc(diagnoses, func(d diagnosis) bool { return d.Fixable }) {
+		if err = applyFixes(cmd, cfg, c.fs, plugins, diagnoses); err != nil {
+			return err
+		}
+	}
+
+	if err = writeResult(cmd, diagnoses, func(out io.Writer) error {
+		writeDiagnoses(out, diagnoses)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if left := len(slices.DeleteFunc(slices.Clone(diagnoses), func(d diagnosis) bool { return d.Fixed })); left != 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d problem(s) found in the project", left)
+	}
+	return nil
+}
+
+// diagnosePluginChain resolves the plugin chain of the project with the installed plugins. It returns the
+// problems found with the keys that do not match exactly one plugin, and the plugins resolved for the rest.
+func (c CLI) diagnosePluginChain(cfg config.Config) ([]diagnosis, []plugin.Plugin) {
+	available := make([]plugin.Plugin, 0, len(c.plugins))
+	for _, key := range c.sortedPluginKeys() {
+		available = append(available, c.plugins[key])
+	}
+
+	diagnoses := []diagnosis{}
+	resolved := make([]plugin.Plugin, 0, len(cfg.GetPluginChain()))
+	for _, key := range cfg.GetPluginChain() {
+		matching, err := plugin.FilterPluginsByKey(available, key)
+		if err == nil {
+			matching = plugin.FilterPluginsByProjectVersion(matching, cfg.GetVersion())
+		}
+
+		switch {
+		case err != nil:
+			diagnoses = append(diagnoses, diagnosis{
+				Check:   checkPluginChain,
+				Message: fmt.Sprintf("invalid plugin key %q in the plugin chain: %v", key, err),
+				Hint:    "fix the key in the layout field of the PROJECT file",
+			})
+		case len(matching) == 1:
+			resolved = append(resolved, matching[0])
+		case len(matching) == 0:
+			diagnoses = append(diagnoses, diagnosis{
+				Check: checkPluginChain,
+				Message: fmt.Sprintf("no installed plugin matches the key %q of the plugin chain for project version %q",
+					key, cfg.GetVersion()),
+				Hint: fmt.Sprintf("install the plugin, or upgrade the layout with \"%s alpha generate\" "+
+					"if it was removed from this version of %s", c.commandName, c.commandName),
+			})
+		default:
+			diagnoses = append(diagnoses, diagnosis{
+				Check:   checkPluginChain,
+				Message: fmt.Sprintf("the key %q of the plugin chain matches several installed plugins", key),
+				Hint:    "use the fully qualified key, including the version, in the layout field of the PROJECT file",
+			})
+		}
+	}
+
+	return diagnoses, resolved
+}
+
+// diagnoseCliVersion checks whether the project was scaffolded with an older version of the CLI.
+func (c CLI) diagnoseCliVersion(cfg config.Config) []diagnosis {
+	scaffoldedWith := "v" + strings.TrimPrefix(cfg.GetCliVersion(), "v")
+	running := "v" + strings.TrimPrefix(c.cliVersion, "v")
+	if !semver.IsValid(scaffoldedWith) || !semver.IsValid(running) || semver.Compare(scaffoldedWith, running) >= 0 {
+		return nil
+	}
+
+	return []diagnosis{{
+		Check: checkCliVersion,
+		Message: fmt.Sprintf("the project was scaffolded with %s %s, which is older than the running version %s",
+			c.commandName, scaffoldedWith, running),
+		Hint: fmt.Sprintf("upgrade the scaffold of the project with \"%s alpha update\"", c.commandName),
+	}}
+}
+
+// diagnosePlugins returns the problems found by the plugins, or by the bundled plugins for bundles.
+func diagnosePlugins(cfg config.Config, fs machinery.Filesystem, plugins []plugin.Plugin) ([]diagnosis, error) {
+	var diagnoses []diagnosis
+	checked := make(map[string]struct{}, len(plugins))
+	for _, p := range flattenPlugins(plugins) {
+		key := plugin.KeyFor(p)
+		diagnosable, isDiagnosable := p.(plugin.Diagnosable)
+		if _, done := checked[key]; done || !isDiagnosable {
+			continue
+		}
+		checked[key] = struct{}{}
+
+		found, err := diagnosable.Diagnose(cfg, fs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the project with %q: %w", key, err)
+		}
+		for _, d := range found {
+			diagnoses = append(diagnoses, diagnosis{
+				Check:   d.Check,
+				Plugin:  key,
+				Message: d.Message,
+				Hint:    d.Hint,
+				Fixable: d.Fix != nil,
+				fix:     d.Fix,
+			})
+		}
+	}
+	return diagnoses, nil
+}
+
+// flattenPlugins replaces the bundles by the plugins they group.
+func flattenPlugins(plugins []plugin.Plugin) []plugin.Plugin {
+	flattened := make([]plugin.Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		if bundle, isBundle := p.(plugin.Bundle); isBundle {
+			flattened = append(flattened, bundle.Plugins()...)
+			continue
+		}
+		flattened = append(flattened, p)
+	}
+	return flattened
+}
+
+// applyFixes applies the fixes of the diagnoses, rolling them back if any fails. With --dry-run, the fixes
+// are kept in memory and printed instead. The problems that are not found again once the fixes are applied
+// are flagged as fixed.
+func applyFixes(cmd *cobra.Command, cfg config.Config, fs machinery.Filesystem,
+	plugins []plugin.Plugin, diagnoses []diagnosis,
+) error {
+	result := commandResultFrom(cmd.Context())
+	resources := resourceSnapshot(cfg)
+
+	var dryRun *machinery.DryRun
+	var transaction *machinery.Transaction
+	if isDryRun, _ := cmd.Flags().GetBool(dryRunFlag); isDryRun {
+		dryRun = machinery.NewDryRun(fs)
+		fs = dryRun.Filesystem()
+	} else {
+		transaction = machinery.NewTransaction(fs)
+		fs = transaction.Filesystem()
+	}
+
+	for _, d := range diagnoses {
+		if d.fix == nil {
+			continue
+		}
+		if err := d.fix(fs); err != nil {
+			err = fmt.Errorf("failed to fix %q: %w", d.Message, err)
+			if transaction == nil {
+				return err
+			}
+			restored, rollbackErr := transaction.Rollback()
+			return rollbackError{err: err, restored: restored, rollbackErr: rollbackErr}
+		}
+	}
+
+	left, err := diagnosePlugins(cfg, fs, plugins)
+	if err != nil {
+		return err
+	}
+	for i, d := range diagnoses {
+		diagnoses[i].Fixed = d.Fixable && !slices.ContainsFunc(left, func(l diagnosis) bool {
+			return l.Check == d.Check && l.Message == d.Message
+		})
+	}
+
+	if dryRun != nil {
+		if result != nil {
+			return recordDoctorDryRun(result, dryRun, resources)
+		}
+		if err = dryRun.WriteDiff(cmd.OutOrStdout()); err != nil {
+			return fmt.Errorf("failed to print the dry-run changes: %w", err)
+		}
+		return nil
+	}
+
+	if result != nil {
+		changes, changesErr := transaction.Changes()
+		if changesErr != nil {
+			return fmt.Errorf("failed to list the changed files: %w", changesErr)
+		}
+		result.recordChanges(changes, transaction.Skipped(), resources, resources)
+	}
+	return nil
+}
+
+// recordDoctorDryRun records in the result the changes that the fixes would do.
+func recordDoctorDryRun(result *commandResult, dryRun *machinery.DryRun, resources []resource.Resource) error {
+	changes, err := dryRun.Changes()
+	if err != nil {
+		return fmt.Errorf("failed to list the dry-run changes: %w", err)
+	}
+	var diff strings.Builder
+	if err = dryRun.WriteDiff(&diff); err != nil {
+		return fmt.Errorf("failed to print the dry-run changes: %w", err)
+	}
+
+	result.DryRun = true
+	result.Diff = diff.String()
+	result.recordChanges(changes, dryRun.Skipped(), resources, resources)
+	return nil
+}
+
+// writeDiagnoses writes the problems found in the project in text format.
+func writeDiagnoses(out io.Writer, diagnoses []diagnosis) {
+	if len(diagnoses) == 0 {
+		_, _ = fmt.Fprintln(out, "No problems found in the project")
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "Found %d problem(s) in the project:\n", len(diagnoses))
+	for _, d := range diagnoses {
+		status := ""
+		switch {
+		case d.Fixed:
+			status = " (fixed)"
+		case d.Fixable:
+			status = " (fixable with --" + fixFlag + ")"
+		}
+		_, _ = fmt.Fprintf(out, "  - [%s] %s%s\n", d.Check, d.Message, status)
+		if d.Hint != "" && !d.Fixed {
+			_, _ = fmt.Fprintf(out, "    hint: %s\n", d.Hint)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+const doctorTestFile = "registered.txt"
+
+var _ plugin.Diagnosable = mockDiagnosablePlugin{}
+
+// mockDiagnosablePlugin reports doctorTestFile as missing, which can be fixed, and a problem that cannot.
+type mockDiagnosablePlugin struct {
+	mockPlugin
+	unfixable bool
+}
+
+func (p mockDiagnosablePlugin) Diagnose(_ config.Config, fs machinery.Filesystem) ([]plugin.Diagnostic, error) {
+	var diagnostics []plugin.Diagnostic
+	if exists, _ := afero.Exists(fs.FS, doctorTestFile); !exists {
+		diagnostics = append(diagnostics, plugin.Diagnostic{
+			Check:   "registration",
+			Message: doctorTestFile + " is missing",
+			Fix: func(fs machinery.Filesystem) error {
+				return afero.WriteFile(fs.FS, doctorTestFile, []byte("registered\n"), machinery.DefaultFilePermission)
+			},
+		})
+	}
+	if p.unfixable {
+		diagnostics = append(diagnostics, plugin.Diagnostic{
+			Check:   "types",
+			Message: "the types are missing",
+			Hint:    "restore them",
+		})
+	}
+	return diagnostics, nil
+}
+
+var _ = Describe("doctor", func() {
+	const cliVersion = "4.2.0"
+
+	var (
+		fs        machinery.Filesystem
+		diagnoser mockDiagnosablePlugin
+		out       *bytes.Buffer
+	)
+
+	writeProject := func(scaffoldedWith string, chain ...string) {
+		GinkgoHelper()
+
+		store := yamlstore.New(fs)
+		Expect(store.New(cfgv3.Version)).To(Succeed())
+		Expect(store.Config().SetCliVersion(scaffoldedWith)).To(Succeed())
+		Expect(store.Config().SetPluginChain(chain)).To(Succeed())
+		Expect(store.Save()).To(Succeed())
+	}
+
+	run := func(args ...string) error {
+		GinkgoHelper()
+
+		originalArgs := os.Args
+		DeferCleanup(func() { os.Args = originalArgs })
+		args = append([]string{kubebuilderSubcommandDoctor}, args...)
+		os.Args = append([]string{kubebuilderCommandName}, args...)
+
+		bundle := newMockPluginBundle("bundle.test.io", []config.Version{cfgv3.Version}, []plugin.Plugin{diagnoser})
+		c, err := New(
+			WithPlugins(bundle),
+			WithDefaultPlugins(cfgv3.Version, bundle),
+			WithDefaultProjectVersion(cfgv3.Version),
+			WithCliVersion(cliVersion),
+			WithFilesystem(fs),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		c.cmd.SetOut(out)
+		c.cmd.SetErr(&bytes.Buffer{})
+		c.cmd.SetArgs(args)
+
+		return c.Run()
+	}
+
+	fileExists := func() bool {
+		exists, err := afero.Exists(fs.FS, doctorTestFile)
+		Expect(err).NotTo(HaveOccurred())
+		return exists
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		diagnoser = mockDiagnosablePlugin{
+			mockPlugin: newMockPlugin("diagnosable.test.io", "v1", cfgv3.Version).(mockPlugin),
+		}
+		out = &bytes.Buffer{}
+	})
+
+	It("should fail if the project is not initialized", func() {
+		Expect(run()).To(MatchError(ContainSubstring("project must be initialized")))
+	})
+
+	It("should succeed when no problem is found", func() {
+		writeProject(cliVersion, "bundle.test.io/v1")
+		Expect(afero.WriteFile(fs.FS, doctorTestFile, []byte{}, machinery.DefaultFilePermission)).To(Succeed())
+
+		Expect(run()).To(Succeed())
+		Expect(out.String()).To(Equal("No problems found in the project\n"))
+	})
+
+	It("should report the problems found by the CLI and by the plugins", func() {
+		writeProject("4.0.0", "bundle.test.io/v1", "missing.test.io/v1")
+
+		Expect(run()).To(MatchError(ContainSubstring("3 problem(s) found in the project")))
+		Expect(out.String()).To(ContainSubstring(`[plugin-chain] no installed plugin matches the key "missing.test.io/v1"`))
+		Expect(out.String()).To(ContainSubstring("[cli-version] the project was scaffolded with kubebuilder v4.0.0"))
+		Expect(out.String()).To(ContainSubstring("[registration] registered.txt is missing (fixable with --fix)"))
+		Expect(fileExists()).To(BeFalse())
+	})
+
+	It("should fix the problems that can be fixed", func() {
+		writeProject(cliVersion, "bundle.test.io/v1")
+
+		Expect(run("--" + fixFlag)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("[registration] registered.txt is missing (fixed)"))
+		Expect(fileExists()).To(BeTrue())
+	})
+
+	It("should fail when some problems cannot be fixed", func() {
+		writeProject(cliVersion, "bundle.test.io/v1")
+		diagnoser.unfixable = true
+
+		Expect(run("--" + fixFlag)).To(MatchError(ContainSubstring("1 problem(s) found in the project")))
+		Expect(out.String()).To(ContainSubstring("(fixed)"))
+		Expect(out.String()).To(ContainSubstring("hint: restore them"))
+		Expect(fileExists()).To(BeTrue())
+	})
+
+	It("should only print the fixes in a dry run", func() {
+		writeProject(cliVersion, "bundle.test.io/v1")
+
+		Expect(run("--"+fixFlag, dryRunFlagArg)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("+++ b/" + doctorTestFile))
+		Expect(fileExists()).To(BeFalse())
+	})
+
+	It("should report the problems and the fixed files in the JSON output", func() {
+		writeProject(cliVersion, "bundle.test.io/v1")
+		devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		Expect(err).NotTo(HaveOccurred())
+		stderr := os.Stderr
+		os.Stderr = devNull
+		DeferCleanup(func() {
+			os.Stderr = stderr
+			_ = devNull.Close()
+		})
+
+		Expect(run("--"+fixFlag, "-o", "json")).To(Succeed())
+
+		var diagnoses []diagnosis
+		result := &commandResult{Data: &diagnoses}
+		Expect(json.Unmarshal(out.Bytes(), result)).To(Succeed())
+		Expect(result.Command).To(Equal(kubebuilderSubcommandDoctor))
+		Expect(result.Files.Created).To(Equal([]string{doctorTestFile}))
+		Expect(diagnoses).To(Equal([]diagnosis{{
+			Check:   "registration",
+			Plugin:  "diagnosable.test.io/v1",
+			Message: doctorTestFile + " is missing",
+			Fixable: true,
+			Fixed:   true,
+		}}))
+	})
+})
@@ -25,7 +25,10 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
 
-const editErrorMsg = "failed to edit project"
+const (
+	editErrorMsg         = "failed to edit project"
+	editResourceErrorMsg = "failed to edit resource"
+)
 
 func (c CLI) newEditCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -34,7 +37,9 @@ func (c CLI) newEditCmd() *cobra.Command {
 		Long: `Update an initialized project's configuration and scaffold files needed for the selected settings.
 
 Use this command to enable layout options such as multigroup or namespace-scoped deployment, or to run optional
-plugins that modify an existing project.`,
+plugins that modify an existing project.
+
+Use "edit resource" to rename or move a scaffolded API to another group, version or kind.`,
 		RunE: errCmdFunc(
 			fmt.Errorf("project must be initialized"),
 		),
@@ -50,7 +55,7 @@ plugins that modify an existing project.`,
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.Edit.
 	subcommands := c.filterSubcommands(
 		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.Edit)
+			_, isValid := plugin.AsEdit(p)
 			return isValid
 		},
 		func(p plugin.Plugin) plugin.Subcommand {
@@ -58,8 +63,9 @@ plugins that modify an existing project.`,
 		},
 	)
 
-	// Verify that there is at least one remaining plugin.
+	// Verify that there is at least one remaining plugin, hiding the subcommand otherwise.
 	if len(subcommands) == 0 {
+		cmd.Hidden = true
 		cmdErr(cmd, noAvailablePluginError{"edit project"})
 		return cmd
 	}
@@ -68,9 +74,58 @@ plugins that modify an existing project.`,
 
 	// Append plugin table after metadata updates
 	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.Edit)
+		_, isValid := plugin.AsEdit(p)
 		return isValid
 	}, "Available plugins that support 'edit'")
 
 	return cmd
 }
+
+func (c CLI) newEditResourceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resource",
+		Short: "Rename or move a scaffolded Kubernetes API",
+		Long: `Rename or move a scaffolded Kubernetes API to another group, version or kind, updating
+the files scaffolded for the resource and the PROJECT file consistently.
+
+Run this command from an initialized project.`,
+		RunE: errCmdFunc(
+			fmt.Errorf("resource subcommand requires an existing project"),
+		),
+	}
+
+	// In case no plugin was resolved, instead of failing the construction of the CLI, fail the execution of
+	// this subcommand. This allows the use of subcommands that do not require resolved plugins like help.
+	if len(c.resolvedPlugins) == 0 {
+		cmdErr(cmd, noResolvedPluginError{})
+		return cmd
+	}
+
+	// Obtain the plugin keys and subcommands from the plugins that implement plugin.EditResource.
+	subcommands := c.filterSubcommands(
+		func(p plugin.Plugin) bool {
+			_, isValid := plugin.AsEditResource(p)
+			return isValid
+		},
+		func(p plugin.Plugin) plugin.Subcommand {
+			return p.(plugin.EditResource).GetEditResourceSubcommand()
+		},
+	)
+
+	// Verify that there is at least one remaining plugin, hiding the subcommand otherwise.
+	if len(subcommands) == 0 {
+		cmd.Hidden = true
+		cmdErr(cmd, noAvailablePluginError{"resource edition"})
+		return cmd
+	}
+
+	c.applySubcommandHooks(cmd, subcommands, editResourceErrorMsg, false)
+
+	// Append plugin table after metadata updates
+	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
+		_, isValid := plugin.AsEditResource(p)
+		return isValid
+	}, "Available plugins that support 'edit resource'")
+
+	return cmd
+}
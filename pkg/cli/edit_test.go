@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+// mockEditResourcePlugin is a plugin that provides the edit resource subcommand.
+type mockEditResourcePlugin struct {
+	mockPluginWithSubcommand
+}
+
+func (m *mockEditResourcePlugin) GetEditResourceSubcommand() plugin.EditResourceSubcommand {
+	return m.subcommand
+}
+
+var _ = Describe("edit resource", func() {
+	It("should have the correct error message", func() {
+		Expect(editResourceErrorMsg).To(Equal("failed to edit resource"))
+	})
+
+	It("should list the plugins that can edit resources", func() {
+		editPlugin := &mockEditResourcePlugin{
+			mockPluginWithSubcommand: *newMockPluginWithSubcommand(
+				"edit.test.io", []config.Version{{Number: 3}}, &mockTestSubcommand{}),
+		}
+		c := &CLI{
+			commandName:     "kubebuilder",
+			plugins:         map[string]plugin.Plugin{plugin.KeyFor(editPlugin): editPlugin},
+			resolvedPlugins: []plugin.Plugin{editPlugin},
+		}
+
+		cmd := c.newEditResourceCmd()
+		Expect(cmd.Hidden).To(BeFalse())
+		Expect(cmd.Long).To(ContainSubstring("edit.test/v1"))
+	})
+
+	It("should be hidden if no plugin provides it", func() {
+		basicPlugin := newMockPlugin("basic.test.io", "v1", config.Version{Number: 3})
+		c := &CLI{
+			commandName:     "kubebuilder",
+			plugins:         map[string]plugin.Plugin{plugin.KeyFor(basicPlugin): basicPlugin},
+			resolvedPlugins: []plugin.Plugin{basicPlugin},
+		}
+
+		cmd := c.newEditResourceCmd()
+		Expect(cmd.Hidden).To(BeTrue())
+		Expect(cmd.RunE(cmd, nil)).To(MatchError(noAvailablePluginError{"resource edition"}))
+	})
+})
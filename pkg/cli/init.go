@@ -54,7 +54,7 @@ For further help about a specific plugin, set --plugins.
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.Init.
 	subcommands := c.filterSubcommands(
 		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.Init)
+			_, isValid := plugin.AsInit(p)
 			return isValid
 		},
 		func(p plugin.Plugin) plugin.Subcommand {
@@ -62,8 +62,9 @@ For further help about a specific plugin, set --plugins.
 		},
 	)
 
-	// Verify that there is at least one remaining plugin.
+	// Verify that there is at least one remaining plugin, hiding the subcommand otherwise.
 	if len(subcommands) == 0 {
+		cmd.Hidden = true
 		cmdErr(cmd, noAvailablePluginError{"project initialization"})
 		return cmd
 	}
@@ -72,7 +73,7 @@ For further help about a specific plugin, set --plugins.
 
 	// Append plugin table after metadata updates
 	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.Init)
+		_, isValid := plugin.AsInit(p)
 		return isValid
 	}, "Available plugins that support 'init'")
 
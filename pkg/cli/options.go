@@ -34,6 +34,7 @@ import (
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	externalapi "sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 )
 
@@ -58,6 +59,15 @@ func WithVersion(version string) Option {
 	}
 }
 
+// WithVersionInfo is an Option that defines the structured version information of the CLI, which is
+// written by the version command with --output json instead of the version string.
+func WithVersionInfo(info any) Option {
+	return func(c *CLI) error {
+		c.versionInfo = info
+		return nil
+	}
+}
+
 // WithCliVersion is an Option that defines only the version string of the CLI (no extra info).
 func WithCliVersion(version string) Option {
 	return func(c *CLI) error {
@@ -178,11 +188,19 @@ func isPluginsFlag(arg string) bool {
 	return arg == pluginsFlagArg || strings.HasPrefix(arg, pluginsFlagArg+"=")
 }
 
+func isDryRunFlag(arg string) bool {
+	return arg == dryRunFlagArg || strings.HasPrefix(arg, dryRunFlagArg+"=")
+}
+
 // parseExternalPluginArgs returns the program arguments.
 func parseExternalPluginArgs() (args []string) {
 	// Loop through os.Args and only get flags and their values that should be passed to the plugins
-	// this also removes the --plugins flag and its values from the list passed to the external plugin
+	// this also removes the --plugins flag and its values, and the --dry-run flag handled by the CLI itself,
+	// from the list passed to the external plugin
 	for i, arg := range os.Args {
+		if isDryRunFlag(arg) {
+			continue
+		}
 		if strings.HasPrefix(arg, "--") && !isPluginsFlag(arg) {
 			args = append(args, arg)
 
@@ -298,6 +316,10 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 			}
 
 			for _, pluginFile := range pluginFiles {
+				if pluginFile.Name() == externalapi.ManifestFileName {
+					continue
+				}
+
 				// find the executable that matches the same name as info.Name().
 				// if no match is found, compare the external plugin string name before dot
 				// and match it with info.Name() which is the external plugin root dir.
@@ -324,6 +346,10 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 						return nil, fmt.Errorf("error parsing external plugin version %q: %w", version.Name(), err)
 					}
 
+					if err = ep.LoadManifest(filesystem); err != nil {
+						return nil, fmt.Errorf("error loading external plugin %q: %w", ep.Name(), err)
+					}
+
 					slog.Debug("Adding external plugin", "plugin name", ep.Name())
 
 					ps = append(ps, ep)
@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 )
 
 const (
@@ -280,6 +281,25 @@ var _ = Describe("Discover external plugins", func() {
 			Expect(plugins[0].Version().Number).To(Equal(1))
 		})
 
+		It("should apply the manifest of the external plugin", func() {
+			err = filesystem.FS.Chmod(pluginFilePath, filePermissions)
+			Expect(err).To(Not(HaveOccurred()))
+
+			manifest := "description: Sample plugin\nsubcommands: [edit]\n"
+			err = afero.WriteFile(filesystem.FS, filepath.Join(filepath.Dir(pluginFilePath), "plugin.yaml"),
+				[]byte(manifest), 0o644)
+			Expect(err).To(Not(HaveOccurred()))
+
+			plugins, err = DiscoverExternalPlugins(filesystem.FS)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plugins).To(HaveLen(1))
+			Expect(plugins[0].(plugin.Describable).Description()).To(Equal("Sample plugin"))
+			_, providesEdit := plugin.AsEdit(plugins[0])
+			Expect(providesEdit).To(BeTrue())
+			_, providesInit := plugin.AsInit(plugins[0])
+			Expect(providesInit).To(BeFalse())
+		})
+
 		It("should discover multiple external plugins and return the plugins without any errors", func() {
 			// set the execute permissions on the first plugin executable
 			err = filesystem.FS.Chmod(pluginFilePath, filePermissions)
@@ -623,6 +643,20 @@ var _ = Describe("CLI options", func() {
 			Expect(c.plugins).To(Equal(map[string]plugin.Plugin{plugin.KeyFor(p): p}))
 		})
 
+		When("providing an external plugin that requires a newer CLI version", func() {
+			It("should not make it available", func() {
+				ep := external.Plugin{
+					PName:                     "external.example.com",
+					PVersion:                  plugin.Version{Number: 1},
+					PSupportedProjectVersions: []config.Version{projectVersion},
+					MinCLIVersion:             "v4.6.0",
+				}
+				c, err = newCLI(WithCliVersion("4.5.0"), WithPlugins(p, ep))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.plugins).To(Equal(map[string]plugin.Plugin{plugin.KeyFor(p): p}))
+			})
+		})
+
 		When("providing plugins with same keys", func() {
 			It("should return an error", func() {
 				_, err = newCLI(WithPlugins(p, p))
@@ -0,0 +1,449 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	log "log/slog"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+)
+
+const (
+	outputFlag = "output"
+	outputText = "text"
+	outputJSON = "json"
+
+	outputFlagDescription = "Output format, one of: text, json. With json, the result of the command is written " +
+		"to the standard output as a JSON document, and the logs to the standard error"
+)
+
+// Error codes reported in the JSON output. They are part of the output format, so they must not change.
+const (
+	errorCodeCommandFailed         = "CommandFailed"
+	errorCodeInvalidFlags          = "InvalidFlags"
+	errorCodeNoResolvedPlugin      = "NoResolvedPlugin"
+	errorCodeNoAvailablePlugin     = "NoAvailablePlugin"
+	errorCodeUnsupportedVersion    = "UnsupportedProjectVersion"
+	errorCodeResourceNotFound      = "ResourceNotFound"
+	errorCodeTemplateValidation    = "TemplateValidationFailed"
+	errorCodeTemplateDefaults      = "TemplateDefaultsFailed"
+	errorCodeFileExistsCheck       = "FileExistsCheckFailed"
+	errorCodeFileOpen              = "FileOpenFailed"
+	errorCodeDirectoryCreate       = "DirectoryCreateFailed"
+	errorCodeFileCreate            = "FileCreateFailed"
+	errorCodeFileRead              = "FileReadFailed"
+	errorCodeFileWrite             = "FileWriteFailed"
+	errorCodeFileClose             = "FileCloseFailed"
+	errorCodeModelAlreadyExists    = "ModelAlreadyExists"
+	errorCodeUnknownIfExistsAction = "UnknownIfExistsAction"
+	errorCodeFileAlreadyExists     = "FileAlreadyExists"
+	errorCodeAnchorNotFound        = "AnchorNotFound"
+)
+
+// commandResult is the result of a command, written to the standard output with --output json.
+type commandResult struct {
+	// Command is the subcommand that was run, e.g. "create api".
+	Command string `json:"command"`
+	// Success is true if the command did not return an error.
+	Success bool `json:"success"`
+	// DryRun is true if the changes were not written because of --dry-run.
+	DryRun bool `json:"dryRun,omitempty"`
+	// Files are the files changed by the plugins, or that would be changed in a dry run.
+	// The files changed by the commands that plugins run, such as go mod tidy, are not listed.
+	Files *fileChanges `json:"files,omitempty"`
+	// Project describes the changes done to the PROJECT file.
+	Project *projectChanges `json:"project,omitempty"`
+	// Diff is the unified diff of the changes that would be done in a dry run.
+	Diff string `json:"diff,omitempty"`
+	// Warnings are the warnings logged while running the command.
+	Warnings []commandWarning `json:"warnings,omitempty"`
+	// NextSteps are the steps that users have to run once the command is done.
+	NextSteps []util.NextStep `json:"nextSteps,omitempty"`
+	// Data is the output of the commands that print information, such as "plugins list".
+	Data any `json:"data,omitempty"`
+	// Error is the error returned by the command, if any.
+	Error *commandError `json:"error,omitempty"`
+
+	mu sync.Mutex
+}
+
+// fileChanges lists the paths of the files changed by a command.
+type fileChanges struct {
+	Created  []string `json:"created"`
+	Modified []string `json:"modified"`
+	Removed  []string `json:"removed"`
+	// Skipped are the files that were not scaffolded because they already exist.
+	Skipped []string `json:"skipped"`
+}
+
+// projectChanges describes the changes done to the PROJECT file by a command.
+type projectChanges struct {
+	// Created is true if the PROJECT file was created.
+	Created bool `json:"created,omitempty"`
+	// Modified is true if the existing PROJECT file was modified.
+	Modified bool `json:"modified,omitempty"`
+
+	ResourcesAdded   []resource.GVK `json:"resourcesAdded,omitempty"`
+	ResourcesUpdated []resource.GVK `json:"resourcesUpdated,omitempty"`
+	ResourcesRemoved []resource.GVK `json:"resourcesRemoved,omitempty"`
+}
+
+// commandWarning is a warning logged while running a command.
+type commandWarning struct {
+	Message    string            `json:"message"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// commandError is the error returned by a command.
+type commandError struct {
+	// Code identifies the kind of error, see the errorCode* constants.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// RolledBack are the files that were restored to their previous state after the error.
+	RolledBack []string `json:"rolledBack,omitempty"`
+}
+
+// ReportedError is returned by Run when the error was already written in the JSON output of the
+// command, so that callers can exit with a failure without printing it again.
+type ReportedError struct {
+	err error
+}
+
+// Error implements error interface.
+func (e ReportedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap implements the errors.Unwrap interface.
+func (e ReportedError) Unwrap() error {
+	return e.err
+}
+
+type commandResultKey struct{}
+
+// withCommandResult returns a context that carries the result of the command being run.
+func withCommandResult(ctx context.Context, result *commandResult) context.Context {
+	return context.WithValue(ctx, commandResultKey{}, result)
+}
+
+// commandResultFrom returns the result carried by the context, which is nil unless --output json is set.
+func commandResultFrom(ctx context.Context) *commandResult {
+	if ctx == nil {
+		return nil
+	}
+	result, _ := ctx.Value(commandResultKey{}).(*commandResult)
+	return result
+}
+
+// writeResult reports data in the JSON output of the command, or writes it with writeText if there is none.
+func writeResult(cmd *cobra.Command, data any, writeText func(io.Writer) error) error {
+	if result := commandResultFrom(cmd.Context()); result != nil {
+		result.Data = data
+		return nil
+	}
+	return writeText(cmd.OutOrStdout())
+}
+
+// addWarning records a warning logged while running the command.
+func (r *commandResult) addWarning(warning commandWarning) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Warnings = append(r.Warnings, warning)
+}
+
+// addNextSteps records the steps reported by the plugins.
+func (r *commandResult) addNextSteps(steps ...util.NextStep) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.NextSteps = append(r.NextSteps, steps...)
+}
+
+// recordChanges records the files changed by the command and the changes done to the resources
+// tracked in the PROJECT file.
+func (r *commandResult) recordChanges(
+	changes []machinery.FileChange,
+	skipped []string,
+	before, after []resource.Resource,
+) {
+	r.Files = &fileChanges{
+		Created:  []string{},
+		Modified: []string{},
+		Removed:  []string{},
+		Skipped:  skipped,
+	}
+	if r.Files.Skipped == nil {
+		r.Files.Skipped = []string{}
+	}
+
+	r.Project = &projectChanges{}
+	for _, change := range changes {
+		switch {
+		case change.Created:
+			r.Files.Created = append(r.Files.Created, change.Path)
+		case change.Deleted:
+			r.Files.Removed = append(r.Files.Removed, change.Path)
+		default:
+			r.Files.Modified = append(r.Files.Modified, change.Path)
+		}
+
+		if change.Path == yamlstore.DefaultPath {
+			r.Project.Created = change.Created
+			r.Project.Modified = !change.Created
+		}
+	}
+
+	r.Project.ResourcesAdded, r.Project.ResourcesUpdated, r.Project.ResourcesRemoved = diffResources(before, after)
+}
+
+// complete records the subcommand that was run and the error it returned, if any.
+func (r *commandResult) complete(cmd *cobra.Command, err error) {
+	if cmd != nil {
+		r.Command = strings.Join(subcommandPath(cmd), " ")
+	}
+	r.Success = err == nil
+	if err == nil {
+		return
+	}
+
+	r.Error = &commandError{Code: errorCode(err), Message: err.Error()}
+	var rbErr rollbackError
+	if errors.As(err, &rbErr) {
+		r.Error.Message = rbErr.err.Error()
+		for _, file := range rbErr.restored {
+			r.Error.RolledBack = append(r.Error.RolledBack, file.Path)
+		}
+	}
+}
+
+// resourceSnapshot returns the resources tracked in the project configuration.
+func resourceSnapshot(cfg config.Config) []resource.Resource {
+	if cfg == nil {
+		return nil
+	}
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil
+	}
+	return resources
+}
+
+// diffResources returns the resources that were added, updated and removed between two snapshots.
+func diffResources(before, after []resource.Resource) (added, updated, removed []resource.GVK) {
+	find := func(resources []resource.Resource, gvk resource.GVK) (resource.Resource, bool) {
+		for _, res := range resources {
+			if res.IsEqualTo(gvk) {
+				return res, true
+			}
+		}
+		return resource.Resource{}, false
+	}
+
+	for _, res := range after {
+		previous, found := find(before, res.GVK)
+		switch {
+		case !found:
+			added = append(added, res.GVK)
+		case !reflect.DeepEqual(previous, res):
+			updated = append(updated, res.GVK)
+		}
+	}
+	for _, res := range before {
+		if _, found := find(after, res.GVK); !found {
+			removed = append(removed, res.GVK)
+		}
+	}
+	return added, updated, removed
+}
+
+// errorCode returns the code reported in the JSON output for err.
+func errorCode(err error) string {
+	switch {
+	case errors.As(err, &flagError{}):
+		return errorCodeInvalidFlags
+	case errors.As(err, &noResolvedPluginError{}):
+		return errorCodeNoResolvedPlugin
+	case errors.As(err, &noAvailablePluginError{}):
+		return errorCodeNoAvailablePlugin
+	case errors.As(err, &config.UnsupportedVersionError{}):
+		return errorCodeUnsupportedVersion
+	case errors.As(err, &config.ResourceNotFoundError{}):
+		return errorCodeResourceNotFound
+	case errors.As(err, &machinery.ValidateError{}):
+		return errorCodeTemplateValidation
+	case errors.As(err, &machinery.SetTemplateDefaultsError{}):
+		return errorCodeTemplateDefaults
+	case errors.As(err, &machinery.ExistsFileError{}):
+		return errorCodeFileExistsCheck
+	case errors.As(err, &machinery.OpenFileError{}):
+		return errorCodeFileOpen
+	case errors.As(err, &machinery.CreateDirectoryError{}):
+		return errorCodeDirectoryCreate
+	case errors.As(err, &machinery.CreateFileError{}):
+		return errorCodeFileCreate
+	case errors.As(err, &machinery.ReadFileError{}):
+		return errorCodeFileRead
+	case errors.As(err, &machinery.WriteFileError{}):
+		return errorCodeFileWrite
+	case errors.As(err, &machinery.CloseFileError{}):
+		return errorCodeFileClose
+	case errors.As(err, &machinery.ModelAlreadyExistsError{}):
+		return errorCodeModelAlreadyExists
+	case errors.As(err, &machinery.UnknownIfExistsActionError{}):
+		return errorCodeUnknownIfExistsAction
+	case errors.As(err, &machinery.FileAlreadyExistsError{}):
+		return errorCodeFileAlreadyExists
+	case errors.As(err, &machinery.AnchorNotFoundError{}):
+		return errorCodeAnchorNotFound
+	default:
+		return errorCodeCommandFailed
+	}
+}
+
+// outputFormat returns the output format requested in the command-line arguments. The arguments are
+// read before Cobra parses them, so that everything the command prints can be redirected.
+func (c CLI) outputFormat() string {
+	fs := pflag.NewFlagSet("output", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	format := fs.StringP(outputFlag, "o", outputText, outputFlagDescription)
+	fs.BoolP("help", "h", false, "")
+	fs.ParseErrorsAllowlist = pflag.ParseErrorsAllowlist{UnknownFlags: true}
+	if err := fs.Parse(c.args); err != nil {
+		return outputText
+	}
+	return *format
+}
+
+// validateOutputFormat returns an error if the output format set in the flags is not supported.
+func validateOutputFormat(flags *pflag.FlagSet) error {
+	format, err := flags.GetString(outputFlag)
+	if err != nil || format == outputText || format == outputJSON {
+		return nil
+	}
+	return flagError{fmt.Errorf("invalid output format %q, it must be one of: %s, %s", format, outputText, outputJSON)}
+}
+
+// runWithJSONOutput executes the command and writes its result to the standard output as a JSON document.
+// Everything else, such as the logs and the output of the commands run by the plugins, is written to the
+// standard error instead.
+func (c CLI) runWithJSONOutput() error {
+	result := &commandResult{}
+	out := c.cmd.OutOrStdout()
+
+	stdout, logger := os.Stdout, log.Default()
+	os.Stdout = os.Stderr
+	c.cmd.SetOut(c.cmd.ErrOrStderr())
+	log.SetDefault(log.New(&warningRecorder{
+		Handler: log.NewTextHandler(os.Stderr, nil),
+		result:  result,
+	}))
+	restoreNextSteps := util.HandleNextSteps(result.addNextSteps)
+	defer func() {
+		os.Stdout = stdout
+		c.cmd.SetOut(out)
+		log.SetDefault(logger)
+		restoreNextSteps()
+	}()
+
+	// The error is reported in the result, so Cobra must not print it nor the usage
+	c.cmd.SilenceErrors = true
+	c.cmd.SilenceUsage = true
+
+	cmd, err := c.cmd.ExecuteContextC(withCommandResult(context.Background(), result))
+	if errors.Is(err, errHelpDisplayed) {
+		err = nil
+	}
+	result.complete(cmd, err)
+
+	if writeErr := writeJSON(out, result); writeErr != nil {
+		return writeErr
+	}
+	if err != nil {
+		return ReportedError{err: fmt.Errorf("error executing command: %w", err)}
+	}
+	return nil
+}
+
+// writeJSON writes the value as indented JSON.
+func writeJSON(out io.Writer, value any) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(value); err != nil {
+		return fmt.Errorf("failed to write JSON output: %w", err)
+	}
+	return nil
+}
+
+// warningRecorder is a log handler that records the warnings in the result of the command.
+type warningRecorder struct {
+	log.Handler
+
+	result *commandResult
+	attrs  []log.Attr
+}
+
+// Handle implements slog.Handler.
+func (h *warningRecorder) Handle(ctx context.Context, r log.Record) error {
+	if r.Level >= log.LevelWarn {
+		warning := commandWarning{Message: r.Message}
+		addAttr := func(attr log.Attr) bool {
+			if warning.Attributes == nil {
+				warning.Attributes = make(map[string]string)
+			}
+			warning.Attributes[attr.Key] = attr.Value.String()
+			return true
+		}
+		for _, attr := range h.attrs {
+			addAttr(attr)
+		}
+		r.Attrs(addAttr)
+		h.result.addWarning(warning)
+	}
+	return h.Handler.Handle(ctx, r) //nolint:wrapcheck
+}
+
+// WithAttrs implements slog.Handler.
+func (h *warningRecorder) WithAttrs(attrs []log.Attr) log.Handler {
+	return &warningRecorder{
+		Handler: h.Handler.WithAttrs(attrs),
+		result:  h.result,
+		attrs:   append(slices.Clone(h.attrs), attrs...),
+	}
+}
+
+// WithGroup implements slog.Handler.
+func (h *warningRecorder) WithGroup(name string) log.Handler {
+	return &warningRecorder{Handler: h.Handler.WithGroup(name), result: h.result, attrs: h.attrs}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+)
+
+// mockReportingSubcommand scaffolds a file, logs a warning and reports a next step.
+type mockReportingSubcommand struct {
+	err error
+}
+
+func (m *mockReportingSubcommand) Scaffold(fs machinery.Filesystem) error {
+	log.Warn("the file was customized", "file", "scaffolded.txt")
+	util.PrintNextSteps(util.NextStep{Description: "regenerate the manifests", Command: "make manifests"})
+	if err := afero.WriteFile(fs.FS, "scaffolded.txt", []byte("content\n"), machinery.DefaultFilePermission); err != nil {
+		return err
+	}
+	return m.err
+}
+
+var _ = Describe("JSON output", func() {
+	var (
+		fs         machinery.Filesystem
+		subcommand *mockReportingSubcommand
+		out        *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		subcommand = &mockReportingSubcommand{}
+		out = &bytes.Buffer{}
+
+		// The logs are written to the standard error
+		devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		Expect(err).NotTo(HaveOccurred())
+		stderr := os.Stderr
+		os.Stderr = devNull
+		DeferCleanup(func() {
+			os.Stderr = stderr
+			_ = devNull.Close()
+		})
+	})
+
+	run := func(args ...string) (*commandResult, error) {
+		GinkgoHelper()
+
+		originalArgs := os.Args
+		DeferCleanup(func() { os.Args = originalArgs })
+		os.Args = append([]string{kubebuilderCommandName}, args...)
+
+		initPlugin := &mockInitPlugin{
+			mockPluginWithSubcommand: *newMockPluginWithSubcommand("init.test.io", []config.Version{{Number: 3}}, subcommand),
+		}
+		c, err := New(
+			WithPlugins(initPlugin),
+			WithDefaultPlugins(config.Version{Number: 3}, initPlugin),
+			WithDefaultProjectVersion(config.Version{Number: 3}),
+			WithVersion("version string"),
+			WithFilesystem(fs),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		c.cmd.SetOut(out)
+		c.cmd.SetArgs(args)
+
+		err = c.Run()
+
+		result := &commandResult{}
+		Expect(json.Unmarshal(out.Bytes(), result)).To(Succeed())
+		return result, err
+	}
+
+	It("should report the changed files, the warnings and the next steps", func() {
+		result, err := run(kubebuilderSubcommandInit, "--output", "json")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.Command).To(Equal(kubebuilderSubcommandInit))
+		Expect(result.Success).To(BeTrue())
+		Expect(result.Error).To(BeNil())
+		Expect(result.Files).To(Equal(&fileChanges{
+			Created:  []string{yamlstore.DefaultPath, "scaffolded.txt"},
+			Modified: []string{},
+			Removed:  []string{},
+			Skipped:  []string{},
+		}))
+		Expect(result.Project).To(Equal(&projectChanges{Created: true}))
+		Expect(result.Warnings).To(Equal([]commandWarning{{
+			Message:    "the file was customized",
+			Attributes: map[string]string{"file": "scaffolded.txt"},
+		}}))
+		Expect(result.NextSteps).To(Equal([]util.NextStep{
+			{Description: "regenerate the manifests", Command: "make manifests"},
+		}))
+	})
+
+	It("should report the changes and the diff in a dry run", func() {
+		result, err := run(kubebuilderSubcommandInit, "-o", "json", dryRunFlagArg)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.DryRun).To(BeTrue())
+		Expect(result.Files.Created).To(Equal([]string{yamlstore.DefaultPath, "scaffolded.txt"}))
+		Expect(result.Diff).To(ContainSubstring("+++ b/scaffolded.txt"))
+
+		exists, err := afero.Exists(fs.FS, "scaffolded.txt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should report the error with its code and the files that were rolled back", func() {
+		subcommand.err = machinery.FileAlreadyExistsError{}
+
+		result, err := run(kubebuilderSubcommandInit, "--output=json")
+		Expect(err).To(HaveOccurred())
+		Expect(errors.As(err, &ReportedError{})).To(BeTrue())
+
+		Expect(result.Success).To(BeFalse())
+		Expect(result.Files).To(BeNil())
+		Expect(result.Error).NotTo(BeNil())
+		Expect(result.Error.Code).To(Equal(errorCodeFileAlreadyExists))
+		Expect(result.Error.RolledBack).To(ContainElement("scaffolded.txt"))
+		Expect(result.NextSteps).To(HaveLen(1))
+	})
+
+	It("should report invalid flags", func() {
+		result, err := run(kubebuilderSubcommandInit, "--output", "json", "--unknown")
+		Expect(err).To(HaveOccurred())
+		Expect(result.Error.Code).To(Equal(errorCodeInvalidFlags))
+	})
+
+	It("should reject unknown output formats", func() {
+		originalArgs := os.Args
+		DeferCleanup(func() { os.Args = originalArgs })
+		os.Args = []string{kubebuilderCommandName, kubebuilderSubcommandVersion, "--output", "yaml"}
+
+		c, err := New(WithVersion("version string"), WithFilesystem(fs))
+		Expect(err).NotTo(HaveOccurred())
+		c.cmd.SetOut(out)
+		c.cmd.SetErr(out)
+		c.cmd.SetArgs(os.Args[1:])
+		Expect(c.Run()).To(MatchError(ContainSubstring(`invalid output format "yaml"`)))
+	})
+
+	It("should report the output of the commands that print information", func() {
+		result, err := run(kubebuilderSubcommandVersion, "-o", "json")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Command).To(Equal(kubebuilderSubcommandVersion))
+		Expect(result.Data).To(Equal("version string"))
+	})
+})
+
+var _ = Describe("errorCode", func() {
+	DescribeTable("should identify the kind of error through the wrapping",
+		func(err error, code string) {
+			Expect(errorCode(fmt.Errorf("failed to scaffold: %w", err))).To(Equal(code))
+		},
+		Entry("for flags", flagError{errors.New("unknown flag")}, errorCodeInvalidFlags),
+		Entry("for missing plugins", noResolvedPluginError{}, errorCodeNoResolvedPlugin),
+		Entry("for missing resources", config.ResourceNotFoundError{}, errorCodeResourceNotFound),
+		Entry("for files that failed to be written", machinery.WriteFileError{}, errorCodeFileWrite),
+		Entry("for existing files", machinery.FileAlreadyExistsError{}, errorCodeFileAlreadyExists),
+		Entry("for missing anchors", machinery.AnchorNotFoundError{}, errorCodeAnchorNotFound),
+		Entry("for rolled back errors", rollbackError{err: machinery.ReadFileError{}}, errorCodeFileRead),
+		Entry("for any other error", errors.New("failure"), errorCodeCommandFailed),
+	)
+})
+
+var _ = Describe("diffResources", func() {
+	It("should list the added, updated and removed resources", func() {
+		kept := resource.Resource{GVK: resource.GVK{Group: "crew", Version: "v1", Kind: "Captain"}}
+		updated := resource.Resource{GVK: resource.GVK{Group: "crew", Version: "v1", Kind: "FirstMate"}}
+		removed := resource.Resource{GVK: resource.GVK{Group: "crew", Version: "v1", Kind: "Admiral"}}
+		added := resource.Resource{GVK: resource.GVK{Group: "ship", Version: "v1", Kind: "Frigate"}}
+
+		withController := updated.Copy()
+		withController.Controller = true
+
+		a, u, r := diffResources(
+			[]resource.Resource{kept, updated, removed},
+			[]resource.Resource{kept, withController, added},
+		)
+		Expect(a).To(Equal([]resource.GVK{added.GVK}))
+		Expect(u).To(Equal([]resource.GVK{updated.GVK}))
+		Expect(r).To(Equal([]resource.GVK{removed.GVK}))
+	})
+})
+
+var _ = Describe("outputFormat", func() {
+	DescribeTable("should read the format before the command line is parsed",
+		func(format string, args ...string) {
+			Expect(CLI{args: args}.outputFormat()).To(Equal(format))
+		},
+		Entry("by default", outputText, kubebuilderSubcommandInit),
+		Entry("with the flag", outputJSON, kubebuilderSubcommandInit, "--output", "json"),
+		Entry("with the shorthand", outputJSON, "create", "api", "--kind", "Captain", "-o", "json"),
+		Entry("with the value after an equal sign", outputJSON, "edit", "--output=json"),
+		Entry("with help", outputJSON, kubebuilderSubcommandInit, "--help", "-o", "json"),
+	)
+})
+
+var _ = Describe("warningRecorder", func() {
+	It("should record the warnings with their attributes", func() {
+		result := &commandResult{}
+		logger := log.New(&warningRecorder{Handler: log.NewTextHandler(&bytes.Buffer{}, nil), result: result})
+
+		logger.Info("scaffolding")
+		logger.With("plugin", plugin.KeyFor(newMockPlugin("test.io", "v1"))).Warn("skipping", "file", "a.go")
+
+		Expect(result.Warnings).To(Equal([]commandWarning{{
+			Message:    "skipping",
+			Attributes: map[string]string{"plugin": "test.io/v1", "file": "a.go"},
+		}}))
+	})
+})
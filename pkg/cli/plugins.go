@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
+)
+
+// pluginSummary describes a plugin in the output of the plugins commands.
+type pluginSummary struct {
+	Key             string   `json:"key"`
+	Description     string   `json:"description,omitempty"`
+	Deprecation     string   `json:"deprecationWarning,omitempty"`
+	ProjectVersions []string `json:"projectVersions"`
+	Subcommands     []string `json:"subcommands"`
+	External        bool     `json:"external,omitempty"`
+	Path            string   `json:"path,omitempty"`
+	// BundledPlugins are the keys of the plugins grouped by a bundle.
+	BundledPlugins []string `json:"bundledPlugins,omitempty"`
+}
+
+// pluginDetails describes a plugin and the flags of its subcommands in the output of `plugins describe`.
+type pluginDetails struct {
+	pluginSummary `json:",inline"`
+
+	Flags map[string][]flagSummary `json:"flags,omitempty"`
+}
+
+// flagSummary describes a flag bound by a plugin subcommand.
+type flagSummary struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Default string `json:"default,omitempty"`
+	Usage   string `json:"usage,omitempty"`
+}
+
+func (c CLI) newPluginsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   kubebuilderSubcommandPlugins,
+		Short: "List, inspect and validate plugins",
+		Long: `List, inspect and validate the plugins available to this CLI,
+including the external plugins found in the plugins directory.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(c.newPluginsListCmd(), c.newPluginsDescribeCmd(), c.newPluginsValidateCmd())
+
+	return cmd
+}
+
+func (c CLI) newPluginsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List the available plugins",
+		Example: fmt.Sprintf("  %[1]s plugins list\n  %[1]s plugins list --output json", c.commandName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			summaries := make([]pluginSummary, 0, len(c.plugins))
+			for _, key := range c.sortedPluginKeys() {
+				summaries = append(summaries, summarizePlugin(c.plugins[key]))
+			}
+
+			return writeResult(cmd, summaries, func(out io.Writer) error {
+				return writePluginSummaries(out, summaries)
+			})
+		},
+	}
+}
+
+func (c CLI) newPluginsDescribeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "describe <plugin key>",
+		Short: "Describe a plugin and the flags of its subcommands",
+		Example: fmt.Sprintf("  %[1]s plugins describe go.kubebuilder.io/v4\n"+
+			"  %[1]s plugins describe helm.kubebuilder.io/v2-alpha --output json", c.commandName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := c.findPlugin(args[0])
+			if err != nil {
+				return err
+			}
+			details := describePlugin(p)
+
+			return writeResult(cmd, details, func(out io.Writer) error {
+				return writePluginDetails(out, details)
+			})
+		},
+	}
+}
+
+func (c CLI) newPluginsValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate <path>",
+		Short: "Check that an external plugin executable follows the external plugin protocol",
+		Long: `Check that an external plugin executable follows the external plugin protocol.
+
+The plugin manifest, if any, is validated, and the plugin is executed with the requests sent to
+obtain the flags and metadata of each subcommand it implements. The responses are checked
+against the protocol. The command fails if any problem is found.`,
+		Example: fmt.Sprintf("  %s plugins validate ~/.config/kubebuilder/plugins/myplugin/v1/myplugin", c.commandName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			problems, err := external.CheckConformance(c.fs.FS, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to validate plugin: %w", err)
+			}
+
+			if problems == nil {
+				problems = []external.ConformanceProblem{}
+			}
+			if err = writeResult(cmd, problems, func(out io.Writer) error {
+				writeConformanceProblems(out, args[0], problems)
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			if len(problems) != 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("plugin %q does not conform to the external plugin protocol: %d problem(s) found",
+					args[0], len(problems))
+			}
+			return nil
+		},
+	}
+}
+
+// sortedPluginKeys returns the keys of the available plugins, sorted.
+func (c CLI) sortedPluginKeys() []string {
+	keys := make([]string, 0, len(c.plugins))
+	for key := range c.plugins {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// findPlugin returns the available plugin with the provided key. Keys that are not fully qualified
+// are accepted as long as they match a single plugin.
+func (c CLI) findPlugin(key string) (plugin.Plugin, error) {
+	if p, exists := c.plugins[key]; exists {
+		return p, nil
+	}
+
+	plugins := make([]plugin.Plugin, 0, len(c.plugins))
+	for _, k := range c.sortedPluginKeys() {
+		plugins = append(plugins, c.plugins[k])
+	}
+	plugins, err := plugin.FilterPluginsByKey(plugins, key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin key %q: %w", key, err)
+	}
+
+	switch len(plugins) {
+	case 1:
+		return plugins[0], nil
+	case 0:
+		return nil, fmt.Errorf("no plugin could be found with key %q", key)
+	default:
+		keys := make([]string, 0, len(plugins))
+		for _, p := range plugins {
+			keys = append(keys, plugin.KeyFor(p))
+		}
+		return nil, fmt.Errorf("ambiguous plugin %q, matching plugins: %s", key, strings.Join(keys, ", "))
+	}
+}
+
+// summarizePlugin returns the summary of a plugin.
+func summarizePlugin(p plugin.Plugin) pluginSummary {
+	s := pluginSummary{
+		Key:         plugin.KeyFor(p),
+		Subcommands: providedSubcommands(p),
+	}
+
+	if describable, ok := p.(plugin.Describable); ok && describable.Description() != "" {
+		s.Description = describable.Description()
+	} else {
+		s.Description = getPluginDescription(s.Key)
+	}
+	if deprecated, ok := p.(plugin.Deprecated); ok {
+		s.Deprecation = deprecated.DeprecationWarning()
+	}
+	for _, version := range p.SupportedProjectVersions() {
+		s.ProjectVersions = append(s.ProjectVersions, version.String())
+	}
+	if ep, isExternal := p.(external.Plugin); isExternal {
+		s.External = true
+		s.Path = ep.Path
+	}
+	if bundle, isBundle := p.(plugin.Bundle); isBundle {
+		for _, bundled := range bundle.Plugins() {
+			s.BundledPlugins = append(s.BundledPlugins, plugin.KeyFor(bundled))
+		}
+	}
+
+	return s
+}
+
+// providedSubcommands returns the names of the subcommands provided by a plugin,
+// or by any of the bundled plugins for bundles.
+func providedSubcommands(p plugin.Plugin) []string {
+	subcommands := make([]string, 0, len(subcommandOrder))
+	for _, tuple := range pluginSubcommands(p) {
+		if !slices.Contains(subcommands, tuple.name) {
+			subcommands = append(subcommands, tuple.name)
+		}
+	}
+	slices.SortFunc(subcommands, func(a, b string) int {
+		return slices.Index(subcommandOrder, a) - slices.Index(subcommandOrder, b)
+	})
+	return subcommands
+}
+
+// subcommandOrder is the order in which subcommands are displayed.
+var subcommandOrder = []string{
+	plugin.InitSubcommandName,
+	plugin.CreateAPISubcommandName,
+	plugin.CreateWebhookSubcommandName,
+	plugin.EditSubcommandName,
+	plugin.EditResourceSubcommandName,
+	plugin.DeleteAPISubcommandName,
+	plugin.DeleteWebhookSubcommandName,
+}
+
+// namedSubcommand is a subcommand provided by a plugin together with its name.
+type namedSubcommand struct {
+	name       string
+	subcommand plugin.Subcommand
+}
+
+// pluginSubcommands returns the subcommands provided by a plugin, or by the bundled plugins for bundles.
+func pluginSubcommands(p plugin.Plugin) []namedSubcommand {
+	if bundle, isBundle := p.(plugin.Bundle); isBundle {
+		var subcommands []namedSubcommand
+		for _, bundled := range bundle.Plugins() {
+			subcommands = append(subcommands, pluginSubcommands(bundled)...)
+		}
+		return subcommands
+	}
+
+	var subcommands []namedSubcommand
+	if init, ok := plugin.AsInit(p); ok {
+		subcommands = append(subcommands, namedSubcommand{plugin.InitSubcommandName, init.GetInitSubcommand()})
+	}
+	if api, ok := plugin.AsCreateAPI(p); ok {
+		subcommands = append(subcommands, namedSubcommand{plugin.CreateAPISubcommandName, api.GetCreateAPISubcommand()})
+	}
+	if webhook, ok := plugin.AsCreateWebhook(p); ok {
+		subcommands = append(subcommands,
+			namedSubcommand{plugin.CreateWebhookSubcommandName, webhook.GetCreateWebhookSubcommand()})
+	}
+	if edit, ok := plugin.AsEdit(p); ok {
+		subcommands = append(subcommands, namedSubcommand{plugin.EditSubcommandName, edit.GetEditSubcommand()})
+	}
+	if editResource, ok := plugin.AsEditResource(p); ok {
+		subcommands = append(subcommands,
+			namedSubcommand{plugin.EditResourceSubcommandName, editResource.GetEditResourceSubcommand()})
+	}
+	if deleteAPI, ok := plugin.AsDeleteAPI(p); ok {
+		subcommands = append(subcommands,
+			namedSubcommand{plugin.DeleteAPISubcommandName, deleteAPI.GetDeleteAPISubcommand()})
+	}
+	if deleteWebhook, ok := plugin.AsDeleteWebhook(p); ok {
+		subcommands = append(subcommands,
+			namedSubcommand{plugin.DeleteWebhookSubcommandName, deleteWebhook.GetDeleteWebhookSubcommand()})
+	}
+	return subcommands
+}
+
+// describePlugin returns the details of a plugin, binding the flags of its subcommands to list them.
+func describePlugin(p plugin.Plugin) pluginDetails {
+	details := pluginDetails{pluginSummary: summarizePlugin(p)}
+
+	for _, tuple := range pluginSubcommands(p) {
+		withFlags, hasFlags := tuple.subcommand.(plugin.HasFlags)
+		if !hasFlags {
+			continue
+		}
+
+		fs := pflag.NewFlagSet(tuple.name, pflag.ContinueOnError)
+		withFlags.BindFlags(fs)
+		fs.VisitAll(func(flag *pflag.Flag) {
+			if flag.Hidden {
+				return
+			}
+			if slices.ContainsFunc(details.Flags[tuple.name], func(f flagSummary) bool { return f.Name == flag.Name }) {
+				return
+			}
+			if details.Flags == nil {
+				details.Flags = make(map[string][]flagSummary)
+			}
+			details.Flags[tuple.name] = append(details.Flags[tuple.name], flagSummary{
+				Name:    flag.Name,
+				Type:    flag.Value.Type(),
+				Default: flag.DefValue,
+				Usage:   flag.Usage,
+			})
+		})
+	}
+
+	return details
+}
+
+// writePluginSummaries writes the summaries of the plugins as a table.
+func writePluginSummaries(out io.Writer, summaries []pluginSummary) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KEY\tPROJECT VERSIONS\tSUBCOMMANDS\tDESCRIPTION")
+	for _, s := range summaries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Key, strings.Join(s.ProjectVersions, ", "),
+			strings.Join(s.Subcommands, ", "), s.Description)
+	}
+	return w.Flush() //nolint:wrapcheck
+}
+
+// writePluginDetails writes the details of a plugin in a human-readable format.
+func writePluginDetails(out io.Writer, details pluginDetails) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Key:\t%s\n", details.Key)
+	_, _ = fmt.Fprintf(w, "Description:\t%s\n", details.Description)
+	if details.Deprecation != "" {
+		_, _ = fmt.Fprintf(w, "Deprecated:\t%s\n", details.Deprecation)
+	}
+	_, _ = fmt.Fprintf(w, "Project versions:\t%s\n", strings.Join(details.ProjectVersions, ", "))
+	if details.External {
+		_, _ = fmt.Fprintf(w, "Path:\t%s\n", details.Path)
+	}
+	if len(details.BundledPlugins) != 0 {
+		_, _ = fmt.Fprintf(w, "Bundled plugins:\t%s\n", strings.Join(details.BundledPlugins, ", "))
+	}
+	_, _ = fmt.Fprintf(w, "Subcommands:\t%s\n", strings.Join(details.Subcommands, ", "))
+
+	for _, subcommand := range details.Subcommands {
+		flags := details.Flags[subcommand]
+		if len(flags) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "\nFlags for %q:\n", subcommand)
+		for _, flag := range flags {
+			defaultValue := ""
+			if flag.Default != "" {
+				defaultValue = fmt.Sprintf(" (default %s)", flag.Default)
+			}
+			_, _ = fmt.Fprintf(w, "  --%s %s\t%s%s\n", flag.Name, flag.Type, flag.Usage, defaultValue)
+		}
+	}
+
+	return w.Flush() //nolint:wrapcheck
+}
+
+// writeConformanceProblems writes the problems found when validating an external plugin.
+func writeConformanceProblems(out io.Writer, path string, problems []external.ConformanceProblem) {
+	if len(problems) == 0 {
+		_, _ = fmt.Fprintf(out, "Plugin %q conforms to the external plugin protocol\n", path)
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "Plugin %q does not conform to the external plugin protocol:\n", path)
+	for _, problem := range problems {
+		if problem.Subcommand != "" {
+			_, _ = fmt.Fprintf(out, "  - %s: %s\n", problem.Subcommand, problem.Message)
+		} else {
+			_, _ = fmt.Fprintf(out, "  - %s\n", problem.Message)
+		}
+	}
+}
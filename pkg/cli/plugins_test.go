@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
+)
+
+// mockInitPlugin is a describable plugin that provides the init subcommand.
+type mockInitPlugin struct {
+	mockPluginWithSubcommand
+}
+
+func (m *mockInitPlugin) GetInitSubcommand() plugin.InitSubcommand {
+	return m.subcommand
+}
+
+func (m *mockInitPlugin) Description() string {
+	return "Scaffolds the test project"
+}
+
+var _ = Describe("Plugins", func() {
+	var (
+		c   *CLI
+		out *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		initPlugin := &mockInitPlugin{
+			mockPluginWithSubcommand: *newMockPluginWithSubcommand(
+				"init.test.io", []config.Version{{Number: 3}}, &mockSubcommandWithForceFlag{}),
+		}
+		bundle := newMockPluginBundle("bundle.test.io", []config.Version{{Number: 3}}, []plugin.Plugin{initPlugin})
+		externalPlugin := external.Plugin{
+			PName:                     "sample",
+			PVersion:                  plugin.Version{Number: 1},
+			PSupportedProjectVersions: []config.Version{{Number: 3}},
+			PSubcommands:              []string{plugin.EditSubcommandName},
+			Path:                      "plugins/sample/v1/sample",
+		}
+
+		c = &CLI{
+			commandName: "kubebuilder",
+			fs:          machinery.Filesystem{FS: afero.NewMemMapFs()},
+			plugins: map[string]plugin.Plugin{
+				plugin.KeyFor(initPlugin):     initPlugin,
+				plugin.KeyFor(bundle):         bundle,
+				plugin.KeyFor(externalPlugin): externalPlugin,
+			},
+		}
+		out = &bytes.Buffer{}
+	})
+
+	// run executes the plugins command with the provided arguments through the root command,
+	// which holds the --output flag, and decodes the JSON output in data.
+	run := func(data any, args ...string) error {
+		c.cmd = c.newRootCmd()
+		c.cmd.AddCommand(c.newPluginsCmd())
+		c.cmd.SetOut(out)
+		c.cmd.SetErr(&bytes.Buffer{})
+		c.args = append([]string{kubebuilderSubcommandPlugins}, args...)
+		c.cmd.SetArgs(c.args)
+
+		err := c.Run()
+		if data != nil {
+			var result commandResult
+			result.Data = data
+			Expect(json.Unmarshal(out.Bytes(), &result)).To(Succeed())
+			Expect(result.Command).To(Equal("plugins " + args[0]))
+			Expect(result.Success).To(Equal(err == nil))
+		}
+		return err
+	}
+
+	Context("list", func() {
+		It("should list every plugin with the subcommands it provides", func() {
+			var summaries []pluginSummary
+			Expect(run(&summaries, "list", "--output", "json")).To(Succeed())
+			Expect(summaries).To(HaveLen(3))
+
+			Expect(summaries[0].Key).To(Equal("bundle.test.io/v1"))
+			Expect(summaries[0].Subcommands).To(Equal([]string{plugin.InitSubcommandName}))
+			Expect(summaries[0].BundledPlugins).To(Equal([]string{"init.test.io/v1"}))
+
+			Expect(summaries[1].Key).To(Equal("init.test.io/v1"))
+			Expect(summaries[1].Description).To(Equal("Scaffolds the test project"))
+			Expect(summaries[1].ProjectVersions).To(Equal([]string{"3"}))
+
+			Expect(summaries[2].Key).To(Equal("sample/v1"))
+			Expect(summaries[2].External).To(BeTrue())
+			Expect(summaries[2].Path).To(Equal("plugins/sample/v1/sample"))
+			Expect(summaries[2].Subcommands).To(Equal([]string{plugin.EditSubcommandName}))
+		})
+
+		It("should print a table by default", func() {
+			cmd := c.newPluginsListCmd()
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{})
+			Expect(cmd.Execute()).To(Succeed())
+
+			Expect(out.String()).To(ContainSubstring("KEY"))
+			Expect(out.String()).To(MatchRegexp(`init\.test\.io/v1\s+3\s+init\s+Scaffolds the test project`))
+		})
+	})
+
+	Context("describe", func() {
+		It("should list the flags of each subcommand", func() {
+			var details pluginDetails
+			Expect(run(&details, "describe", "init.test.io", "-o", "json")).To(Succeed())
+			Expect(details.Key).To(Equal("init.test.io/v1"))
+			Expect(details.Flags).To(HaveKeyWithValue(plugin.InitSubcommandName, []flagSummary{
+				{Name: "force", Type: "bool", Default: "false", Usage: "force usage"},
+			}))
+		})
+
+		It("should fail for unknown and ambiguous keys", func() {
+			_, err := c.findPlugin("missing.test.io")
+			Expect(err).To(MatchError(ContainSubstring("no plugin could be found")))
+
+			_, err = c.findPlugin("bundle.test.io")
+			Expect(err).NotTo(HaveOccurred())
+
+			c.plugins["bundle.test.io.extra/v1"] = newMockPlugin("bundle.test.io.extra", "v1")
+			_, err = c.findPlugin("bundle")
+			Expect(err).To(MatchError(ContainSubstring("ambiguous plugin")))
+		})
+	})
+
+	Context("validate", func() {
+		It("should fail if the plugin does not exist", func() {
+			cmd := c.newPluginsValidateCmd()
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs([]string{"plugins/missing/v1/missing"})
+			Expect(cmd.Execute()).NotTo(Succeed())
+		})
+
+		It("should report the problems found in the plugin", func() {
+			Expect(afero.WriteFile(c.fs.FS, "plugins/sample/v1/sample", []byte("#!/bin/sh"), 0o644)).To(Succeed())
+
+			cmd := c.newPluginsValidateCmd()
+			cmd.SetOut(out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"plugins/sample/v1/sample"})
+			Expect(cmd.Execute()).To(MatchError(ContainSubstring("1 problem(s) found")))
+			Expect(out.String()).To(ContainSubstring("the plugin is not an executable file"))
+		})
+
+		It("should report the problems and the error in the JSON output", func() {
+			Expect(afero.WriteFile(c.fs.FS, "plugins/sample/v1/sample", []byte("#!/bin/sh"), 0o644)).To(Succeed())
+
+			var problems []external.ConformanceProblem
+			err := run(&problems, "validate", "plugins/sample/v1/sample", "--output=json")
+			Expect(err).To(MatchError(ContainSubstring("1 problem(s) found")))
+			Expect(errors.As(err, &ReportedError{})).To(BeTrue())
+			Expect(problems).To(HaveLen(1))
+			Expect(out.String()).To(ContainSubstring(`"code": "CommandFailed"`))
+		})
+	})
+})
@@ -140,6 +140,9 @@ func (c *CLI) newRootCmd() *cobra.Command {
 			if isCompletionRequest(cmd) {
 				return nil
 			}
+			if err := validateOutputFormat(cmd.Flags()); err != nil {
+				return err
+			}
 			// A malformed command line is reported for normal commands. Completion requests are
 			// intentionally allowed to inspect partial input.
 			if c.flagErr != nil {
@@ -163,6 +166,13 @@ func (c *CLI) newRootCmd() *cobra.Command {
 
 	// Global flags for all subcommands.
 	cmd.PersistentFlags().StringSlice(pluginsFlag, nil, pluginsFlagDescription)
+	cmd.PersistentFlags().Bool(dryRunFlag, false, dryRunFlagDescription)
+	cmd.PersistentFlags().StringP(outputFlag, "o", outputText, outputFlagDescription)
+
+	// Flag parsing errors are told apart from the rest in the JSON output.
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return flagError{err}
+	})
 
 	// Register --project-version on the root command so that it shows up in help.
 	cmd.Flags().String(projectVersionFlag, c.defaultProjectVersion.String(), projectVersionFlagDescription)
@@ -210,9 +220,43 @@ func (c CLI) getPluginTable() string {
 }
 
 // getPluginTableFilteredForSubcommand returns a filtered list of plugins for subcommands,
-// excluding the default scaffold bundle and its component plugins.
+// excluding the default scaffold bundle and its component plugins unless they are the only
+// plugins supporting the subcommand, such as for "delete api" or "edit resource".
 func (c CLI) getPluginTableFilteredForSubcommand(filter func(plugin.Plugin) bool) string {
-	return c.getPluginTableFilteredWithOptions(filter, true)
+	excludeDefaultScaffold := false
+	for pluginKey, p := range c.plugins {
+		if !isDefaultScaffoldPlugin(pluginKey) && !isHiddenFromHelp(pluginKey, p) && matchesFilter(p, filter) {
+			excludeDefaultScaffold = true
+			break
+		}
+	}
+	return c.getPluginTableFilteredWithOptions(filter, excludeDefaultScaffold)
+}
+
+// isDefaultScaffoldPlugin returns true if the plugin is the default scaffold bundle or one of its components.
+func isDefaultScaffoldPlugin(pluginKey string) bool {
+	return pluginKey == pluginGoKubebuilderV4 || pluginKey == "kustomize.common.kubebuilder.io/v2"
+}
+
+// matchesFilter returns true if there is no filter or if the plugin, or one of the plugins of a bundle,
+// matches it.
+func matchesFilter(p plugin.Plugin, filter func(plugin.Plugin) bool) bool {
+	if filter == nil || filter(p) {
+		return true
+	}
+	if bundle, isBundle := p.(plugin.Bundle); isBundle {
+		return slices.ContainsFunc(bundle.Plugins(), filter)
+	}
+	return false
+}
+
+// isHiddenFromHelp returns true if the plugin is never listed in the help output, which is the case
+// of the deprecated plugins and of the base.go plugin, to avoid duplication with the go plugin.
+func isHiddenFromHelp(pluginKey string, p plugin.Plugin) bool {
+	if deprecated, ok := p.(plugin.Deprecated); ok && deprecated.DeprecationWarning() != "" {
+		return true
+	}
+	return strings.Contains(pluginKey, "base.go.kubebuilder.io")
 }
 
 // getPluginTableFiltered returns a formatted list of plugins filtered by a predicate.
@@ -234,36 +278,26 @@ func (c CLI) getPluginTableFilteredWithOptions(filter func(plugin.Plugin) bool,
 	plugins := make([]pluginInfo, 0, len(c.plugins))
 
 	for pluginKey, p := range c.plugins {
-		// Skip deprecated plugins in help output
-		if deprecated, ok := p.(plugin.Deprecated); ok {
-			if deprecated.DeprecationWarning() != "" {
-				continue
-			}
-		}
-
-		// Apply filter if provided
-		if filter != nil && !filter(p) {
+		// Skip deprecated plugins and the base.go plugin in help output
+		if isHiddenFromHelp(pluginKey, p) {
 			continue
 		}
 
-		// Skip base.go plugin to avoid duplication with go plugin
-		if strings.Contains(pluginKey, "base.go.kubebuilder.io") {
+		// Apply filter if provided
+		if !matchesFilter(p, filter) {
 			continue
 		}
 
 		// For subcommands, skip default scaffold and its component plugins
-		if excludeDefaultScaffold {
-			if pluginKey == pluginGoKubebuilderV4 ||
-				pluginKey == "kustomize.common.kubebuilder.io/v2" {
-				continue
-			}
+		if excludeDefaultScaffold && isDefaultScaffoldPlugin(pluginKey) {
+			continue
 		}
 
 		shortKey := getShortKey(pluginKey)
 
-		// Get description from plugin if it implements Describable, otherwise use fallback
+		// Get description from plugin if it implements Describable and provides one, otherwise use fallback
 		var desc string
-		if describable, ok := p.(plugin.Describable); ok {
+		if describable, ok := p.(plugin.Describable); ok && describable.Description() != "" {
 			desc = describable.Description()
 		} else {
 			desc = getPluginDescription(pluginKey)
@@ -18,6 +18,7 @@ package cli
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 )
@@ -28,9 +29,15 @@ func (c CLI) newVersionCmd() *cobra.Command {
 		Short:   fmt.Sprintf("Print the %s version", c.commandName),
 		Long:    fmt.Sprintf("Print the %s version", c.commandName),
 		Example: fmt.Sprintf("%s %s", c.commandName, kubebuilderSubcommandVersion),
-		RunE: func(_ *cobra.Command, _ []string) error {
-			fmt.Println(c.version)
-			return nil
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var data any = c.version
+			if c.versionInfo != nil {
+				data = c.versionInfo
+			}
+			return writeResult(cmd, data, func(out io.Writer) error {
+				_, err := fmt.Fprintln(out, c.version)
+				return err //nolint:wrapcheck
+			})
 		},
 	}
 	return cmd
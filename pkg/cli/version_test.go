@@ -17,8 +17,14 @@ limitations under the License.
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 )
 
 var _ = Describe("Version", func() {
@@ -42,4 +48,57 @@ var _ = Describe("Version", func() {
 			Expect(cmd.Example).To(ContainSubstring("version"))
 		})
 	})
+
+	Context("with --output json", func() {
+		type versionInfo struct {
+			KubeBuilderVersion string `json:"kubeBuilderVersion"`
+			GoOs               string `json:"goOs"`
+		}
+
+		var out *bytes.Buffer
+
+		// run executes the version command through the root command, which holds the --output flag.
+		run := func(args ...string) error {
+			c.commandName = "kubebuilder"
+			c.version = "Version: v4.0.0"
+			c.fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+			c.cmd = c.newRootCmd()
+			c.cmd.AddCommand(c.newVersionCmd())
+			c.cmd.SetOut(out)
+			c.cmd.SetErr(&bytes.Buffer{})
+			c.args = append([]string{kubebuilderSubcommandVersion}, args...)
+			c.cmd.SetArgs(c.args)
+			return c.Run()
+		}
+
+		BeforeEach(func() {
+			out = &bytes.Buffer{}
+		})
+
+		It("should write the structured version information", func() {
+			c.versionInfo = versionInfo{KubeBuilderVersion: "v4.0.0", GoOs: "linux"}
+			Expect(run("--output", "json")).To(Succeed())
+
+			var info versionInfo
+			result := commandResult{Data: &info}
+			Expect(json.Unmarshal(out.Bytes(), &result)).To(Succeed())
+			Expect(result.Success).To(BeTrue())
+			Expect(info).To(Equal(versionInfo{KubeBuilderVersion: "v4.0.0", GoOs: "linux"}))
+		})
+
+		It("should write the version string when no structured information is defined", func() {
+			Expect(run("--output", "json")).To(Succeed())
+
+			var version string
+			result := commandResult{Data: &version}
+			Expect(json.Unmarshal(out.Bytes(), &result)).To(Succeed())
+			Expect(version).To(Equal("Version: v4.0.0"))
+		})
+
+		It("should write the version string as text", func() {
+			c.versionInfo = versionInfo{KubeBuilderVersion: "v4.0.0"}
+			Expect(run()).To(Succeed())
+			Expect(out.String()).To(Equal("Version: v4.0.0\n"))
+		})
+	})
 })
@@ -47,29 +47,30 @@ Run this command from an initialized project after creating the API resource.`,
 	}
 
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.CreateWebhook.
-	subcommands := c.filterSubcommands(
-		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.CreateWebhook)
-			return isValid
-		},
-		func(p plugin.Plugin) plugin.Subcommand {
-			return p.(plugin.CreateWebhook).GetCreateWebhookSubcommand()
-		},
-	)
-
-	// Verify that there is at least one remaining plugin.
+	filter := func(p plugin.Plugin) bool {
+		_, isValid := plugin.AsCreateWebhook(p)
+		return isValid
+	}
+	extract := func(p plugin.Plugin) plugin.Subcommand {
+		return p.(plugin.CreateWebhook).GetCreateWebhookSubcommand()
+	}
+	subcommands := c.filterSubcommands(filter, extract)
+
+	// Verify that there is at least one remaining plugin, hiding the subcommand otherwise.
 	if len(subcommands) == 0 {
+		cmd.Hidden = true
 		cmdErr(cmd, noAvailablePluginError{"webhook creation"})
 		return cmd
 	}
 
+	// Plugins tracked in the project configuration run after the plugin chain to update their scaffolds.
+	subcommands = append(subcommands,
+		c.attachedSubcommands(plugin.CreateWebhookSubcommandName, subcommands, filter, extract)...)
+
 	c.applySubcommandHooks(cmd, subcommands, webhookErrorMsg, false)
 
 	// Append plugin table after metadata updates
-	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.CreateWebhook)
-		return isValid
-	}, "Available plugins that support 'create webhook'")
+	c.appendPluginTable(cmd, filter, "Available plugins that support 'create webhook'")
 
 	return cmd
 }
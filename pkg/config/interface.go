@@ -89,6 +89,8 @@ type Config interface {
 	AddResource(res resource.Resource) error
 	// UpdateResource adds the provided resource if it was not present, modifies it if it was already present.
 	UpdateResource(res resource.Resource) error
+	// RemoveResource removes the resource matching the provided GVK, returning an error if it was not present.
+	RemoveResource(gvk resource.GVK) error
 
 	// HasGroup checks if the provided group is the same as any of the tracked resources.
 	HasGroup(group string) bool
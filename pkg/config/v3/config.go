@@ -18,6 +18,7 @@ package v3
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"sigs.k8s.io/yaml"
@@ -277,6 +278,18 @@ func (c *Cfg) UpdateResource(res resource.Resource) error {
 	return nil
 }
 
+// RemoveResource implements config.Config
+func (c *Cfg) RemoveResource(gvk resource.GVK) error {
+	for i, r := range c.Resources {
+		if gvk.IsEqualTo(r.GVK) {
+			c.Resources = slices.Delete(c.Resources, i, i+1)
+			return nil
+		}
+	}
+
+	return config.ResourceNotFoundError{GVK: gvk}
+}
+
 // HasGroup implements config.Config
 func (c Cfg) HasGroup(group string) bool {
 	// Return true if the target group is found in the tracked resources
@@ -17,6 +17,7 @@ limitations under the License.
 package v3
 
 import (
+	"errors"
 	"slices"
 	"testing"
 
@@ -283,6 +284,26 @@ var _ = Describe("Cfg", func() {
 			checkResource(c.Resources[0], resWithoutPlural)
 		})
 
+		It("RemoveResource should remove the resource if it exists", func() {
+			other := resource.Resource{
+				GVK: resource.GVK{
+					Group:   resourceGroup,
+					Version: "v2",
+					Kind:    resourceKind,
+				},
+			}
+			c.Resources = append(c.Resources, res, other)
+
+			Expect(c.RemoveResource(res.GVK)).To(Succeed())
+			Expect(c.Resources).To(Equal([]resource.Resource{other}))
+		})
+
+		It("RemoveResource should fail if the resource does not exist", func() {
+			err := c.RemoveResource(res.GVK)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.As(err, &config.ResourceNotFoundError{})).To(BeTrue())
+		})
+
 		It("HasGroup should return false with no tracked resources", func() {
 			Expect(c.HasGroup(res.Group)).To(BeFalse())
 		})
@@ -0,0 +1,722 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type anchorKind int
+
+const (
+	importsAnchor anchorKind = iota
+	beforeFuncAnchor
+	funcStartAnchor
+	funcEndAnchor
+	beforeCallAnchor
+	afterCallAnchor
+)
+
+// Anchor represents a position of a Go file where code fragments will be inserted. Unlike a Marker,
+// it is located with the syntax tree of the file, so it does not depend on comments or on the formatting.
+type Anchor struct {
+	kind anchorKind
+	// funcName is the name of the function of the anchor, methods are named as <Type>.<Method>
+	funcName string
+	// call is the function called by the statements the anchor is relative to, such as mgr.Start
+	call string
+	// marker is the scaffold marker used when it is in the part of the function designated by the anchor,
+	// or when the anchor is not found
+	marker Marker
+}
+
+// ImportsAnchor returns the anchor of the import declarations. Its code fragments are import specs,
+// such as `"fmt"` or `ctrl "sigs.k8s.io/controller-runtime"`, which are added to the group of imports
+// whose paths are the most similar.
+func ImportsAnchor() Anchor {
+	return Anchor{kind: importsAnchor}
+}
+
+// BeforeFuncAnchor returns the anchor placed before the declaration of a function and its doc comment.
+// Its code fragments are top level declarations.
+func BeforeFuncAnchor(funcName string) Anchor {
+	return Anchor{kind: beforeFuncAnchor, funcName: funcName}
+}
+
+// FuncStartAnchor returns the anchor placed at the start of the body of a function.
+// Its code fragments are statements.
+func FuncStartAnchor(funcName string) Anchor {
+	return Anchor{kind: funcStartAnchor, funcName: funcName}
+}
+
+// FuncEndAnchor returns the anchor placed at the end of the body of a function.
+// Its code fragments are statements.
+func FuncEndAnchor(funcName string) Anchor {
+	return Anchor{kind: funcEndAnchor, funcName: funcName}
+}
+
+// BeforeCallAnchor returns the anchor placed before the first statement of the body of a function that
+// calls the provided function, such as BeforeCallAnchor("main", "mgr.Start"). Its code fragments are statements.
+func BeforeCallAnchor(funcName, call string) Anchor {
+	return Anchor{kind: beforeCallAnchor, funcName: funcName, call: call}
+}
+
+// AfterCallAnchor returns the anchor placed after the last statement of the body of a function that
+// calls the provided function, such as AfterCallAnchor("init", "utilruntime.Must"). Its code fragments
+// are statements.
+func AfterCallAnchor(funcName, call string) Anchor {
+	return Anchor{kind: afterCallAnchor, funcName: funcName, call: call}
+}
+
+// WithMarker returns a copy of the anchor that falls back to a scaffold marker. The code fragments are inserted
+// before the marker when it is in the part of the function designated by the anchor, which keeps the layout of
+// the scaffolded files, or when the anchor is not found, such as when the user moved the code it relies on.
+func (a Anchor) WithMarker(marker Marker) Anchor {
+	a.marker = marker
+	return a
+}
+
+// String implements Stringer
+func (a Anchor) String() string {
+	switch a.kind {
+	case importsAnchor:
+		return "import declarations"
+	case beforeFuncAnchor:
+		return fmt.Sprintf("declaration of func %s", a.funcName)
+	case funcStartAnchor, funcEndAnchor:
+		return fmt.Sprintf("body of func %s", a.funcName)
+	default:
+		return fmt.Sprintf("call to %s in func %s", a.call, a.funcName)
+	}
+}
+
+// AnchoredCodeFragmentsMap binds Anchors and CodeFragments together
+type AnchoredCodeFragmentsMap map[Anchor]CodeFragments
+
+// GoFile is a parsed Go file whose content is edited at the offsets of the nodes of its syntax tree,
+// so that the code that is not edited keeps its formatting and comments
+type GoFile struct {
+	// File is the syntax tree of the file, parsed with its comments
+	File *ast.File
+	// Fset is the file set of the positions of the syntax tree
+	Fset *token.FileSet
+
+	path    string
+	content string
+}
+
+// GoEdit replaces the content between two offsets of a Go file
+type GoEdit struct {
+	Start, End int
+	Text       string
+}
+
+// ParseGoFile parses the content of a Go file
+func ParseGoFile(path string, content []byte) (*GoFile, error) {
+	return parseGoFile(path, string(content))
+}
+
+func parseGoFile(path, content string) (*GoFile, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, content, parser.ParseComments)
+	if err != nil {
+		// The errors of the parser are prefixed with the path of the file
+		return nil, fmt.Errorf("failed to parse Go file: %w", err)
+	}
+	return &GoFile{File: file, Fset: fset, path: path, content: content}, nil
+}
+
+// InsertCodeFragments returns the edits that insert the code fragments of the anchors, skipping the ones
+// that the file already declares. The anchors are processed in the provided order.
+func (f *GoFile) InsertCodeFragments(anchors []Anchor, codeFragmentsMap AnchoredCodeFragmentsMap) ([]GoEdit, error) {
+	var edits []GoEdit
+	for _, anchor := range anchors {
+		codeFragments := codeFragmentsMap[anchor]
+		if len(codeFragments) == 0 {
+			continue
+		}
+
+		var anchorEdits []GoEdit
+		var err error
+		switch anchor.kind {
+		case importsAnchor:
+			anchorEdits, err = f.insertImports(codeFragments)
+		case beforeFuncAnchor:
+			anchorEdits, err = f.insertDecls(anchor, codeFragments)
+		default:
+			anchorEdits, err = f.insertStatements(anchor, codeFragments)
+		}
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, anchorEdits...)
+	}
+	return edits, nil
+}
+
+// Edit returns the content of the file with the edits applied, formatted with gofmt. Overlapping edits
+// are skipped, and the content is returned unchanged if there is no edit.
+func (f *GoFile) Edit(edits []GoEdit) ([]byte, error) {
+	if len(edits) == 0 {
+		return []byte(f.content), nil
+	}
+
+	formatted, err := format.Source([]byte(f.apply(edits)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format %s: %w", f.path, err)
+	}
+	return formatted, nil
+}
+
+// insertGoCodeFragments inserts the code fragments of the anchors in the content of a Go file,
+// skipping the ones that it already declares. The anchors are processed in the provided order.
+func insertGoCodeFragments(
+	path, content string, anchors []Anchor, codeFragmentsMap AnchoredCodeFragmentsMap,
+) (string, error) {
+	f, err := parseGoFile(path, content)
+	if err != nil {
+		return "", err
+	}
+
+	edits, err := f.InsertCodeFragments(anchors, codeFragmentsMap)
+	if err != nil {
+		return "", err
+	}
+	return f.apply(edits), nil
+}
+
+// removeGoCodeFragments removes the statements and declarations of the code fragments from the content
+// of a Go file. Imports are not removed, since they may still be used: the unused ones are removed when the
+// file is formatted.
+func removeGoCodeFragments(path, content string, codeFragmentsMap AnchoredCodeFragmentsMap) (string, error) {
+	f, err := parseGoFile(path, content)
+	if err != nil {
+		return "", err
+	}
+
+	removed := map[ast.Node]bool{}
+	var edits []GoEdit
+	for anchor, codeFragments := range codeFragmentsMap {
+		if anchor.kind == importsAnchor {
+			continue
+		}
+		fn := f.anchorFunc(anchor)
+		if fn == nil {
+			continue
+		}
+
+		for _, codeFragment := range codeFragments {
+			nodes, err := f.findCodeFragment(anchor, fn, codeFragment)
+			if err != nil {
+				return "", err
+			}
+			for _, node := range nodes {
+				if !removed[node] {
+					removed[node] = true
+					edits = append(edits, GoEdit{Start: f.NodeStart(node), End: f.lineEnd(f.Offset(node.End()))})
+				}
+			}
+		}
+	}
+
+	return f.apply(edits), nil
+}
+
+// insertImports adds the missing import specs of the code fragments
+func (f *GoFile) insertImports(codeFragments CodeFragments) ([]GoEdit, error) {
+	imported := map[string]string{}
+	for _, spec := range f.File.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		imported[importPath] = importName(spec)
+	}
+
+	var edits []GoEdit
+	var newSpecs []string
+	for _, codeFragment := range codeFragments {
+		specs, err := parseImportSpecs(codeFragment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code fragment for the %s of %s: %w", ImportsAnchor(), f.path, err)
+		}
+
+		for _, spec := range specs {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			if name, found := imported[importPath]; found {
+				if name != importName(spec) {
+					return nil, fmt.Errorf("failed to import %q as %s in %s: it is already imported as %s",
+						importPath, importName(spec), f.path, name)
+				}
+				continue
+			}
+			imported[importPath] = importName(spec)
+
+			text := printNode(spec)
+			decl, group := f.importGroup(importPath)
+			switch {
+			case decl == nil:
+				newSpecs = append(newSpecs, text)
+			case decl.Lparen.IsValid():
+				offset := f.lineEnd(f.Offset(group.End()))
+				edits = append(edits, GoEdit{Start: offset, End: offset, Text: "\t" + text + "\n"})
+			default:
+				offset := f.lineEnd(f.Offset(decl.End()))
+				edits = append(edits, GoEdit{Start: offset, End: offset, Text: "import " + text + "\n"})
+			}
+		}
+	}
+
+	if len(newSpecs) > 0 {
+		offset := f.lineEnd(f.Offset(f.File.Name.End()))
+		edits = append(edits, GoEdit{Start: offset, End: offset,
+			Text: "\nimport (\n\t" + strings.Join(newSpecs, "\n\t") + "\n)\n"})
+	}
+	return edits, nil
+}
+
+// importGroup returns the import declaration and the spec after which an import path is added: the last spec
+// of the group of imports with the most similar paths, where standard library packages are grouped together.
+func (f *GoFile) importGroup(importPath string) (*ast.GenDecl, *ast.ImportSpec) {
+	var bestDecl *ast.GenDecl
+	var bestSpec *ast.ImportSpec
+	bestScore := -1
+	for _, decl := range f.File.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			specPath, _ := strconv.Unquote(importSpec.Path.Value)
+			score := commonPathElements(specPath, importPath)
+			if isStandardImport(specPath) == isStandardImport(importPath) {
+				score++
+			}
+			if score >= bestScore {
+				bestDecl, bestSpec, bestScore = genDecl, importSpec, score
+			}
+		}
+	}
+	return bestDecl, bestSpec
+}
+
+// insertDecls adds the top level declarations of the code fragments whose names are not declared yet
+func (f *GoFile) insertDecls(anchor Anchor, codeFragments CodeFragments) ([]GoEdit, error) {
+	var offset int
+	if fn := f.FindFunc(anchor.funcName); fn != nil {
+		offset = f.NodeStart(fn)
+	} else if offset = f.markerOffset(anchor.marker); offset < 0 {
+		return nil, AnchorNotFoundError{path: f.path, anchor: anchor}
+	}
+
+	declared := map[string]bool{}
+	for _, decl := range f.File.Decls {
+		for _, name := range declaredNames(decl) {
+			declared[name] = true
+		}
+	}
+
+	var edits []GoEdit
+	for _, codeFragment := range codeFragments {
+		decls, err := parseDecls(codeFragment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code fragment for the %s of %s: %w", anchor, f.path, err)
+		}
+
+		var names []string
+		for _, decl := range decls {
+			names = append(names, declaredNames(decl)...)
+		}
+		if len(names) > 0 && !slices.ContainsFunc(names, func(name string) bool { return !declared[name] }) {
+			continue
+		}
+		for _, name := range names {
+			declared[name] = true
+		}
+
+		edits = append(edits, GoEdit{Start: offset, End: offset, Text: strings.Trim(codeFragment, "\n") + "\n\n"})
+	}
+	return edits, nil
+}
+
+// insertStatements adds the code fragments that are not already statements of the body of the function
+func (f *GoFile) insertStatements(anchor Anchor, codeFragments CodeFragments) ([]GoEdit, error) {
+	fn, offset, err := f.statementsOffset(anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]bool{}
+	if fn != nil {
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if stmt, isStmt := n.(ast.Stmt); isStmt {
+				existing[printNode(stmt)] = true
+			}
+			return true
+		})
+	}
+
+	var edits []GoEdit
+	for _, codeFragment := range codeFragments {
+		stmts, err := parseStatements(codeFragment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code fragment for the %s of %s: %w", anchor, f.path, err)
+		}
+
+		printed := make([]string, 0, len(stmts))
+		for _, stmt := range stmts {
+			printed = append(printed, printNode(stmt))
+		}
+		if len(printed) > 0 && !slices.ContainsFunc(printed, func(stmt string) bool { return !existing[stmt] }) {
+			continue
+		}
+		for _, stmt := range printed {
+			existing[stmt] = true
+		}
+
+		text := strings.TrimLeft(codeFragment, "\n")
+		if !strings.HasSuffix(text, "\n") {
+			text += "\n"
+		}
+		if offset > 0 && f.content[offset-1] != '\n' {
+			text = "\n" + text
+		}
+		edits = append(edits, GoEdit{Start: offset, End: offset, Text: text})
+	}
+	return edits, nil
+}
+
+// statementsOffset returns the function and the offset where the statements of an anchor are inserted. The marker
+// of the anchor is preferred when it is in the part of the function designated by the anchor, and it is used on
+// its own when the anchor is not found.
+func (f *GoFile) statementsOffset(anchor Anchor) (*ast.FuncDecl, int, error) {
+	markerOffset := f.markerOffset(anchor.marker)
+
+	if fn := f.FindFunc(anchor.funcName); fn != nil && fn.Body != nil {
+		// The marker is accepted between start and end
+		var offset int
+		start, end := f.Offset(fn.Body.Lbrace), f.Offset(fn.Body.Rbrace)
+		found := true
+		switch anchor.kind {
+		case funcStartAnchor:
+			offset = f.lineEnd(start)
+			if offset > end {
+				// The body is in a single line
+				offset = start + 1
+			}
+		case funcEndAnchor:
+			offset = f.lineStart(end)
+			if offset <= start {
+				// The body is in a single line
+				offset = end
+			}
+		default:
+			var calls []ast.Stmt
+			for _, stmt := range fn.Body.List {
+				if callsFunc(stmt, anchor.call) {
+					calls = append(calls, stmt)
+				}
+			}
+			switch {
+			case len(calls) == 0:
+				found = false
+			case anchor.kind == beforeCallAnchor:
+				offset = f.NodeStart(calls[0])
+				end = offset
+			default:
+				offset = f.lineEnd(f.Offset(calls[len(calls)-1].End()))
+				start = offset - 1
+			}
+		}
+
+		if found {
+			if markerOffset > start && markerOffset <= end {
+				return fn, markerOffset, nil
+			}
+			return fn, offset, nil
+		}
+	}
+
+	if markerOffset < 0 {
+		return nil, 0, AnchorNotFoundError{path: f.path, anchor: anchor}
+	}
+	return f.enclosingFunc(markerOffset), markerOffset, nil
+}
+
+// anchorFunc returns the function of an anchor, or the one that contains its marker if it is not found
+func (f *GoFile) anchorFunc(anchor Anchor) *ast.FuncDecl {
+	if fn := f.FindFunc(anchor.funcName); fn != nil {
+		return fn
+	}
+	if offset := f.markerOffset(anchor.marker); offset >= 0 {
+		return f.enclosingFunc(offset)
+	}
+	return nil
+}
+
+// markerOffset returns the offset of the start of the line of a marker, or -1 if the file does not contain it
+func (f *GoFile) markerOffset(marker Marker) int {
+	if marker == (Marker{}) {
+		return -1
+	}
+	offset := 0
+	for line := range strings.SplitAfterSeq(f.content, "\n") {
+		if marker.EqualsLine(line) {
+			return offset
+		}
+		offset += len(line)
+	}
+	return -1
+}
+
+// enclosingFunc returns the function whose body contains an offset, if any
+func (f *GoFile) enclosingFunc(offset int) *ast.FuncDecl {
+	for _, decl := range f.File.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil &&
+			f.Offset(fn.Body.Lbrace) < offset && offset < f.Offset(fn.Body.Rbrace) {
+			return fn
+		}
+	}
+	return nil
+}
+
+// findCodeFragment returns the statements or declarations of the file that match the code fragment
+func (f *GoFile) findCodeFragment(anchor Anchor, fn *ast.FuncDecl, codeFragment string) ([]ast.Node, error) {
+	var nodes []ast.Node
+	if anchor.kind == beforeFuncAnchor {
+		decls, err := parseDecls(codeFragment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code fragment for the %s of %s: %w", anchor, f.path, err)
+		}
+		for _, decl := range decls {
+			names := declaredNames(decl)
+			for _, existing := range f.File.Decls {
+				if len(names) > 0 && slices.Equal(declaredNames(existing), names) {
+					nodes = append(nodes, existing)
+				}
+			}
+		}
+		return nodes, nil
+	}
+
+	stmts, err := parseStatements(codeFragment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid code fragment for the %s of %s: %w", anchor, f.path, err)
+	}
+	if fn.Body == nil {
+		return nil, nil
+	}
+	for _, stmt := range stmts {
+		printed := printNode(stmt)
+		for _, existing := range fn.Body.List {
+			if printNode(existing) == printed {
+				nodes = append(nodes, existing)
+				break
+			}
+		}
+	}
+	return nodes, nil
+}
+
+// FindFunc returns the declaration of a function, or of a method if the name is <Type>.<Method>
+func (f *GoFile) FindFunc(name string) *ast.FuncDecl {
+	recv, method, isMethod := strings.Cut(name, ".")
+	for _, decl := range f.File.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		if !isMethod && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+		if isMethod && fn.Recv != nil && fn.Name.Name == method && receiverType(fn) == recv {
+			return fn
+		}
+	}
+	return nil
+}
+
+// apply returns the content of the file with the edits applied
+func (f *GoFile) apply(edits []GoEdit) string {
+	slices.SortStableFunc(edits, func(a, b GoEdit) int { return a.Start - b.Start })
+
+	var sb strings.Builder
+	last := 0
+	for _, edit := range edits {
+		if edit.Start < last {
+			continue
+		}
+		_, _ = sb.WriteString(f.content[last:edit.Start])
+		_, _ = sb.WriteString(edit.Text)
+		last = edit.End
+	}
+	_, _ = sb.WriteString(f.content[last:])
+	return sb.String()
+}
+
+// Offset returns the offset of a position in the content of the file
+func (f *GoFile) Offset(pos token.Pos) int {
+	return f.Fset.Position(pos).Offset
+}
+
+// lineStart returns the offset of the start of the line of an offset
+func (f *GoFile) lineStart(offset int) int {
+	return strings.LastIndexByte(f.content[:offset], '\n') + 1
+}
+
+// lineEnd returns the offset of the start of the line after the one of an offset
+func (f *GoFile) lineEnd(offset int) int {
+	if i := strings.IndexByte(f.content[offset:], '\n'); i >= 0 {
+		return offset + i + 1
+	}
+	return len(f.content)
+}
+
+// NodeStart returns the offset of the start of the line of a node, including the comments directly above it
+func (f *GoFile) NodeStart(node ast.Node) int {
+	start := f.lineStart(f.Offset(node.Pos()))
+	line := f.Fset.Position(node.Pos()).Line
+	for _, group := range f.File.Comments {
+		groupStart := f.Offset(group.Pos())
+		if f.Fset.Position(group.End()).Line == line-1 &&
+			strings.TrimSpace(f.content[f.lineStart(groupStart):groupStart]) == "" {
+			return f.lineStart(groupStart)
+		}
+	}
+	return start
+}
+
+func parseImportSpecs(codeFragment string) ([]*ast.ImportSpec, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p\nimport (\n"+codeFragment+"\n)\n", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse import specs: %w", err)
+	}
+	return file.Imports, nil
+}
+
+func parseDecls(codeFragment string) ([]ast.Decl, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+codeFragment, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse declarations: %w", err)
+	}
+	return file.Decls, nil
+}
+
+func parseStatements(codeFragment string) ([]ast.Stmt, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p\nfunc _() {\n"+codeFragment+"\n}\n", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse statements: %w", err)
+	}
+	return file.Decls[0].(*ast.FuncDecl).Body.List, nil
+}
+
+// printNode returns the source of a node without its comments and with a normalized layout, so that nodes
+// that only differ in formatting are printed in the same way
+func printNode(node ast.Node) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), node); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// callsFunc returns true if the node contains a call to the provided function
+func callsFunc(node ast.Node, call string) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if callExpr, isCall := n.(*ast.CallExpr); isCall && printNode(callExpr.Fun) == call {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// declaredNames returns the names declared by a top level declaration, methods are named as <Type>.<Method>
+func declaredNames(decl ast.Decl) []string {
+	var names []string
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		if decl.Recv != nil {
+			return []string{receiverType(decl) + "." + decl.Name.Name}
+		}
+		return []string{decl.Name.Name}
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, spec.Name.Name)
+			case *ast.ValueSpec:
+				for _, name := range spec.Names {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// receiverType returns the name of the type of the receiver of a method
+func receiverType(fn *ast.FuncDecl) string {
+	expr := fn.Recv.List[0].Type
+	if star, isStar := expr.(*ast.StarExpr); isStar {
+		expr = star.X
+	}
+	switch typ := expr.(type) {
+	case *ast.IndexExpr:
+		expr = typ.X
+	case *ast.IndexListExpr:
+		expr = typ.X
+	}
+	if ident, isIdent := expr.(*ast.Ident); isIdent {
+		return ident.Name
+	}
+	return ""
+}
+
+// importName returns the name used to reference an import, which is the last element of its path when
+// it has no alias
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	importPath, _ := strconv.Unquote(spec.Path.Value)
+	return path.Base(importPath)
+}
+
+// commonPathElements returns the number of leading elements that two import paths share
+func commonPathElements(a, b string) int {
+	aElements, bElements := strings.Split(a, "/"), strings.Split(b, "/")
+	n := 0
+	for n < len(aElements) && n < len(bElements) && aElements[n] == bElements[n] {
+		n++
+	}
+	return n
+}
+
+// isStandardImport returns true if the import path belongs to the standard library
+func isStandardImport(importPath string) bool {
+	first, _, _ := strings.Cut(importPath, "/")
+	return !strings.Contains(first, ".")
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+)
+
+const anchorTestMain = `package main
+
+import (
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	crewv1 "example.com/project/api/v1"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(crewv1.AddToScheme(scheme))
+}
+
+// main starts the manager
+func main() {
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{Scheme: scheme})
+	if err != nil {
+		os.Exit(1)
+	}
+
+	// Start the manager
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		os.Exit(1)
+	}
+}
+`
+
+var _ = Describe("Anchors", func() {
+	const path = "main.go"
+
+	var s *Scaffold
+
+	BeforeEach(func() {
+		s = &Scaffold{fs: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(s.fs, path, []byte(anchorTestMain), 0o666)).To(Succeed())
+	})
+
+	read := func() string {
+		b, err := afero.ReadFile(s.fs, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(b)
+	}
+
+	DescribeTable("should insert code fragments",
+		func(codeFragments AnchoredCodeFragmentsMap, expected string) {
+			inserter := fakeGoInserter{fakeBuilder: fakeBuilder{path: path}, codeFragments: codeFragments}
+			Expect(s.Execute(inserter)).To(Succeed())
+			Expect(read()).To(Equal(expected))
+
+			By("skipping the code fragments that were already inserted")
+			Expect(s.Execute(inserter)).To(Succeed())
+			Expect(read()).To(Equal(expected))
+		},
+		Entry("in the group of imports with the most similar paths",
+			AnchoredCodeFragmentsMap{
+				ImportsAnchor(): {
+					`"fmt"`,
+					`clientgoscheme "k8s.io/client-go/kubernetes/scheme"`,
+					`crewv2 "example.com/project/api/v2"`,
+				},
+				FuncStartAnchor("init"): {
+					"utilruntime.Must(clientgoscheme.AddToScheme(scheme))\n",
+					"utilruntime.Must(crewv2.AddToScheme(scheme))\n",
+				},
+				FuncEndAnchor("main"): {"fmt.Println(\"stopped\")\n"},
+			},
+			replaceOnce(replaceOnce(replaceOnce(replaceOnce(replaceOnce(anchorTestMain,
+				"\t\"os\"\n", "\t\"fmt\"\n\t\"os\"\n"),
+				"\tctrl \"sigs.k8s.io/controller-runtime\"\n",
+				"\tclientgoscheme \"k8s.io/client-go/kubernetes/scheme\"\n\tctrl \"sigs.k8s.io/controller-runtime\"\n"),
+				"\tcrewv1 \"example.com/project/api/v1\"\n",
+				"\tcrewv1 \"example.com/project/api/v1\"\n\tcrewv2 \"example.com/project/api/v2\"\n"),
+				"func init() {\n",
+				"func init() {\n\tutilruntime.Must(clientgoscheme.AddToScheme(scheme))\n"+
+					"\tutilruntime.Must(crewv2.AddToScheme(scheme))\n"),
+				"\t\tos.Exit(1)\n\t}\n}\n", "\t\tos.Exit(1)\n\t}\n\tfmt.Println(\"stopped\")\n}\n"),
+		),
+		Entry("after the last call of a function",
+			AnchoredCodeFragmentsMap{AfterCallAnchor("init", "utilruntime.Must"): {
+				"utilruntime.Must(crewv2.AddToScheme(scheme))\n",
+			}},
+			replaceOnce(anchorTestMain, "\tutilruntime.Must(crewv1.AddToScheme(scheme))\n",
+				"\tutilruntime.Must(crewv1.AddToScheme(scheme))\n\tutilruntime.Must(crewv2.AddToScheme(scheme))\n"),
+		),
+		Entry("before the first call of a function, above its comments",
+			AnchoredCodeFragmentsMap{BeforeCallAnchor("main", "mgr.Start"): {
+				"if err := mgr.AddHealthzCheck(\"healthz\", nil); err != nil {\n\tos.Exit(1)\n}\n\n",
+			}},
+			replaceOnce(anchorTestMain, "\t// Start the manager\n",
+				"\tif err := mgr.AddHealthzCheck(\"healthz\", nil); err != nil {\n\t\tos.Exit(1)\n\t}\n\n"+
+					"\t// Start the manager\n"),
+		),
+		Entry("at the start and at the end of the body of a function",
+			AnchoredCodeFragmentsMap{
+				FuncStartAnchor("main"): {"ctrl.SetLogger(ctrl.Log)\n"},
+				FuncEndAnchor("main"):   {"os.Exit(0)\n"},
+			},
+			replaceOnce(replaceOnce(anchorTestMain, "func main() {\n", "func main() {\n\tctrl.SetLogger(ctrl.Log)\n"),
+				"\t\tos.Exit(1)\n\t}\n}\n", "\t\tos.Exit(1)\n\t}\n\tos.Exit(0)\n}\n"),
+		),
+		Entry("before the declaration of a function and its doc comment",
+			AnchoredCodeFragmentsMap{BeforeFuncAnchor("main"): {
+				"// getWatchNamespace returns the namespace to watch\nfunc getWatchNamespace() string {\n" +
+					"\treturn os.Getenv(\"WATCH_NAMESPACE\")\n}\n",
+			}},
+			replaceOnce(anchorTestMain, "// main starts the manager\n",
+				"// getWatchNamespace returns the namespace to watch\nfunc getWatchNamespace() string {\n"+
+					"\treturn os.Getenv(\"WATCH_NAMESPACE\")\n}\n\n// main starts the manager\n"),
+		),
+	)
+
+	It("should skip statements that only differ in formatting and comments", func() {
+		Expect(s.Execute(fakeGoInserter{
+			fakeBuilder: fakeBuilder{path: path},
+			codeFragments: AnchoredCodeFragmentsMap{BeforeCallAnchor("main", "mgr.Start"): {
+				"// Create the manager\nmgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{\n" +
+					"\tScheme: scheme,\n})\n",
+				"if err != nil { os.Exit(1) }",
+			}},
+		})).To(Succeed())
+		Expect(read()).To(Equal(anchorTestMain))
+	})
+
+	It("should skip declarations whose names are already declared", func() {
+		Expect(s.Execute(fakeGoInserter{
+			fakeBuilder: fakeBuilder{path: path},
+			codeFragments: AnchoredCodeFragmentsMap{BeforeFuncAnchor("main"): {
+				"var scheme = runtime.NewScheme() // shared scheme\n",
+			}},
+		})).To(Succeed())
+		Expect(read()).To(Equal(anchorTestMain))
+	})
+
+	It("should fail if an anchor is not found", func() {
+		anchor := BeforeCallAnchor("main", "mgr.Add")
+		err := s.Execute(fakeGoInserter{
+			fakeBuilder:   fakeBuilder{path: path},
+			codeFragments: AnchoredCodeFragmentsMap{anchor: {"setupLog.Info(\"adding runnable\")\n"}},
+		})
+		Expect(err).To(MatchError(AnchorNotFoundError{path: path, anchor: anchor}))
+		Expect(err).To(MatchError(ContainSubstring("call to mgr.Add in func main not found")))
+		Expect(read()).To(Equal(anchorTestMain))
+	})
+
+	Context("with a marker", func() {
+		const content = `package main
+
+func main() {
+	setupControllers()
+
+	// +kubebuilder:scaffold:builder
+
+	setupHealthChecks()
+	run()
+}
+`
+		marker := NewMarkerFor(path, "builder")
+
+		BeforeEach(func() {
+			Expect(afero.WriteFile(s.fs, path, []byte(content), 0o666)).To(Succeed())
+		})
+
+		It("should insert the code fragments before the marker if it is in the part of the function of the anchor",
+			func() {
+				Expect(s.Execute(fakeGoInserter{
+					fakeBuilder: fakeBuilder{path: path},
+					codeFragments: AnchoredCodeFragmentsMap{
+						BeforeCallAnchor("main", "run").WithMarker(marker): {"setupWebhooks()\n"},
+					},
+				})).To(Succeed())
+				Expect(read()).To(Equal(replaceOnce(content, "\t// +kubebuilder:scaffold:builder\n",
+					"\tsetupWebhooks()\n\t// +kubebuilder:scaffold:builder\n")))
+			})
+
+		It("should insert the code fragments at the anchor if the marker is not in the part of its function", func() {
+			Expect(s.Execute(fakeGoInserter{
+				fakeBuilder: fakeBuilder{path: path},
+				codeFragments: AnchoredCodeFragmentsMap{
+					BeforeCallAnchor("main", "setupControllers").WithMarker(marker): {"setupLogger()\n"},
+				},
+			})).To(Succeed())
+			Expect(read()).To(Equal(replaceOnce(content, "\tsetupControllers()\n",
+				"\tsetupLogger()\n\tsetupControllers()\n")))
+		})
+
+		It("should insert the code fragments before the marker if the anchor is not found", func() {
+			inserter := fakeGoInserter{
+				fakeBuilder: fakeBuilder{path: path},
+				codeFragments: AnchoredCodeFragmentsMap{
+					BeforeCallAnchor("main", "mgr.Start").WithMarker(marker): {"setupWebhooks()\n"},
+				},
+			}
+			Expect(s.Execute(inserter)).To(Succeed())
+			expected := replaceOnce(content, "\t// +kubebuilder:scaffold:builder\n",
+				"\tsetupWebhooks()\n\t// +kubebuilder:scaffold:builder\n")
+			Expect(read()).To(Equal(expected))
+
+			By("skipping the code fragments that were already inserted")
+			Expect(s.Execute(inserter)).To(Succeed())
+			Expect(read()).To(Equal(expected))
+
+			By("removing them")
+			Expect(s.Remove(inserter)).To(Succeed())
+			Expect(read()).To(Equal(content))
+		})
+
+		It("should fail if neither the anchor nor the marker is found", func() {
+			anchor := BeforeCallAnchor("main", "mgr.Start").WithMarker(NewMarkerFor(path, "webhooks"))
+			err := s.Execute(fakeGoInserter{
+				fakeBuilder:   fakeBuilder{path: path},
+				codeFragments: AnchoredCodeFragmentsMap{anchor: {"setupWebhooks()\n"}},
+			})
+			Expect(err).To(MatchError(AnchorNotFoundError{path: path, anchor: anchor}))
+			Expect(err).To(MatchError(ContainSubstring(
+				`neither the call to mgr.Start in func main nor the marker "// +kubebuilder:scaffold:webhooks" was found`)))
+			Expect(read()).To(Equal(content))
+		})
+	})
+
+	It("should fail if an import is already used with another name", func() {
+		err := s.Execute(fakeGoInserter{
+			fakeBuilder:   fakeBuilder{path: path},
+			codeFragments: AnchoredCodeFragmentsMap{ImportsAnchor(): {`"example.com/project/api/v1"`}},
+		})
+		Expect(err).To(MatchError(ContainSubstring(`"example.com/project/api/v1" as v1`)))
+		Expect(err).To(MatchError(ContainSubstring("already imported as crewv1")))
+	})
+
+	It("should fail if a code fragment is not valid", func() {
+		err := s.Execute(fakeGoInserter{
+			fakeBuilder:   fakeBuilder{path: path},
+			codeFragments: AnchoredCodeFragmentsMap{FuncEndAnchor("main"): {"func() {"}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("invalid code fragment for the body of func main")))
+	})
+
+	It("should skip a missing file when IgnoreFile action is used", func() {
+		Expect(s.Execute(fakeGoInserterWithIfNotExists{
+			fakeGoInserter: fakeGoInserter{
+				fakeBuilder:   fakeBuilder{path: "missing.go"},
+				codeFragments: AnchoredCodeFragmentsMap{FuncEndAnchor("main"): {"os.Exit(0)\n"}},
+			},
+			ifNotExistsAction: IgnoreFile,
+		})).To(Succeed())
+	})
+
+	It("should remove the inserted code fragments and the imports that are no longer used", func() {
+		inserter := fakeGoInserter{
+			fakeBuilder: fakeBuilder{path: path},
+			codeFragments: AnchoredCodeFragmentsMap{
+				ImportsAnchor(): {`crewv2 "example.com/project/api/v2"`},
+				AfterCallAnchor("init", "utilruntime.Must"): {
+					"utilruntime.Must(crewv2.AddToScheme(scheme))\n",
+				},
+				BeforeFuncAnchor("main"): {"// crewVersions are the served versions\nvar crewVersions = 2\n"},
+			},
+		}
+		Expect(s.Execute(inserter)).To(Succeed())
+		Expect(read()).To(ContainSubstring("crewv2"))
+
+		Expect(s.Remove(inserter)).To(Succeed())
+		Expect(read()).To(Equal(anchorTestMain))
+	})
+})
+
+func replaceOnce(content, old, replacement string) string {
+	Expect(content).To(ContainSubstring(old))
+	return strings.Replace(content, old, replacement, 1)
+}
+
+var _ GoInserter = fakeGoInserter{}
+
+type fakeGoInserter struct {
+	fakeBuilder
+
+	codeFragments AnchoredCodeFragmentsMap
+}
+
+// GetAnchors implements GoInserter
+func (f fakeGoInserter) GetAnchors() []Anchor {
+	anchors := make([]Anchor, 0, len(f.codeFragments))
+	for anchor := range f.codeFragments {
+		anchors = append(anchors, anchor)
+	}
+	return anchors
+}
+
+// GetAnchoredCodeFragments implements GoInserter
+func (f fakeGoInserter) GetAnchoredCodeFragments() AnchoredCodeFragmentsMap {
+	return f.codeFragments
+}
+
+var (
+	_ GoInserter           = fakeGoInserterWithIfNotExists{}
+	_ HasIfNotExistsAction = fakeGoInserterWithIfNotExists{}
+)
+
+type fakeGoInserterWithIfNotExists struct {
+	fakeGoInserter
+	ifNotExistsAction IfNotExistsAction
+}
+
+func (f fakeGoInserterWithIfNotExists) GetIfNotExistsAction() IfNotExistsAction {
+	return f.ifNotExistsAction
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines shown around each change in a unified diff
+const diffContextLines = 3
+
+// editKind identifies the operation of a single line edit
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+// lineEdit is a single step of the edit script that turns one list of lines into another.
+// oldIndex and newIndex are the positions in the old and new lines before the step is applied.
+type lineEdit struct {
+	kind     editKind
+	oldIndex int
+	newIndex int
+}
+
+// splitLines splits content into lines, keeping the line terminators so that a missing
+// newline at the end of the content is detected as a change.
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit script that turns oldLines into newLines using the
+// Myers difference algorithm.
+func diffLines(oldLines, newLines []string) []lineEdit {
+	n, m := len(oldLines), len(newLines)
+
+	// Trivial cases do not need the algorithm, and avoid its quadratic memory for new files.
+	switch {
+	case n == 0 && m == 0:
+		return nil
+	case n == 0 || m == 0:
+		edits := make([]lineEdit, 0, n+m)
+		for i := range n {
+			edits = append(edits, lineEdit{kind: editDelete, oldIndex: i})
+		}
+		for j := range m {
+			edits = append(edits, lineEdit{kind: editInsert, newIndex: j})
+		}
+		return edits
+	}
+
+	maxSteps := n + m
+	offset := maxSteps
+	v := make([]int, 2*maxSteps+1)
+	var trace [][]int
+
+	for d := 0; d <= maxSteps; d++ {
+		trace = append(trace, slices.Clone(v))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && oldLines[x] == newLines[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrackEdits(trace, offset, n, m)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backtrackEdits walks the trace recorded by diffLines from the end to the start in order to
+// build the edit script.
+func backtrackEdits(trace [][]int, offset, n, m int) []lineEdit {
+	x, y := n, m
+	var edits []lineEdit
+
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			edits = append(edits, lineEdit{kind: editEqual, oldIndex: x - 1, newIndex: y - 1})
+			x--
+			y--
+		}
+		if x == prevX {
+			edits = append(edits, lineEdit{kind: editInsert, oldIndex: x, newIndex: y - 1})
+			y--
+		} else {
+			edits = append(edits, lineEdit{kind: editDelete, oldIndex: x - 1, newIndex: y})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		edits = append(edits, lineEdit{kind: editEqual, oldIndex: x - 1, newIndex: y - 1})
+		x--
+		y--
+	}
+
+	slices.Reverse(edits)
+	return edits
+}
+
+// UnifiedDiff returns the changes between oldContent and newContent in unified diff format.
+// oldName and newName are used in the diff header, "/dev/null" can be used for either of them
+// to describe a created or a deleted file. An empty string is returned if both contents are equal.
+func UnifiedDiff(oldName, newName, oldContent, newContent string) string {
+	if oldContent == newContent {
+		return ""
+	}
+
+	oldLines, newLines := splitLines(oldContent), splitLines(newContent)
+	edits := diffLines(oldLines, newLines)
+
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+
+	hunkEnd := 0
+	for i := 0; i < len(edits); {
+		// Look for the next change
+		for i < len(edits) && edits[i].kind == editEqual {
+			i++
+		}
+		if i == len(edits) {
+			break
+		}
+
+		start := max(i-diffContextLines, hunkEnd)
+		end := i
+		for end < len(edits) {
+			if edits[end].kind != editEqual {
+				end++
+				continue
+			}
+			run := end
+			for run < len(edits) && edits[run].kind == editEqual {
+				run++
+			}
+			if run == len(edits) || run-end > 2*diffContextLines {
+				end = min(end+diffContextLines, run)
+				break
+			}
+			end = run
+		}
+
+		writeHunk(&sb, edits[start:end], oldLines, newLines)
+		hunkEnd = end
+		i = end
+	}
+
+	return sb.String()
+}
+
+// writeHunk writes a single unified diff hunk with its header
+func writeHunk(sb *strings.Builder, hunk []lineEdit, oldLines, newLines []string) {
+	oldCount, newCount := 0, 0
+	for _, e := range hunk {
+		switch e.kind {
+		case editEqual:
+			oldCount++
+			newCount++
+		case editDelete:
+			oldCount++
+		case editInsert:
+			newCount++
+		}
+	}
+
+	_, _ = fmt.Fprintf(sb, "@@ -%s +%s @@\n",
+		hunkRange(hunk[0].oldIndex, oldCount), hunkRange(hunk[0].newIndex, newCount))
+
+	for _, e := range hunk {
+		switch e.kind {
+		case editEqual:
+			writeDiffLine(sb, ' ', oldLines[e.oldIndex])
+		case editDelete:
+			writeDiffLine(sb, '-', oldLines[e.oldIndex])
+		case editInsert:
+			writeDiffLine(sb, '+', newLines[e.newIndex])
+		}
+	}
+}
+
+// hunkRange formats the start line and line count of one side of a hunk
+func hunkRange(index, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", index)
+	case 1:
+		return fmt.Sprintf("%d", index+1)
+	default:
+		return fmt.Sprintf("%d,%d", index+1, count)
+	}
+}
+
+// writeDiffLine writes a line prefixed with its operation, marking lines without a terminator
+func writeDiffLine(sb *strings.Builder, op byte, line string) {
+	_ = sb.WriteByte(op)
+	_, _ = sb.WriteString(line)
+	if !strings.HasSuffix(line, "\n") {
+		_, _ = sb.WriteString("\n\\ No newline at end of file\n")
+	}
+}
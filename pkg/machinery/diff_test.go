@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UnifiedDiff", func() {
+	const (
+		oldName = "a/file.txt"
+		newName = "b/file.txt"
+	)
+
+	It("should return an empty string when the contents are equal", func() {
+		Expect(UnifiedDiff(oldName, newName, "a\nb\n", "a\nb\n")).To(BeEmpty())
+	})
+
+	It("should describe a created file", func() {
+		Expect(UnifiedDiff(devNull, newName, "", "a\nb\n")).To(Equal(`--- /dev/null
++++ b/file.txt
+@@ -0,0 +1,2 @@
++a
++b
+`))
+	})
+
+	It("should describe a modified line with its context", func() {
+		Expect(UnifiedDiff(oldName, newName, "1\n2\n3\n4\n5\n", "1\n2\nthree\n4\n5\n")).To(Equal(`--- a/file.txt
++++ b/file.txt
+@@ -1,5 +1,5 @@
+ 1
+ 2
+-3
++three
+ 4
+ 5
+`))
+	})
+
+	It("should split distant changes in several hunks", func() {
+		var oldLines, newLines []string
+		for i := range 20 {
+			line := strings.Repeat("x", i+1)
+			oldLines = append(oldLines, line)
+			newLines = append(newLines, line)
+		}
+		newLines[1] = "changed"
+		newLines[18] = "changed"
+
+		diff := UnifiedDiff(oldName, newName, strings.Join(oldLines, "\n")+"\n", strings.Join(newLines, "\n")+"\n")
+		Expect(strings.Count(diff, "@@ -")).To(Equal(2))
+		Expect(diff).To(ContainSubstring("@@ -1,5 +1,5 @@"))
+		Expect(diff).To(ContainSubstring("@@ -16,5 +16,5 @@"))
+	})
+
+	It("should report a missing newline at the end of the file", func() {
+		Expect(UnifiedDiff(oldName, newName, "a\n", "a\nb")).To(Equal(`--- a/file.txt
++++ b/file.txt
+@@ -1 +1,2 @@
+ a
++b
+\ No newline at end of file
+`))
+	})
+})
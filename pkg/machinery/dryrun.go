@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+const devNull = "/dev/null"
+
+// FileChange describes a file that would be written by a dry run, or that was written in a Transaction
+type FileChange struct {
+	// Path is the file location, relative to the working directory when possible
+	Path string
+
+	// Created is true if the file does not exist yet
+	Created bool
+
+	// Deleted is true if the file would be removed
+	Deleted bool
+
+	// Before is the current content of the file
+	Before string
+
+	// After is the content that would be written, empty if the file would be removed
+	After string
+}
+
+// DryRun keeps every change done through its Filesystem in memory, on top of the provided one,
+// so that the changes can be previewed without modifying the underlying filesystem.
+//
+// Changes done outside of the Filesystem, such as running external commands, are not captured.
+type DryRun struct {
+	base    afero.Fs
+	layer   afero.Fs
+	overlay *overlayFs
+	fs      afero.Fs
+
+	// workDir is used to display absolute paths relative to the working directory
+	workDir string
+
+	mu      sync.Mutex
+	written map[string]struct{}
+	// skipped are the files that would not be written because they already exist
+	skipped map[string]struct{}
+}
+
+// NewDryRun returns a new DryRun that reads from the provided filesystem
+func NewDryRun(fs Filesystem) *DryRun {
+	d := &DryRun{
+		base:    fs.FS,
+		layer:   afero.NewMemMapFs(),
+		written: make(map[string]struct{}),
+		skipped: make(map[string]struct{}),
+	}
+	d.overlay = newOverlayFs(d.base, d.layer)
+	d.fs = &recordingFs{Fs: d.overlay, record: d.record}
+	d.workDir, _ = os.Getwd()
+
+	return d
+}
+
+// Filesystem returns the filesystem that must be used to scaffold during the dry run
+func (d *DryRun) Filesystem() Filesystem {
+	return Filesystem{FS: d.fs}
+}
+
+// record keeps track of a written path
+func (d *DryRun) record(path string, op fsOperation) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if op == opSkip {
+		d.skipped[path] = struct{}{}
+		return nil
+	}
+	d.written[path] = struct{}{}
+	return nil
+}
+
+// Skipped returns the files that would not be written because they already exist, sorted by path.
+func (d *DryRun) Skipped() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	paths := make([]string, 0, len(d.skipped))
+	for _, path := range sortedPaths(d.skipped) {
+		paths = append(paths, d.displayPath(path))
+	}
+	return paths
+}
+
+// Changes returns the files that would be written or removed, sorted by path.
+// Files whose content would not change are omitted.
+func (d *DryRun) Changes() ([]FileChange, error) {
+	d.mu.Lock()
+	written := make([]string, 0, len(d.written))
+	for path := range d.written {
+		written = append(written, path)
+	}
+	d.mu.Unlock()
+
+	changes := make(map[string]FileChange)
+
+	// Removed files are hidden from the base
+	for _, removed := range d.overlay.removedPaths() {
+		err := afero.Walk(d.base, removed, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return ReadFileError{err}
+			}
+			if info.IsDir() {
+				return nil
+			}
+			before, err := afero.ReadFile(d.base, path)
+			if err != nil {
+				return ReadFileError{err}
+			}
+			changes[path] = FileChange{Path: d.displayPath(path), Deleted: true, Before: string(before)}
+			return nil
+		})
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	}
+
+	// Written files are stored in the layer, directories may have been renamed as a whole
+	for _, written := range written {
+		if d.overlay.isRemoved(written) {
+			continue
+		}
+		if _, err := d.layer.Stat(written); errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, ExistsFileError{err}
+		}
+
+		err := afero.Walk(d.layer, written, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return ReadFileError{err}
+			}
+			if info.IsDir() {
+				return nil
+			}
+			change, err := d.change(path)
+			if err != nil {
+				return err
+			}
+			if change.Created || change.Before != change.After {
+				changes[path] = change
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	}
+
+	result := make([]FileChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, change)
+	}
+	slices.SortFunc(result, func(a, b FileChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return result, nil
+}
+
+// change returns the change done to a file written to the layer
+func (d *DryRun) change(path string) (FileChange, error) {
+	after, err := afero.ReadFile(d.layer, path)
+	if err != nil {
+		return FileChange{}, ReadFileError{err}
+	}
+
+	change := FileChange{Path: d.displayPath(path), After: string(after)}
+	before, err := afero.ReadFile(d.base, path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		change.Created = true
+	case err != nil:
+		return FileChange{}, ReadFileError{err}
+	default:
+		change.Before = string(before)
+	}
+	return change, nil
+}
+
+// WriteDiff writes the changes that would be done as a unified diff
+func (d *DryRun) WriteDiff(w io.Writer) error {
+	changes, err := d.Changes()
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		oldName := filepath.ToSlash(filepath.Join("a", change.Path))
+		if change.Created {
+			oldName = devNull
+		}
+		newName := filepath.ToSlash(filepath.Join("b", change.Path))
+		if change.Deleted {
+			newName = devNull
+		}
+
+		if _, err := io.WriteString(w, UnifiedDiff(oldName, newName, change.Before, change.After)); err != nil {
+			return fmt.Errorf("failed to write diff for %s: %w", change.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// displayPath returns the path relative to the working directory if it is inside it
+func (d *DryRun) displayPath(path string) string {
+	if !filepath.IsAbs(path) || d.workDir == "" {
+		return path
+	}
+	if rel, err := filepath.Rel(d.workDir, path); err == nil && filepath.IsLocal(rel) {
+		return rel
+	}
+	return path
+}
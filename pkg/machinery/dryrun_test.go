@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"bytes"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+)
+
+var _ = Describe("DryRun", func() {
+	const (
+		existingPath = "existing.txt"
+		createdPath  = "dir/created.txt"
+		samePath     = "same.txt"
+	)
+
+	var (
+		base   afero.Fs
+		dryRun *DryRun
+	)
+
+	BeforeEach(func() {
+		base = afero.NewMemMapFs()
+		Expect(afero.WriteFile(base, existingPath, []byte("old\n"), DefaultFilePermission)).To(Succeed())
+		Expect(afero.WriteFile(base, samePath, []byte("same\n"), DefaultFilePermission)).To(Succeed())
+
+		dryRun = NewDryRun(Filesystem{FS: base})
+	})
+
+	It("should not modify the underlying filesystem", func() {
+		s := NewScaffold(dryRun.Filesystem())
+		Expect(s.Execute(
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: createdPath}, body: "created"},
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: existingPath, ifExistsAction: OverwriteFile}, body: "new"},
+		)).To(Succeed())
+
+		exists, err := afero.Exists(base, createdPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		content, err := afero.ReadFile(base, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("old\n"))
+
+		content, err = afero.ReadFile(dryRun.Filesystem().FS, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("new"))
+	})
+
+	It("should list the changed files sorted by path", func() {
+		fs := dryRun.Filesystem().FS
+		Expect(afero.WriteFile(fs, existingPath, []byte("new\n"), DefaultFilePermission)).To(Succeed())
+		Expect(afero.WriteFile(fs, samePath, []byte("same\n"), DefaultFilePermission)).To(Succeed())
+		Expect(fs.MkdirAll("dir", DefaultDirectoryPermission)).To(Succeed())
+		Expect(afero.WriteFile(fs, createdPath, []byte("created\n"), DefaultFilePermission)).To(Succeed())
+
+		changes, err := dryRun.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: createdPath, Created: true, After: "created\n"},
+			{Path: existingPath, Before: "old\n", After: "new\n"},
+		}))
+	})
+
+	It("should list the files that would be skipped", func() {
+		s := NewScaffold(dryRun.Filesystem())
+		Expect(s.Execute(
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: existingPath, ifExistsAction: SkipFile}, body: "new"},
+		)).To(Succeed())
+
+		changes, err := dryRun.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+		Expect(dryRun.Skipped()).To(Equal([]string{existingPath}))
+	})
+
+	It("should write the changes as a unified diff", func() {
+		fs := dryRun.Filesystem().FS
+		Expect(afero.WriteFile(fs, existingPath, []byte("new\n"), DefaultFilePermission)).To(Succeed())
+		Expect(fs.MkdirAll("dir", DefaultDirectoryPermission)).To(Succeed())
+		Expect(afero.WriteFile(fs, createdPath, []byte("created\n"), DefaultFilePermission)).To(Succeed())
+
+		var out bytes.Buffer
+		Expect(dryRun.WriteDiff(&out)).To(Succeed())
+		Expect(out.String()).To(Equal(`--- /dev/null
++++ b/dir/created.txt
+@@ -0,0 +1 @@
++created
+--- a/existing.txt
++++ b/existing.txt
+@@ -1 +1 @@
+-old
++new
+`))
+	})
+
+	It("should list the removed and renamed files", func() {
+		fs := dryRun.Filesystem().FS
+		Expect(fs.Remove(samePath)).To(Succeed())
+		Expect(fs.Rename(existingPath, "renamed.txt")).To(Succeed())
+
+		exists, err := afero.Exists(fs, samePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		names, err := afero.ReadDir(fs, ".")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(HaveLen(1))
+		Expect(names[0].Name()).To(Equal("renamed.txt"))
+
+		changes, err := dryRun.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: existingPath, Deleted: true, Before: "old\n"},
+			{Path: "renamed.txt", Created: true, After: "old\n"},
+			{Path: samePath, Deleted: true, Before: "same\n"},
+		}))
+
+		exists, err = afero.Exists(base, samePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("should write a removed file again from scratch", func() {
+		fs := dryRun.Filesystem().FS
+		Expect(fs.Remove(existingPath)).To(Succeed())
+		f, err := fs.OpenFile(existingPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, DefaultFilePermission)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.WriteString("new\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		changes, err := dryRun.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: existingPath, Before: "old\n", After: "new\n"},
+		}))
+	})
+})
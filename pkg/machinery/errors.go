@@ -143,3 +143,18 @@ type FileAlreadyExistsError struct {
 func (e FileAlreadyExistsError) Error() string {
 	return fmt.Sprintf("failed to create %s: file already exists", e.path)
 }
+
+// AnchorNotFoundError is returned if the anchor where code fragments are inserted is not found in a Go file
+type AnchorNotFoundError struct {
+	path   string
+	anchor Anchor
+}
+
+// Error implements error interface
+func (e AnchorNotFoundError) Error() string {
+	if e.anchor.marker != (Marker{}) {
+		return fmt.Sprintf("failed to insert code fragments in %s: neither the %s nor the marker %q was found",
+			e.path, e.anchor, e.anchor.marker)
+	}
+	return fmt.Sprintf("failed to insert code fragments in %s: %s not found", e.path, e.anchor)
+}
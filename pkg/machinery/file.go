@@ -28,6 +28,11 @@ const (
 
 	// OverwriteFile truncates and overwrites the existing file
 	OverwriteFile
+
+	// MergeFile merges the changes of the scaffold into the existing file with a three-way merge, using as
+	// common ancestor the pristine output of the previous scaffold stored under PristineDir. The changes that
+	// conflict are left between conflict markers. The file is overwritten if there is no pristine output yet.
+	MergeFile
 )
 
 // IfNotExistsAction determines what to do if a file to be updated does not exist
@@ -54,4 +59,11 @@ type File struct {
 
 	// IfNotExistsAction determines what to do if the file is missing (optional updates only)
 	IfNotExistsAction IfNotExistsAction
+
+	// Mergeable stores the contents under PristineDir whenever the file is written, see Mergeable
+	Mergeable bool
+
+	// scaffolded is true if the contents are the output of a template, and false if they were loaded from
+	// the existing file to insert code fragments
+	scaffolded bool
 }
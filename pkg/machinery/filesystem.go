@@ -17,10 +17,122 @@ limitations under the License.
 package machinery
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/spf13/afero"
 )
 
+// writeFlags are the flags that make os.OpenFile modify a file
+const writeFlags = os.O_WRONLY | os.O_RDWR | os.O_CREATE | os.O_TRUNC | os.O_APPEND
+
 // Filesystem abstracts the underlying disk for scaffolding
 type Filesystem struct {
 	FS afero.Fs
 }
+
+// fsOperation identifies the kind of modification reported by recordingFs
+type fsOperation int
+
+const (
+	// opWrite creates or modifies a file
+	opWrite fsOperation = iota
+	// opMkdir creates a directory
+	opMkdir
+	// opRemove removes a file or a directory with all its content
+	opRemove
+	// opSkip leaves an existing file untouched instead of writing it
+	opSkip
+)
+
+// recordingFs is an afero.Fs that reports the paths that are about to be modified through it.
+// If record returns an error, the modification is not performed. Errors of the underlying
+// filesystem are returned unwrapped so that checks like os.IsNotExist keep working.
+type recordingFs struct {
+	afero.Fs
+
+	record func(string, fsOperation) error
+}
+
+// skip reports that the file at name was not written because it already exists
+func (fs *recordingFs) skip(name string) {
+	_ = fs.record(filepath.Clean(name), opSkip)
+}
+
+// Create implements afero.Fs
+func (fs *recordingFs) Create(name string) (afero.File, error) {
+	if err := fs.record(filepath.Clean(name), opWrite); err != nil {
+		return nil, err
+	}
+	return fs.Fs.Create(name) //nolint:wrapcheck
+}
+
+// OpenFile implements afero.Fs
+func (fs *recordingFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if flag&writeFlags != 0 {
+		if err := fs.record(filepath.Clean(name), opWrite); err != nil {
+			return nil, err
+		}
+	}
+	return fs.Fs.OpenFile(name, flag, perm) //nolint:wrapcheck
+}
+
+// Mkdir implements afero.Fs
+func (fs *recordingFs) Mkdir(name string, perm os.FileMode) error {
+	if err := fs.record(filepath.Clean(name), opMkdir); err != nil {
+		return err
+	}
+	return fs.Fs.Mkdir(name, perm) //nolint:wrapcheck
+}
+
+// MkdirAll implements afero.Fs
+func (fs *recordingFs) MkdirAll(path string, perm os.FileMode) error {
+	if err := fs.record(filepath.Clean(path), opMkdir); err != nil {
+		return err
+	}
+	return fs.Fs.MkdirAll(path, perm) //nolint:wrapcheck
+}
+
+// Remove implements afero.Fs
+func (fs *recordingFs) Remove(name string) error {
+	if err := fs.record(filepath.Clean(name), opRemove); err != nil {
+		return err
+	}
+	return fs.Fs.Remove(name) //nolint:wrapcheck
+}
+
+// RemoveAll implements afero.Fs
+func (fs *recordingFs) RemoveAll(path string) error {
+	if err := fs.record(filepath.Clean(path), opRemove); err != nil {
+		return err
+	}
+	return fs.Fs.RemoveAll(path) //nolint:wrapcheck
+}
+
+// Rename implements afero.Fs
+func (fs *recordingFs) Rename(oldName, newName string) error {
+	if err := fs.record(filepath.Clean(oldName), opRemove); err != nil {
+		return err
+	}
+	if err := fs.record(filepath.Clean(newName), opWrite); err != nil {
+		return err
+	}
+	return fs.Fs.Rename(oldName, newName) //nolint:wrapcheck
+}
+
+// Chmod implements afero.Fs
+func (fs *recordingFs) Chmod(name string, mode os.FileMode) error {
+	if err := fs.record(filepath.Clean(name), opWrite); err != nil {
+		return err
+	}
+	return fs.Fs.Chmod(name, mode) //nolint:wrapcheck
+}
+
+// LstatIfPossible implements afero.Lstater
+func (fs *recordingFs) LstatIfPossible(name string) (os.FileInfo, bool, error) {
+	if lstater, ok := fs.Fs.(afero.Lstater); ok {
+		return lstater.LstatIfPossible(name) //nolint:wrapcheck
+	}
+	info, err := fs.Stat(name)
+	return info, false, err //nolint:wrapcheck
+}
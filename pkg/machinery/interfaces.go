@@ -59,6 +59,39 @@ type Inserter interface {
 	GetCodeFragments() CodeFragmentsMap
 }
 
+// GoInserter is a file builder that inserts code fragments in a Go file at the positions located by anchors
+// in its syntax tree. Code fragments are skipped when the file already declares them, regardless of their
+// formatting and comments, and an AnchorNotFoundError is returned when an anchor is missing.
+type GoInserter interface {
+	Builder
+	// GetAnchors returns the different anchors where code fragments will be inserted, in insertion order
+	GetAnchors() []Anchor
+	// GetAnchoredCodeFragments returns a map that binds anchors to code fragments
+	GetAnchoredCodeFragments() AnchoredCodeFragmentsMap
+}
+
+// HasRetainedCodeFragments allows an Inserter to keep some of its code fragments when they are removed,
+// such as the imports that are still used by other resources
+type HasRetainedCodeFragments interface {
+	// GetRetainedCodeFragments returns a map that binds markers to the code fragments that must not be removed
+	GetRetainedCodeFragments() CodeFragmentsMap
+}
+
+// HasRetainedAnchoredCodeFragments allows a GoInserter to keep some of its code fragments when they are removed,
+// such as the statements that are shared with other resources
+type HasRetainedAnchoredCodeFragments interface {
+	// GetRetainedAnchoredCodeFragments returns a map that binds anchors to the code fragments that must not be
+	// removed
+	GetRetainedAnchoredCodeFragments() AnchoredCodeFragmentsMap
+}
+
+// Mergeable allows a template to be merged with MergeFile into the file that it scaffolded before
+type Mergeable interface {
+	// IsMergeable returns true if the output of the template is stored under PristineDir whenever its file
+	// is written, as the common ancestor of the next merge
+	IsMergeable() bool
+}
+
 // HasIfNotExistsAction allows a template to define an action if the file is missing
 type HasIfNotExistsAction interface {
 	GetIfNotExistsAction() IfNotExistsAction
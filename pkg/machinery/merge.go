@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// PristineDir is the directory of a project where the pristine output of the files scaffolded with MergeFile
+// is stored, under the same path as the file. It is the common ancestor of the three-way merge that is done
+// the next time the file is scaffolded.
+const PristineDir = ".kubebuilder/pristine"
+
+// Conflict markers left in a merged file around the changes that could not be merged
+const (
+	conflictStartMarker     = "<<<<<<< current"
+	conflictSeparatorMarker = "======="
+	conflictEndMarker       = ">>>>>>> scaffold"
+)
+
+// MovePristineFile moves the pristine output of a file that is moved to another path, if it has one, so that
+// the file is still merged at its new path. The pristine output is rewritten with the function that rewrote the
+// file, if any.
+func MovePristineFile(fs afero.Fs, from, to string, rewrite func([]byte) ([]byte, error)) error {
+	fromPath, toPath := filepath.Join(PristineDir, from), filepath.Join(PristineDir, to)
+	content, err := afero.ReadFile(fs, fromPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return ReadFileError{err}
+	}
+
+	if rewrite != nil {
+		if content, err = rewrite(content); err != nil {
+			return fmt.Errorf("failed to rewrite %s: %w", fromPath, err)
+		}
+	}
+	if err = fs.MkdirAll(filepath.Dir(toPath), DefaultDirectoryPermission); err != nil {
+		return CreateDirectoryError{err}
+	}
+	if err = afero.WriteFile(fs, toPath, content, DefaultFilePermission); err != nil {
+		return WriteFileError{err}
+	}
+	if toPath == fromPath {
+		return nil
+	}
+	return RemovePristineFile(fs, from)
+}
+
+// RemovePristineFile deletes the pristine output of a file, if it has one
+func RemovePristineFile(fs afero.Fs, path string) error {
+	pristinePath := filepath.Join(PristineDir, path)
+	exists, err := afero.Exists(fs, pristinePath)
+	if err != nil {
+		return ExistsFileError{err}
+	}
+	if !exists {
+		return nil
+	}
+
+	if err = fs.Remove(pristinePath); err != nil {
+		return fmt.Errorf("failed to remove file %q: %w", pristinePath, err)
+	}
+	return nil
+}
+
+// merge3 merges the changes from base to current and from base to scaffolded line by line. It returns the
+// merged content and the number of conflicts, which are left between the standard conflict markers with the
+// lines of current first.
+func merge3(base, current, scaffolded string) (string, int) {
+	baseLines, currentLines, scaffoldedLines := splitLines(base), splitLines(current), splitLines(scaffolded)
+	currentMatches := matchLines(baseLines, currentLines)
+	scaffoldedMatches := matchLines(baseLines, scaffoldedLines)
+
+	var sb strings.Builder
+	conflicts := 0
+	b, c, s := 0, 0, 0
+	for {
+		// Copy the lines that are unchanged in both versions
+		stable := 0
+		for b+stable < len(baseLines) &&
+			currentMatches[b+stable] == c+stable && scaffoldedMatches[b+stable] == s+stable {
+			stable++
+		}
+		if stable > 0 {
+			writeLines(&sb, baseLines[b:b+stable])
+			b, c, s = b+stable, c+stable, s+stable
+			continue
+		}
+
+		// Find the next base line kept in both versions, the lines before it were changed in any of them
+		next := b
+		for next < len(baseLines) && (currentMatches[next] < 0 || scaffoldedMatches[next] < 0) {
+			next++
+		}
+		nextCurrent, nextScaffolded := len(currentLines), len(scaffoldedLines)
+		if next < len(baseLines) {
+			nextCurrent, nextScaffolded = currentMatches[next], scaffoldedMatches[next]
+		}
+
+		baseChunk := baseLines[b:next]
+		currentChunk := currentLines[c:nextCurrent]
+		scaffoldedChunk := scaffoldedLines[s:nextScaffolded]
+		switch {
+		case slices.Equal(currentChunk, baseChunk):
+			writeLines(&sb, scaffoldedChunk)
+		case slices.Equal(scaffoldedChunk, baseChunk), slices.Equal(currentChunk, scaffoldedChunk):
+			writeLines(&sb, currentChunk)
+		default:
+			conflicts++
+			_, _ = sb.WriteString(conflictStartMarker + "\n")
+			writeLines(&sb, currentChunk)
+			terminateLine(&sb)
+			_, _ = sb.WriteString(conflictSeparatorMarker + "\n")
+			writeLines(&sb, scaffoldedChunk)
+			terminateLine(&sb)
+			_, _ = sb.WriteString(conflictEndMarker + "\n")
+		}
+
+		if next == len(baseLines) {
+			break
+		}
+		b, c, s = next, nextCurrent, nextScaffolded
+	}
+
+	return sb.String(), conflicts
+}
+
+// matchLines returns, for each line of a, the index of the line of b that it is kept as in the shortest
+// edit script between them, or -1 if the line is removed
+func matchLines(a, b []string) []int {
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+	for _, edit := range diffLines(a, b) {
+		if edit.kind == editEqual {
+			matches[edit.oldIndex] = edit.newIndex
+		}
+	}
+	return matches
+}
+
+func writeLines(sb *strings.Builder, lines []string) {
+	for _, line := range lines {
+		_, _ = sb.WriteString(line)
+	}
+}
+
+// terminateLine adds a line break if the content does not end with one, so that a conflict marker that
+// follows the last line of a file without a trailing line break starts its own line
+func terminateLine(sb *strings.Builder) {
+	if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "\n") {
+		_ = sb.WriteByte('\n')
+	}
+}
+
+// conflictsSummary returns the message that lists the files left with conflict markers
+func conflictsSummary(paths []string) string {
+	return fmt.Sprintf("The scaffold changes conflict with your changes in %d file(s). "+
+		"Resolve the conflicts between the %q and %q markers in: %s",
+		len(paths), conflictStartMarker, conflictEndMarker, strings.Join(paths, ", "))
+}
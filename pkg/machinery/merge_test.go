@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("merge3", func() {
+	const base = "a\nb\nc\nd\ne\n"
+
+	DescribeTable("should merge the changes of both versions",
+		func(current, scaffolded, expected string) {
+			merged, conflicts := merge3(base, current, scaffolded)
+			Expect(conflicts).To(BeZero())
+			Expect(merged).To(Equal(expected))
+		},
+		Entry("when only the scaffold changed", base, "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n"),
+		Entry("when only the current file changed", "a\nb\nc\nD\ne\n", base, "a\nb\nc\nD\ne\n"),
+		Entry("when they changed different lines", "a\nb\nc\nD\ne\n", "a\nB\nc\nd\ne\n", "a\nB\nc\nD\ne\n"),
+		Entry("when they made the same change", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n"),
+		Entry("when lines were added and removed", "x\na\nb\nc\nd\ne\n", "a\nb\nd\ne\nf\n", "x\na\nb\nd\ne\nf\n"),
+		Entry("when the current file was emptied and the scaffold is unchanged", "", base, ""),
+	)
+
+	It("should leave conflict markers around the changes of the same lines", func() {
+		merged, conflicts := merge3(base, "a\nb\nmine\nd\nE\n", "a\nb\ntheirs\nd\ne\n")
+		Expect(conflicts).To(Equal(1))
+		Expect(merged).To(Equal("a\nb\n<<<<<<< current\nmine\n=======\ntheirs\n>>>>>>> scaffold\nd\nE\n"))
+	})
+
+	It("should start the conflict markers in their own lines", func() {
+		merged, conflicts := merge3("a\nb", "a\nmine", "a\ntheirs")
+		Expect(conflicts).To(Equal(1))
+		Expect(merged).To(Equal("a\n<<<<<<< current\nmine\n=======\ntheirs\n>>>>>>> scaffold\n"))
+	})
+})
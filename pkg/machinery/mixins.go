@@ -165,6 +165,49 @@ func (m *ResourceMixin) InjectResource(res *resource.Resource) {
 	}
 }
 
+// RetainedResourcesMixin provides Inserters with the resources whose code fragments must be kept
+// when removing code fragments
+type RetainedResourcesMixin struct {
+	// RetainedResources are the resources that remain in the project
+	RetainedResources []resource.Resource
+}
+
+// RetainedCodeFragments returns the code fragments that fragmentsFor returns for each retained resource
+func (m *RetainedResourcesMixin) RetainedCodeFragments(
+	fragmentsFor func(*resource.Resource) CodeFragmentsMap,
+) CodeFragmentsMap {
+	retained := make(CodeFragmentsMap)
+	for i := range m.RetainedResources {
+		for marker, codeFragments := range fragmentsFor(&m.RetainedResources[i]) {
+			retained[marker] = append(retained[marker], codeFragments...)
+		}
+	}
+	return retained
+}
+
+// RetainedAnchoredCodeFragments returns the anchored code fragments that fragmentsFor returns for each
+// retained resource
+func (m *RetainedResourcesMixin) RetainedAnchoredCodeFragments(
+	fragmentsFor func(*resource.Resource) AnchoredCodeFragmentsMap,
+) AnchoredCodeFragmentsMap {
+	retained := make(AnchoredCodeFragmentsMap)
+	for i := range m.RetainedResources {
+		for anchor, codeFragments := range fragmentsFor(&m.RetainedResources[i]) {
+			retained[anchor] = append(retained[anchor], codeFragments...)
+		}
+	}
+	return retained
+}
+
+// MergeableMixin provides templates that are merged with MergeFile when they are scaffolded again, so that
+// their output is stored under PristineDir even when the file is created without MergeFile
+type MergeableMixin struct{}
+
+// IsMergeable implements Mergeable
+func (m *MergeableMixin) IsMergeable() bool {
+	return true
+}
+
 // IfNotExistsActionMixin provides file builders with an if-not-exists-action field
 type IfNotExistsActionMixin struct {
 	// IfNotExistsAction determines what to do if the file does not exist
@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/afero"
+)
+
+// overlayFs is a copy-on-write filesystem that keeps every change in a layer on top of a read-only base.
+// Unlike afero.CopyOnWriteFs, it also allows removing and renaming the files of the base, by hiding
+// them instead of deleting them. Errors are returned unwrapped so that checks like os.IsNotExist keep working.
+type overlayFs struct {
+	afero.Fs
+
+	base  afero.Fs
+	layer afero.Fs
+
+	mu sync.Mutex
+	// removed are the paths of the base that are hidden, together with everything below them
+	removed map[string]struct{}
+}
+
+// newOverlayFs returns a new overlayFs that writes to layer on top of base
+func newOverlayFs(base, layer afero.Fs) *overlayFs {
+	return &overlayFs{
+		Fs:      afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(base), layer),
+		base:    base,
+		layer:   layer,
+		removed: make(map[string]struct{}),
+	}
+}
+
+// isRemoved returns true if name, or any of its parent directories, was removed
+func (fs *overlayFs) isRemoved(name string) bool {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return fs.isRemovedLocked(filepath.Clean(name))
+}
+
+func (fs *overlayFs) isRemovedLocked(name string) bool {
+	for path := name; ; path = filepath.Dir(path) {
+		if _, found := fs.removed[path]; found {
+			return true
+		}
+		if filepath.Dir(path) == path {
+			return false
+		}
+	}
+}
+
+// removedPaths returns the removed paths of the base, sorted
+func (fs *overlayFs) removedPaths() []string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	paths := make([]string, 0, len(fs.removed))
+	for path := range fs.removed {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// hide removes name from the layer and hides it from the base
+func (fs *overlayFs) hide(name string) error {
+	name = filepath.Clean(name)
+	if err := fs.layer.RemoveAll(name); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if _, err := fs.base.Stat(name); err == nil {
+		fs.mu.Lock()
+		fs.removed[name] = struct{}{}
+		fs.mu.Unlock()
+	}
+	return nil
+}
+
+// unhide makes name visible again before it is created, keeping hidden the rest of the content
+// of the removed directories it is located in.
+func (fs *overlayFs) unhide(name string) {
+	name = filepath.Clean(name)
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if !fs.isRemovedLocked(name) {
+		return
+	}
+
+	// Collect name and its parents, the outermost first
+	var paths []string
+	for path := name; ; path = filepath.Dir(path) {
+		paths = append(paths, path)
+		if filepath.Dir(path) == path {
+			break
+		}
+	}
+	slices.Reverse(paths)
+
+	hidden := false
+	for _, path := range paths {
+		if !hidden {
+			if _, found := fs.removed[path]; !found {
+				continue
+			}
+			delete(fs.removed, path)
+			hidden = true
+		}
+
+		// Every entry of the base below a removed directory must stay hidden, except the ones leading to name
+		entries, err := afero.ReadDir(fs.base, path)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			child := filepath.Join(path, entry.Name())
+			if child != name && !strings.HasPrefix(name, child+string(filepath.Separator)) {
+				fs.removed[child] = struct{}{}
+			}
+		}
+	}
+}
+
+// notExist returns the error returned by the os package for missing files
+func notExist(op, name string) error {
+	return &os.PathError{Op: op, Path: name, Err: os.ErrNotExist}
+}
+
+// Create implements afero.Fs
+func (fs *overlayFs) Create(name string) (afero.File, error) {
+	fs.unhide(name)
+	return fs.Fs.Create(name) //nolint:wrapcheck
+}
+
+// OpenFile implements afero.Fs
+func (fs *overlayFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if fs.isRemoved(name) {
+		if flag&os.O_CREATE == 0 {
+			return nil, notExist("open", name)
+		}
+		// The removed content must not be copied to the layer, so the file is created empty in it
+		fs.unhide(name)
+		if err := fs.layer.MkdirAll(filepath.Dir(name), DefaultDirectoryPermission); err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+		if err := afero.WriteFile(fs.layer, name, nil, perm); err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	}
+	return fs.Fs.OpenFile(name, flag, perm) //nolint:wrapcheck
+}
+
+// Open implements afero.Fs
+func (fs *overlayFs) Open(name string) (afero.File, error) {
+	if fs.isRemoved(name) {
+		return nil, notExist("open", name)
+	}
+	f, err := fs.Fs.Open(name)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return &overlayFile{File: f, fs: fs, name: filepath.Clean(name)}, nil
+}
+
+// Mkdir implements afero.Fs
+func (fs *overlayFs) Mkdir(name string, perm os.FileMode) error {
+	fs.unhide(name)
+	return fs.Fs.Mkdir(name, perm) //nolint:wrapcheck
+}
+
+// MkdirAll implements afero.Fs
+func (fs *overlayFs) MkdirAll(path string, perm os.FileMode) error {
+	fs.unhide(path)
+	return fs.Fs.MkdirAll(path, perm) //nolint:wrapcheck
+}
+
+// Stat implements afero.Fs
+func (fs *overlayFs) Stat(name string) (os.FileInfo, error) {
+	if fs.isRemoved(name) {
+		return nil, notExist("stat", name)
+	}
+	return fs.Fs.Stat(name) //nolint:wrapcheck
+}
+
+// LstatIfPossible implements afero.Lstater
+func (fs *overlayFs) LstatIfPossible(name string) (os.FileInfo, bool, error) {
+	if fs.isRemoved(name) {
+		return nil, false, notExist("lstat", name)
+	}
+	return fs.Fs.(afero.Lstater).LstatIfPossible(name) //nolint:wrapcheck
+}
+
+// Chmod implements afero.Fs
+func (fs *overlayFs) Chmod(name string, mode os.FileMode) error {
+	if fs.isRemoved(name) {
+		return notExist("chmod", name)
+	}
+	return fs.Fs.Chmod(name, mode) //nolint:wrapcheck
+}
+
+// Remove implements afero.Fs
+func (fs *overlayFs) Remove(name string) error {
+	info, err := fs.Stat(name)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		names, err := afero.ReadDir(fs, name)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		if len(names) != 0 {
+			return &os.PathError{Op: "remove", Path: name, Err: syscall.ENOTEMPTY}
+		}
+	}
+	return fs.hide(name)
+}
+
+// RemoveAll implements afero.Fs
+func (fs *overlayFs) RemoveAll(path string) error {
+	if _, err := fs.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	return fs.hide(path)
+}
+
+// Rename implements afero.Fs
+func (fs *overlayFs) Rename(oldName, newName string) error {
+	info, err := fs.Stat(oldName)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		if err = fs.copyFile(oldName, newName, info.Mode()); err != nil {
+			return err
+		}
+		return fs.hide(oldName)
+	}
+
+	oldName = filepath.Clean(oldName)
+	err = afero.Walk(fs, oldName, func(path string, info iofs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(newName, strings.TrimPrefix(path, oldName))
+		if info.IsDir() {
+			return fs.MkdirAll(target, info.Mode().Perm())
+		}
+		return fs.copyFile(path, target, info.Mode())
+	})
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	return fs.hide(oldName)
+}
+
+// copyFile copies the content of a file to a new location
+func (fs *overlayFs) copyFile(from, to string, mode os.FileMode) error {
+	content, err := afero.ReadFile(fs, from)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	return afero.WriteFile(fs, to, content, mode.Perm()) //nolint:wrapcheck
+}
+
+// overlayFile hides the removed entries when listing a directory
+type overlayFile struct {
+	afero.File
+
+	fs   *overlayFs
+	name string
+}
+
+// Readdir implements afero.File
+func (f *overlayFile) Readdir(count int) ([]os.FileInfo, error) {
+	infos, err := f.File.Readdir(count)
+	return slices.DeleteFunc(infos, func(info os.FileInfo) bool {
+		return f.fs.isRemoved(filepath.Join(f.name, info.Name()))
+	}), err //nolint:wrapcheck
+}
+
+// Readdirnames implements afero.File
+func (f *overlayFile) Readdirnames(n int) ([]string, error) {
+	names, err := f.File.Readdirnames(n)
+	return slices.DeleteFunc(names, func(name string) bool {
+		return f.fs.isRemoved(filepath.Join(f.name, name))
+	}), err //nolint:wrapcheck
+}
@@ -155,18 +155,244 @@ func (s *Scaffold) Execute(builders ...Builder) error {
 				return err
 			}
 		}
+
+		// Build models for GoInserter builders
+		if i, isGoInserter := builder.(GoInserter); isGoInserter {
+			if err := s.updateGoFileModel(i, files); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Persist the files to disk
+	var conflicted []string
 	for _, f := range files {
-		if err := s.writeFile(f); err != nil {
+		if f.IfExistsAction != MergeFile {
+			if err := s.writeMergeableFile(f); err != nil {
+				return err
+			}
+			continue
+		}
+
+		conflicts, err := s.mergeFile(f)
+		if err != nil {
 			return err
 		}
+		if conflicts > 0 {
+			conflicted = append(conflicted, f.Path)
+		}
+	}
+
+	if len(conflicted) > 0 {
+		slices.Sort(conflicted)
+		log.Warn(conflictsSummary(conflicted))
 	}
 
 	return nil
 }
 
+// Remove reverts what Execute does with the provided builders: the files scaffolded by Templates are deleted,
+// and the code fragments of Inserters and GoInserters are removed from their files. Builders that are both
+// Templates and Inserters only have their code fragments removed. Missing files are skipped.
+func (s *Scaffold) Remove(builders ...Builder) error {
+	for _, builder := range builders {
+		// Inject common fields
+		s.injector.injectInto(builder)
+
+		if t, isTemplate := builder.(Template); isTemplate {
+			if err := t.SetTemplateDefaults(); err != nil {
+				return SetTemplateDefaultsError{err}
+			}
+		}
+
+		if i, isInserter := builder.(Inserter); isInserter {
+			if err := s.removeCodeFragments(i); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if i, isGoInserter := builder.(GoInserter); isGoInserter {
+			if err := s.removeAnchoredCodeFragments(i); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := s.removeFile(builder.GetPath()); err != nil {
+			return err
+		}
+		if err := s.removePristineFile(builder.GetPath()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeFile deletes a scaffolded file if it exists
+func (s Scaffold) removeFile(path string) error {
+	exists, err := afero.Exists(s.fs, path)
+	if err != nil {
+		return ExistsFileError{err}
+	}
+	if !exists {
+		log.Warn("skipping missing file", "file", path)
+		return nil
+	}
+
+	if err = s.fs.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove file %q: %w", path, err)
+	}
+	return nil
+}
+
+// removePristineFile deletes the pristine output of a file scaffolded with MergeFile, if any
+func (s Scaffold) removePristineFile(path string) error {
+	return RemovePristineFile(s.fs, path)
+}
+
+// removeCodeFragments removes the code fragments of an Inserter from its file
+func (s Scaffold) removeCodeFragments(i Inserter) error {
+	path := i.GetPath()
+	m, err := s.loadModelFromFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("skipping missing file", "file", path)
+			return nil
+		}
+		return fmt.Errorf("failed to load model for %s: %w", path, err)
+	}
+
+	codeFragments := getValidCodeFragments(i)
+	if withRetained, hasRetained := i.(HasRetainedCodeFragments); hasRetained {
+		filterRetainedValues(codeFragments, withRetained.GetRetainedCodeFragments())
+	}
+	if len(codeFragments) == 0 {
+		return nil
+	}
+
+	content := removeStrings(m.Contents, codeFragments)
+	if content == m.Contents {
+		return nil
+	}
+
+	formattedContent := []byte(content)
+	if ext := filepath.Ext(path); ext == goFileExt {
+		formattedContent, err = imports.Process(path, formattedContent, nil)
+		if err != nil {
+			return fmt.Errorf("failed to process formatted content: %w", err)
+		}
+	}
+
+	m.Contents = string(formattedContent)
+	m.IfExistsAction = OverwriteFile
+	return s.writeFile(m)
+}
+
+// removeAnchoredCodeFragments removes the code fragments of a GoInserter from its file
+func (s Scaffold) removeAnchoredCodeFragments(i GoInserter) error {
+	path := i.GetPath()
+	m, err := s.loadModelFromFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("skipping missing file", "file", path)
+			return nil
+		}
+		return fmt.Errorf("failed to load model for %s: %w", path, err)
+	}
+
+	codeFragments := i.GetAnchoredCodeFragments()
+	if withRetained, hasRetained := i.(HasRetainedAnchoredCodeFragments); hasRetained {
+		filterRetainedValues(codeFragments, withRetained.GetRetainedAnchoredCodeFragments())
+	}
+	if len(codeFragments) == 0 {
+		return nil
+	}
+
+	content, err := removeGoCodeFragments(path, m.Contents, codeFragments)
+	if err != nil {
+		return err
+	}
+	if content == m.Contents {
+		return nil
+	}
+
+	formattedContent, err := imports.Process(path, []byte(content), nil)
+	if err != nil {
+		return fmt.Errorf("failed to process formatted content: %w", err)
+	}
+
+	m.Contents = string(formattedContent)
+	m.IfExistsAction = OverwriteFile
+	return s.writeFile(m)
+}
+
+// filterRetainedValues removes the retained code fragments from the code fragments to remove, which are bound
+// to markers or to anchors
+func filterRetainedValues[K comparable, M ~map[K]CodeFragments](codeFragmentsMap, retainedMap M) {
+	for key, codeFragments := range codeFragmentsMap {
+		codeFragmentsOut := codeFragments[:0]
+		for _, codeFragment := range codeFragments {
+			retained := slices.ContainsFunc(retainedMap[key], func(r string) bool {
+				return trimCodeFragment(r) == trimCodeFragment(codeFragment)
+			})
+			if !retained {
+				codeFragmentsOut = append(codeFragmentsOut, codeFragment)
+			}
+		}
+
+		if len(codeFragmentsOut) == 0 {
+			delete(codeFragmentsMap, key)
+		} else {
+			codeFragmentsMap[key] = codeFragmentsOut
+		}
+	}
+}
+
+// removeStrings removes every occurrence of the code fragments from the content. As when checking
+// if a code fragment exists, lines are compared without their surrounding whitespace.
+func removeStrings(content string, codeFragmentsMap CodeFragmentsMap) string {
+	lines := strings.Split(content, "\n")
+	for _, codeFragments := range codeFragmentsMap {
+		for _, codeFragment := range codeFragments {
+			trimmed := trimCodeFragment(codeFragment)
+			if trimmed == "" {
+				continue
+			}
+			fragmentLines := strings.Split(trimmed, "\n")
+			for i := 0; i+len(fragmentLines) <= len(lines); {
+				if matchesLines(lines[i:i+len(fragmentLines)], fragmentLines) {
+					lines = slices.Delete(lines, i, i+len(fragmentLines))
+					continue
+				}
+				i++
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// matchesLines returns true if the lines are equal to the trimmed lines of a code fragment
+func matchesLines(lines, fragmentLines []string) bool {
+	for i, line := range lines {
+		if strings.TrimSpace(line) != fragmentLines[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// trimCodeFragment trims the space of each line of a code fragment and of the whole fragment
+func trimCodeFragment(codeFragment string) string {
+	var sb strings.Builder
+	for line := range strings.SplitSeq(codeFragment, "\n") {
+		_, _ = sb.WriteString(strings.TrimSpace(line))
+		_ = sb.WriteByte('\n')
+	}
+	return strings.TrimSpace(sb.String())
+}
+
 // buildFileModel scaffolds a single file
 func (Scaffold) buildFileModel(t Template, models map[string]*File) error {
 	// Set the template default values
@@ -183,7 +409,7 @@ func (Scaffold) buildFileModel(t Template, models map[string]*File) error {
 			return nil
 		case Error:
 			return ModelAlreadyExistsError{path}
-		case OverwriteFile:
+		case OverwriteFile, MergeFile:
 		default:
 			return UnknownIfExistsActionError{path, t.GetIfExistsAction()}
 		}
@@ -194,10 +420,13 @@ func (Scaffold) buildFileModel(t Template, models map[string]*File) error {
 		return err
 	}
 
+	mergeable, isMergeable := t.(Mergeable)
 	models[path] = &File{
 		Path:           path,
 		Contents:       string(b),
 		IfExistsAction: t.GetIfExistsAction(),
+		Mergeable:      isMergeable && mergeable.IsMergeable(),
+		scaffolded:     true,
 	}
 	return nil
 }
@@ -247,20 +476,7 @@ func (s Scaffold) updateFileModel(i Inserter, models map[string]*File) error {
 	m, err := s.loadPreviousModel(i, models)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			if withOptionalBehavior, ok := i.(HasIfNotExistsAction); ok {
-				switch withOptionalBehavior.GetIfNotExistsAction() {
-				case IgnoreFile:
-					log.Warn("skipping missing file", "file", i.GetPath())
-					log.Warn("the code fragments will not be inserted")
-					return nil
-				case ErrorIfNotExist:
-					return err
-				default:
-					return err
-				}
-			}
-			// If inserter doesn't implement HasIfNotExistsAction, return the original error
-			return err
+			return handleMissingFile(i, err)
 		}
 		return fmt.Errorf("failed to load previous model for %s: %w", i.GetPath(), err)
 	}
@@ -294,14 +510,58 @@ func (s Scaffold) updateFileModel(i Inserter, models map[string]*File) error {
 	}
 
 	m.Contents = string(formattedContent)
-	m.IfExistsAction = OverwriteFile
+	if m.IfExistsAction != MergeFile {
+		m.IfExistsAction = OverwriteFile
+	}
 	models[m.Path] = m
 	return nil
 }
 
+// updateGoFileModel updates a single Go file with the code fragments of a GoInserter
+func (s Scaffold) updateGoFileModel(i GoInserter, models map[string]*File) error {
+	m, err := s.loadPreviousModel(i, models)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return handleMissingFile(i, err)
+		}
+		return fmt.Errorf("failed to load previous model for %s: %w", i.GetPath(), err)
+	}
+
+	content, err := insertGoCodeFragments(m.Path, m.Contents, i.GetAnchors(), i.GetAnchoredCodeFragments())
+	if err != nil {
+		return err
+	}
+	if content == m.Contents {
+		return nil
+	}
+
+	formattedContent, err := imports.Process(i.GetPath(), []byte(content), nil)
+	if err != nil {
+		return fmt.Errorf("failed to process formatted content: %w", err)
+	}
+
+	m.Contents = string(formattedContent)
+	if m.IfExistsAction != MergeFile {
+		m.IfExistsAction = OverwriteFile
+	}
+	models[m.Path] = m
+	return nil
+}
+
+// handleMissingFile returns nil if the builder allows its file to be missing, or the provided error otherwise
+func handleMissingFile(b Builder, err error) error {
+	if withOptionalBehavior, ok := b.(HasIfNotExistsAction); ok &&
+		withOptionalBehavior.GetIfNotExistsAction() == IgnoreFile {
+		log.Warn("skipping missing file", "file", b.GetPath())
+		log.Warn("the code fragments will not be inserted")
+		return nil
+	}
+	return err
+}
+
 // loadPreviousModel gets the previous model from the models map or the actual file
-func (s Scaffold) loadPreviousModel(i Inserter, models map[string]*File) (*File, error) {
-	path := i.GetPath()
+func (s Scaffold) loadPreviousModel(b Builder, models map[string]*File) (*File, error) {
+	path := b.GetPath()
 
 	// Let's see if we already have a model for this file
 	if m, found := models[path]; found {
@@ -328,7 +588,7 @@ func (s Scaffold) loadPreviousModel(i Inserter, models map[string]*File) (*File,
 		case Error:
 			// Writing will result in an error, so we can return error now
 			return nil, FileAlreadyExistsError{path}
-		case OverwriteFile:
+		case OverwriteFile, MergeFile:
 			// Model has preference
 			return m, nil
 		default:
@@ -405,13 +665,7 @@ func filterExistingValues(content string, codeFragmentsMap CodeFragmentsMap) err
 // codeFragmentExists checks if the codeFragment exists in the content.
 func codeFragmentExists(content, codeFragment string) (exists bool, err error) {
 	// Trim space on each line in order to match different levels of indentation.
-	var sb strings.Builder
-	for line := range strings.SplitSeq(codeFragment, "\n") {
-		_, _ = sb.WriteString(strings.TrimSpace(line))
-		_ = sb.WriteByte('\n')
-	}
-
-	codeFragmentTrimmed := strings.TrimSpace(sb.String())
+	codeFragmentTrimmed := trimCodeFragment(codeFragment)
 	scanLines := 1 + strings.Count(codeFragmentTrimmed, "\n")
 	scanFunc := func(contentGroup string) bool {
 		if contentGroup == codeFragmentTrimmed {
@@ -509,6 +763,99 @@ func insertStrings(content string, codeFragmentsMap CodeFragmentsMap) ([]byte, e
 	return out.Bytes(), nil
 }
 
+// mergeFile writes a file scaffolded with MergeFile. If the file exists, the changes between the pristine
+// output of its previous scaffold and the new one are merged into it. The new output is then stored as the
+// pristine one. It returns the number of conflicts left in the file.
+func (s Scaffold) mergeFile(f *File) (int, error) {
+	merged := &File{Path: f.Path, Contents: f.Contents, IfExistsAction: OverwriteFile}
+
+	conflicts := 0
+	current, err := afero.ReadFile(s.fs, f.Path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		// There is nothing to merge, the file is created
+	case err != nil:
+		return 0, ReadFileError{err}
+	default:
+		pristine, pristineErr := afero.ReadFile(s.fs, filepath.Join(PristineDir, f.Path))
+		switch {
+		case errors.Is(pristineErr, os.ErrNotExist):
+			if string(current) != f.Contents {
+				log.Warn("No pristine output of the previous scaffold was found to merge the changes, "+
+					"so the file was overwritten", "file", f.Path)
+			}
+		case pristineErr != nil:
+			return 0, ReadFileError{pristineErr}
+		default:
+			merged.Contents, conflicts = merge3(string(pristine), string(current), f.Contents)
+			if conflicts > 0 {
+				log.Warn("Unable to merge the scaffold changes into the file", "file", f.Path, "conflicts", conflicts)
+			}
+		}
+	}
+
+	if err = s.writeFile(merged); err != nil {
+		return 0, err
+	}
+	if err = s.writePristineFile(f); err != nil {
+		return 0, err
+	}
+	return conflicts, nil
+}
+
+// writeMergeableFile writes a file that is not merged. The output of mergeable files is also stored as their
+// pristine output, unless the existing file was skipped, since it may not derive from this output.
+func (s Scaffold) writeMergeableFile(f *File) error {
+	previous, err := afero.ReadFile(s.fs, f.Path)
+	exists := err == nil
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return ReadFileError{err}
+	}
+	if err = s.writeFile(f); err != nil {
+		return err
+	}
+
+	switch {
+	case exists && f.IfExistsAction == SkipFile:
+		return nil
+	case f.Mergeable:
+		return s.writePristineFile(f)
+	case f.scaffolded:
+		return s.updatePristineFile(f, string(previous), exists)
+	default:
+		return nil
+	}
+}
+
+// updatePristineFile carries the changes that a template which is not mergeable made to a file into its
+// pristine output, if any, so that it remains the ancestor of the file. The pristine output is removed if
+// the changes conflict or if the file was created, since the file no longer derives from it.
+func (s Scaffold) updatePristineFile(f *File, previous string, existed bool) error {
+	pristine, err := afero.ReadFile(s.fs, filepath.Join(PristineDir, f.Path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return ReadFileError{err}
+	}
+
+	if existed {
+		if merged, conflicts := merge3(previous, string(pristine), f.Contents); conflicts == 0 {
+			return s.writePristineFile(&File{Path: f.Path, Contents: merged})
+		}
+	}
+	return s.removePristineFile(f.Path)
+}
+
+// writePristineFile stores the output of a file under PristineDir
+func (s Scaffold) writePristineFile(f *File) error {
+	return s.writeFile(&File{
+		Path:           filepath.Join(PristineDir, f.Path),
+		Contents:       f.Contents,
+		IfExistsAction: OverwriteFile,
+	})
+}
+
 func (s Scaffold) writeFile(f *File) error {
 	// Check if the file to write already exists
 	exists, err := afero.Exists(s.fs, f.Path)
@@ -521,6 +868,9 @@ func (s Scaffold) writeFile(f *File) error {
 			// By not returning, the file is written as if it didn't exist
 		case SkipFile:
 			// By returning nil, the file is not written but the process will carry on
+			if recorder, isRecording := s.fs.(*recordingFs); isRecording {
+				recorder.skip(f.Path)
+			}
 			return nil
 		case Error:
 			// By returning an error, the file is not written and the process will fail
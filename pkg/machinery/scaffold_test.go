@@ -528,6 +528,128 @@ func init() {
 			})
 		})
 
+		Context("merge when the file already exists", func() {
+			const (
+				base     = "a\nb\nc\n"
+				pristine = PristineDir + "/" + path
+			)
+
+			merge := func(content string) *fakeTemplate {
+				return &fakeTemplate{fakeBuilder: fakeBuilder{path: path, ifExistsAction: MergeFile}, body: content}
+			}
+			read := func(path string) string {
+				b, err := afero.ReadFile(s.fs, path)
+				Expect(err).NotTo(HaveOccurred())
+				return string(b)
+			}
+
+			It("should store the pristine output when the file is created", func() {
+				Expect(s.Execute(merge(base))).To(Succeed())
+				Expect(read(path)).To(Equal(base))
+				Expect(read(pristine)).To(Equal(base))
+			})
+
+			It("should overwrite the file if there is no pristine output", func() {
+				Expect(afero.WriteFile(s.fs, path, []byte("custom\n"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(merge(base))).To(Succeed())
+				Expect(read(path)).To(Equal(base))
+				Expect(read(pristine)).To(Equal(base))
+			})
+
+			It("should merge the changes of the scaffold into the file", func() {
+				Expect(s.Execute(merge(base))).To(Succeed())
+				Expect(afero.WriteFile(s.fs, path, []byte("a\nb\nc\ncustom\n"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(merge("A\nb\nc\n"))).To(Succeed())
+				Expect(read(path)).To(Equal("A\nb\nc\ncustom\n"))
+				Expect(read(pristine)).To(Equal("A\nb\nc\n"))
+			})
+
+			It("should leave conflict markers if the changes conflict", func() {
+				Expect(s.Execute(merge(base))).To(Succeed())
+				Expect(afero.WriteFile(s.fs, path, []byte("custom\nb\nc\n"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(merge("A\nb\nc\n"))).To(Succeed())
+				Expect(read(path)).To(Equal("<<<<<<< current\ncustom\n=======\nA\n>>>>>>> scaffold\nb\nc\n"))
+				Expect(read(pristine)).To(Equal("A\nb\nc\n"))
+			})
+
+			It("should store the pristine output of a mergeable file written without merging", func() {
+				created := &fakeMergeableTemplate{fakeTemplate: fakeTemplate{
+					fakeBuilder: fakeBuilder{path: path, ifExistsAction: Error}, body: base,
+				}}
+				Expect(s.Execute(created)).To(Succeed())
+				Expect(read(pristine)).To(Equal(base))
+				Expect(afero.WriteFile(s.fs, path, []byte("a\nb\nc\ncustom\n"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(merge("A\nb\nc\n"))).To(Succeed())
+				Expect(read(path)).To(Equal("A\nb\nc\ncustom\n"))
+			})
+
+			It("should not store the pristine output of a mergeable file that is skipped", func() {
+				Expect(afero.WriteFile(s.fs, path, []byte("custom\n"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(&fakeMergeableTemplate{fakeTemplate: fakeTemplate{
+					fakeBuilder: fakeBuilder{path: path, ifExistsAction: SkipFile}, body: base,
+				}})).To(Succeed())
+				Expect(read(path)).To(Equal("custom\n"))
+				exists, err := afero.Exists(s.fs, pristine)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exists).To(BeFalse())
+			})
+
+			overwrite := func(content string) *fakeTemplate {
+				return &fakeTemplate{fakeBuilder: fakeBuilder{path: path, ifExistsAction: OverwriteFile}, body: content}
+			}
+
+			It("should update the pristine output when a template that is not mergeable overwrites the file", func() {
+				Expect(s.Execute(merge(base))).To(Succeed())
+				Expect(afero.WriteFile(s.fs, path, []byte("a\nb\nc\ncustom\n"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(overwrite("A\nb\nc\ncustom\n"))).To(Succeed())
+				Expect(read(path)).To(Equal("A\nb\nc\ncustom\n"))
+				Expect(read(pristine)).To(Equal("A\nb\nc\n"))
+			})
+
+			It("should remove the pristine output if the changes of a template that is not mergeable conflict",
+				func() {
+					Expect(s.Execute(merge(base))).To(Succeed())
+					Expect(afero.WriteFile(s.fs, path, []byte("custom\nb\nc\n"), 0o666)).To(Succeed())
+
+					Expect(s.Execute(overwrite("other\nb\nc\n"))).To(Succeed())
+					Expect(read(path)).To(Equal("other\nb\nc\n"))
+					exists, err := afero.Exists(s.fs, pristine)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(exists).To(BeFalse())
+				})
+
+			It("should keep the pristine output when code fragments are inserted in the file", func() {
+				const withMarker = base + "#+kubebuilder:scaffold:-\n"
+				Expect(s.Execute(&fakeTemplate{
+					fakeBuilder: fakeBuilder{path: pathYaml, ifExistsAction: MergeFile}, body: withMarker,
+				})).To(Succeed())
+
+				Expect(s.Execute(&fakeInserter{
+					fakeBuilder:   fakeBuilder{path: pathYaml},
+					codeFragments: CodeFragmentsMap{NewMarkerFor(pathYaml, "-"): {"d\n"}},
+				})).To(Succeed())
+				Expect(read(pathYaml)).To(Equal(base + "d\n#+kubebuilder:scaffold:-\n"))
+				Expect(read(PristineDir + "/" + pathYaml)).To(Equal(withMarker))
+			})
+
+			It("should remove the pristine output with the file", func() {
+				Expect(s.Execute(merge(base))).To(Succeed())
+
+				Expect(s.Remove(merge(base))).To(Succeed())
+				for _, p := range []string{path, pristine} {
+					exists, err := afero.Exists(s.fs, p)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(exists).To(BeFalse())
+				}
+			})
+		})
+
 		Context("WithConfig option", func() {
 			It("should set repository in imports.LocalPrefix", func() {
 				cfg := cfgv3.New()
@@ -574,6 +696,92 @@ func init() {
 			})
 		})
 	})
+
+	Describe("Scaffold.Remove", func() {
+		const (
+			pathGo   = "filename.go"
+			pathYaml = "filename.yaml"
+		)
+
+		var s *Scaffold
+
+		BeforeEach(func() {
+			s = &Scaffold{fs: afero.NewMemMapFs()}
+		})
+
+		It("should delete the files of templates", func() {
+			Expect(afero.WriteFile(s.fs, pathYaml, []byte("content"), 0o666)).To(Succeed())
+
+			Expect(s.Remove(&fakeTemplate{fakeBuilder: fakeBuilder{path: pathYaml}})).To(Succeed())
+
+			exists, err := afero.Exists(s.fs, pathYaml)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should skip missing files", func() {
+			Expect(s.Remove(
+				&fakeTemplate{fakeBuilder: fakeBuilder{path: pathYaml}},
+				fakeInserter{
+					fakeBuilder:   fakeBuilder{path: pathGo},
+					codeFragments: CodeFragmentsMap{NewMarkerFor(pathGo, "-"): {"var a int\n"}},
+				},
+			)).To(Succeed())
+		})
+
+		It("should remove the inserted code fragments", func() {
+			Expect(afero.WriteFile(s.fs, pathGo, []byte(`package test
+
+var a int
+
+func b() {
+	println("b")
+}
+
+var c int
+
+// +kubebuilder:scaffold:-
+`), 0o666)).To(Succeed())
+
+			Expect(s.Remove(fakeInserter{
+				fakeBuilder: fakeBuilder{path: pathGo},
+				codeFragments: CodeFragmentsMap{
+					NewMarkerFor(pathGo, "-"): {"var a int\n", "func b() {\n\tprintln(\"b\")\n}\n"},
+				},
+			})).To(Succeed())
+
+			b, err := afero.ReadFile(s.fs, pathGo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(`package test
+
+var c int
+
+// +kubebuilder:scaffold:-
+`))
+		})
+
+		It("should keep the retained code fragments", func() {
+			Expect(afero.WriteFile(s.fs, pathYaml, []byte(`- a
+- b
+# +kubebuilder:scaffold:-
+`), 0o666)).To(Succeed())
+
+			marker := NewMarkerFor(pathYaml, "-")
+			Expect(s.Remove(fakeInserterWithRetained{
+				fakeInserter: fakeInserter{
+					fakeBuilder:   fakeBuilder{path: pathYaml},
+					codeFragments: CodeFragmentsMap{marker: {"- a\n", "- b\n"}},
+				},
+				retained: CodeFragmentsMap{marker: {"  - b\n"}},
+			})).To(Succeed())
+
+			b, err := afero.ReadFile(s.fs, pathYaml)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(`- b
+# +kubebuilder:scaffold:-
+`))
+		})
+	})
 })
 
 var _ Builder = fakeBuilder{}
@@ -644,6 +852,12 @@ func (f *fakeTemplate) SetTemplateDefaults() error {
 	return nil
 }
 
+// fakeMergeableTemplate is used to mock a Mergeable template in order to test Scaffold
+type fakeMergeableTemplate struct {
+	fakeTemplate
+	MergeableMixin
+}
+
 type fakeInserter struct {
 	fakeBuilder
 
@@ -682,3 +896,17 @@ type fakeInserterWithIfNotExists struct {
 func (f fakeInserterWithIfNotExists) GetIfNotExistsAction() IfNotExistsAction {
 	return f.ifNotExistsAction
 }
+
+var (
+	_ Inserter                 = fakeInserterWithRetained{}
+	_ HasRetainedCodeFragments = fakeInserterWithRetained{}
+)
+
+type fakeInserterWithRetained struct {
+	fakeInserter
+	retained CodeFragmentsMap
+}
+
+func (f fakeInserterWithRetained) GetRetainedCodeFragments() CodeFragmentsMap {
+	return f.retained
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+// RestoredFile describes a file that was restored by Transaction.Rollback
+type RestoredFile struct {
+	// Path is the file location
+	Path string
+
+	// Removed is true if the file did not exist before the transaction and was removed
+	Removed bool
+}
+
+// journalEntry holds the state of a file before it was first modified in a transaction
+type journalEntry struct {
+	existed bool
+	content []byte
+	mode    os.FileMode
+}
+
+// Transaction records the state of every file before it is modified through its Filesystem,
+// so that all the changes can be reverted if a later step fails.
+//
+// Changes are written to the underlying filesystem as they happen, so that tools reading
+// the files from disk see them. Changes done outside of the Filesystem, such as running
+// external commands, are not recorded and therefore not reverted.
+type Transaction struct {
+	base afero.Fs
+	fs   afero.Fs
+
+	mu sync.Mutex
+	// order keeps the journaled paths in the order they were first modified
+	order   []string
+	entries map[string]journalEntry
+	// createdDirs are the directories that did not exist when the transaction started
+	createdDirs map[string]struct{}
+	// skipped are the files that were not written because they already existed
+	skipped map[string]struct{}
+}
+
+// NewTransaction returns a new Transaction over the provided filesystem
+func NewTransaction(fs Filesystem) *Transaction {
+	t := &Transaction{
+		base:        fs.FS,
+		entries:     make(map[string]journalEntry),
+		createdDirs: make(map[string]struct{}),
+		skipped:     make(map[string]struct{}),
+	}
+	t.fs = &recordingFs{Fs: t.base, record: t.journal}
+
+	return t
+}
+
+// Filesystem returns the filesystem whose changes are recorded by the transaction
+func (t *Transaction) Filesystem() Filesystem {
+	return Filesystem{FS: t.fs}
+}
+
+// Snapshot saves the state of the provided files, so that they are restored by Rollback even if they are
+// modified outside of the Filesystem, for example by external commands. Files that do not exist are removed
+// by Rollback if they are created.
+func (t *Transaction) Snapshot(paths ...string) error {
+	for _, path := range paths {
+		if err := t.journal(path, opWrite); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journal saves the state of path before it is modified by the provided operation,
+// unless it was already saved. Removing a directory saves every file below it.
+func (t *Transaction) journal(path string, op fsOperation) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if op == opSkip {
+		t.skipped[path] = struct{}{}
+		return nil
+	}
+
+	info, err := t.base.Stat(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		switch op {
+		case opWrite:
+			if _, found := t.entries[path]; !found {
+				t.entries[path] = journalEntry{}
+				t.order = append(t.order, path)
+			}
+			t.journalMissingDirs(filepath.Dir(path))
+		case opMkdir:
+			t.journalMissingDirs(path)
+		case opRemove:
+			// Nothing to save
+		}
+		return nil
+	case err != nil:
+		return ExistsFileError{err}
+	case !info.IsDir():
+		return t.journalFile(path, info)
+	case op != opRemove:
+		// Writing to an existing directory does not modify the files inside it
+		return nil
+	default:
+		//nolint:wrapcheck
+		return afero.Walk(t.base, path, func(walkPath string, walkInfo iofs.FileInfo, walkErr error) error {
+			if walkErr != nil {
+				return fmt.Errorf("failed to walk %q: %w", walkPath, walkErr)
+			}
+			if walkInfo.IsDir() {
+				return nil
+			}
+			return t.journalFile(walkPath, walkInfo)
+		})
+	}
+}
+
+// journalMissingDirs saves that dir, and its parent directories, did not exist
+func (t *Transaction) journalMissingDirs(dir string) {
+	for ; dir != "." && dir != string(filepath.Separator); dir = filepath.Dir(dir) {
+		if _, err := t.base.Stat(dir); err == nil {
+			break
+		}
+		t.createdDirs[dir] = struct{}{}
+	}
+}
+
+// journalFile saves the content and permissions of an existing file
+func (t *Transaction) journalFile(path string, info os.FileInfo) error {
+	if _, found := t.entries[path]; found {
+		return nil
+	}
+
+	content, err := afero.ReadFile(t.base, path)
+	if err != nil {
+		return ReadFileError{err}
+	}
+
+	t.entries[path] = journalEntry{existed: true, content: content, mode: info.Mode().Perm()}
+	t.order = append(t.order, path)
+	return nil
+}
+
+// Rollback restores every file modified through the Filesystem to the state it had before the
+// transaction, removes the files and directories that were created, and returns the files that
+// had to be restored. It tries to restore as many files as possible, even if some of them fail.
+func (t *Transaction) Rollback() ([]RestoredFile, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var (
+		restored []RestoredFile
+		errs     []error
+	)
+
+	for _, path := range slices.Backward(t.order) {
+		entry := t.entries[path]
+
+		if !entry.existed {
+			exists, err := afero.Exists(t.base, path)
+			if err != nil {
+				errs = append(errs, ExistsFileError{err})
+				continue
+			}
+			if !exists {
+				continue
+			}
+			if err := t.base.RemoveAll(path); err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove %q: %w", path, err))
+				continue
+			}
+			restored = append(restored, RestoredFile{Path: path, Removed: true})
+			continue
+		}
+
+		current, err := afero.ReadFile(t.base, path)
+		if err == nil && string(current) == string(entry.content) {
+			// The permissions may still have changed
+			if chmodErr := t.base.Chmod(path, entry.mode); chmodErr != nil {
+				errs = append(errs, fmt.Errorf("failed to restore permissions of %q: %w", path, chmodErr))
+			}
+			continue
+		}
+
+		if err := t.base.MkdirAll(filepath.Dir(path), DefaultDirectoryPermission); err != nil {
+			errs = append(errs, CreateDirectoryError{err})
+			continue
+		}
+		if err := afero.WriteFile(t.base, path, entry.content, entry.mode); err != nil {
+			errs = append(errs, WriteFileError{err})
+			continue
+		}
+		if err := t.base.Chmod(path, entry.mode); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore permissions of %q: %w", path, err))
+		}
+		restored = append(restored, RestoredFile{Path: path})
+	}
+
+	// Remove the created directories, the deepest first, as long as they are empty
+	dirs := make([]string, 0, len(t.createdDirs))
+	for dir := range t.createdDirs {
+		dirs = append(dirs, dir)
+	}
+	slices.SortFunc(dirs, func(a, b string) int {
+		if c := strings.Count(b, string(filepath.Separator)) - strings.Count(a, string(filepath.Separator)); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	for _, dir := range dirs {
+		if empty, err := afero.IsEmpty(t.base, dir); err != nil || !empty {
+			continue
+		}
+		if err := t.base.Remove(dir); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove directory %q: %w", dir, err))
+		}
+	}
+
+	slices.Reverse(restored)
+	t.order = nil
+	t.entries = make(map[string]journalEntry)
+	t.createdDirs = make(map[string]struct{})
+	t.skipped = make(map[string]struct{})
+
+	return restored, errors.Join(errs...)
+}
+
+// Changes returns the files created, modified or removed through the Filesystem since the
+// transaction started, sorted by path. Files that were written with their previous content are omitted.
+func (t *Transaction) Changes() ([]FileChange, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	changes := make(map[string]FileChange)
+	for _, path := range t.order {
+		entry := t.entries[path]
+
+		info, err := t.base.Stat(path)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			if entry.existed {
+				changes[path] = FileChange{Path: path, Deleted: true, Before: string(entry.content)}
+			}
+			continue
+		case err != nil:
+			return nil, ExistsFileError{err}
+		}
+
+		// Directories are journaled as a whole when they are renamed
+		if info.IsDir() {
+			err = afero.Walk(t.base, path, func(walkPath string, walkInfo iofs.FileInfo, walkErr error) error {
+				if walkErr != nil {
+					return ReadFileError{walkErr}
+				}
+				if _, found := t.entries[walkPath]; found || walkInfo.IsDir() {
+					return nil
+				}
+				content, readErr := afero.ReadFile(t.base, walkPath)
+				if readErr != nil {
+					return ReadFileError{readErr}
+				}
+				changes[walkPath] = FileChange{Path: walkPath, Created: true, After: string(content)}
+				return nil
+			})
+			if err != nil {
+				return nil, err //nolint:wrapcheck
+			}
+			continue
+		}
+
+		content, err := afero.ReadFile(t.base, path)
+		if err != nil {
+			return nil, ReadFileError{err}
+		}
+		if entry.existed && string(content) == string(entry.content) {
+			continue
+		}
+		changes[path] = FileChange{
+			Path:    path,
+			Created: !entry.existed,
+			Before:  string(entry.content),
+			After:   string(content),
+		}
+	}
+
+	result := make([]FileChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, change)
+	}
+	slices.SortFunc(result, func(a, b FileChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return result, nil
+}
+
+// Skipped returns the files that were not written because they already existed, sorted by path.
+func (t *Transaction) Skipped() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return sortedPaths(t.skipped)
+}
+
+// sortedPaths returns the paths of the set, sorted
+func sortedPaths(set map[string]struct{}) []string {
+	paths := make([]string, 0, len(set))
+	for path := range set {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+)
+
+var _ = Describe("Transaction", func() {
+	const (
+		existingPath = "existing.txt"
+		removedPath  = "old/removed.txt"
+		createdPath  = "new/dir/created.txt"
+	)
+
+	var (
+		base        afero.Fs
+		transaction *Transaction
+	)
+
+	BeforeEach(func() {
+		base = afero.NewMemMapFs()
+		Expect(afero.WriteFile(base, existingPath, []byte("old\n"), DefaultFilePermission)).To(Succeed())
+		Expect(afero.WriteFile(base, removedPath, []byte("removed\n"), DefaultFilePermission)).To(Succeed())
+
+		transaction = NewTransaction(Filesystem{FS: base})
+	})
+
+	It("should write the changes to the underlying filesystem", func() {
+		s := NewScaffold(transaction.Filesystem())
+		Expect(s.Execute(
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: createdPath}, body: "created"},
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: existingPath, ifExistsAction: OverwriteFile}, body: "new"},
+		)).To(Succeed())
+
+		content, err := afero.ReadFile(base, createdPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("created"))
+
+		content, err = afero.ReadFile(base, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("new"))
+	})
+
+	It("should restore the previous state on rollback", func() {
+		fs := transaction.Filesystem().FS
+		s := NewScaffold(transaction.Filesystem())
+		Expect(s.Execute(
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: createdPath}, body: "created"},
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: existingPath, ifExistsAction: OverwriteFile}, body: "new"},
+		)).To(Succeed())
+		Expect(fs.RemoveAll("old")).To(Succeed())
+
+		restored, err := transaction.Rollback()
+		Expect(err).NotTo(HaveOccurred())
+		// Scaffold.Execute writes the files in no particular order
+		Expect(restored).To(ConsistOf(
+			RestoredFile{Path: createdPath, Removed: true},
+			RestoredFile{Path: existingPath},
+			RestoredFile{Path: removedPath},
+		))
+
+		content, err := afero.ReadFile(base, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("old\n"))
+
+		content, err = afero.ReadFile(base, removedPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("removed\n"))
+
+		exists, err := afero.Exists(base, "new")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should restore the snapshot files changed outside of the transaction on rollback", func() {
+		Expect(transaction.Snapshot(existingPath, createdPath)).To(Succeed())
+		Expect(afero.WriteFile(base, existingPath, []byte("external\n"), DefaultFilePermission)).To(Succeed())
+		Expect(afero.WriteFile(base, createdPath, []byte("external\n"), DefaultFilePermission)).To(Succeed())
+
+		restored, err := transaction.Rollback()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored).To(ConsistOf(
+			RestoredFile{Path: existingPath},
+			RestoredFile{Path: createdPath, Removed: true},
+		))
+
+		content, err := afero.ReadFile(base, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("old\n"))
+
+		exists, err := afero.Exists(base, createdPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should list the changed and skipped files sorted by path", func() {
+		fs := transaction.Filesystem().FS
+		s := NewScaffold(transaction.Filesystem())
+		Expect(s.Execute(
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: createdPath}, body: "created"},
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: existingPath, ifExistsAction: SkipFile}, body: "new"},
+		)).To(Succeed())
+		Expect(fs.Rename(removedPath, "old/renamed.txt")).To(Succeed())
+
+		changes, err := transaction.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: createdPath, Created: true, After: "created"},
+			{Path: removedPath, Deleted: true, Before: "removed\n"},
+			{Path: "old/renamed.txt", Created: true, After: "removed\n"},
+		}))
+		Expect(transaction.Skipped()).To(Equal([]string{existingPath}))
+	})
+
+	It("should not report files written with the same content", func() {
+		fs := transaction.Filesystem().FS
+		Expect(afero.WriteFile(fs, existingPath, []byte("old\n"), DefaultFilePermission)).To(Succeed())
+
+		changes, err := transaction.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		restored, err := transaction.Rollback()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored).To(BeEmpty())
+	})
+})
@@ -16,7 +16,21 @@ limitations under the License.
 
 package external
 
-import "sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+const (
+	// APIVersionV1Alpha1 is the initial version of the protocol. The whole project is sent in the universe
+	// of every request, and plugins can only create or overwrite files.
+	APIVersionV1Alpha1 = "v1alpha1"
+
+	// APIVersionV2Alpha1 allows plugins to select the files sent in the universe, to request the content of
+	// other files on demand, and to delete, rename or change the mode of files.
+	// Plugins opt in by setting it as the APIVersion of their response to the `flags` command.
+	APIVersionV2Alpha1 = "v2alpha1"
+)
 
 // PluginRequest contains all information kubebuilder received from the CLI
 // and plugins executed before it.
@@ -44,6 +58,11 @@ type PluginRequest struct {
 	// Config contains the PROJECT file config. This field may be empty if the
 	// project is being initialized and the PROJECT file has not been created yet.
 	Config map[string]any `json:"config,omitempty"`
+
+	// Files contains the paths of every file of the project that is not excluded by the UniverseFilter,
+	// so that plugins can request their content through FileRequests.
+	// It is only set for the v2alpha1 APIVersion.
+	Files []string `json:"files,omitempty"`
 }
 
 // PluginResponse is returned to kubebuilder by the plugin and contains all files
@@ -72,6 +91,81 @@ type PluginResponse struct {
 	// Flags contains the plugin specific flags that the plugin returns to Kubebuilder when it receives
 	// a request for a list of supported flags from Kubebuilder
 	Flags []Flag `json:"flags,omitempty"`
+
+	// UniverseFilter selects the files whose content is sent in the universe of the requests for the subcommand.
+	// It is returned in response to the `flags` command and only used for the v2alpha1 APIVersion.
+	UniverseFilter *UniverseFilter `json:"universeFilter,omitempty"`
+
+	// FileRequests contains the paths, or glob patterns, of the files whose content the plugin needs and that
+	// were not sent in the universe. Kubebuilder sends the request again with those files added to the universe,
+	// ignoring the rest of the response. It is only used for the v2alpha1 APIVersion.
+	FileRequests []string `json:"fileRequests,omitempty"`
+
+	// Operations contains the files to delete, rename or change the mode of. They are applied in order,
+	// after the files in Universe are written. It is only used for the v2alpha1 APIVersion.
+	Operations []FileOperation `json:"operations,omitempty"`
+
+	// RPC is set by plugins that support the persistent mode. After receiving it, Kubebuilder starts the
+	// plugin once more with the `--rpc` argument and sends the next requests of the CLI invocation,
+	// including the pre-scaffold and post-scaffold hooks, as JSON-RPC 2.0 messages over its stdin and stdout.
+	RPC bool `json:"rpc,omitempty"`
+
+	// ConfigPatch contains the changes the plugin makes to the PROJECT file. It is validated against
+	// the project configuration schema and the whole patch is rejected if any part of it is invalid.
+	ConfigPatch *ConfigPatch `json:"configPatch,omitempty"`
+}
+
+// ConfigPatch describes the changes an external plugin makes to the PROJECT file
+type ConfigPatch struct {
+	// Resources are added to the PROJECT file. If a resource with the same GVK is already tracked,
+	// it is updated with the provided fields, the same way in-tree plugins update it.
+	// The plural may be omitted if it is the regular plural of the kind.
+	Resources []resource.Resource `json:"resources,omitempty"`
+
+	// PluginConfig replaces the configuration stored under the key of the plugin, such as
+	// "myplugin.example.com/v1", in the plugins field of the PROJECT file.
+	PluginConfig map[string]any `json:"pluginConfig,omitempty"`
+}
+
+// UniverseFilter selects files with glob patterns matched against their slash-separated path relative to
+// the project root. Patterns follow path.Match, and a "**" element matches any number of directories.
+type UniverseFilter struct {
+	// Include are the patterns of the files whose content is sent in the universe.
+	// If empty, no content is sent and the plugin must request the files it needs.
+	Include []string `json:"include,omitempty"`
+
+	// Exclude are the patterns of the files that are never sent, nor listed in the request Files,
+	// even if they match an Include pattern. A directory that matches is skipped with all its content.
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// FileOperationType is the kind of change applied by a FileOperation
+type FileOperationType string
+
+const (
+	// FileOperationDelete removes the file, or the directory with all its content, at Path
+	FileOperationDelete FileOperationType = "delete"
+
+	// FileOperationRename moves the file, or the directory, at Path to NewPath
+	FileOperationRename FileOperationType = "rename"
+
+	// FileOperationChmod sets the permissions of the file at Path to Mode
+	FileOperationChmod FileOperationType = "chmod"
+)
+
+// FileOperation is a change to an existing file that cannot be expressed through the universe
+type FileOperation struct {
+	// Type is the kind of change to apply.
+	Type FileOperationType `json:"type"`
+
+	// Path is the location of the file relative to the project root.
+	Path string `json:"path"`
+
+	// NewPath is the new location of the file relative to the project root, for rename operations.
+	NewPath string `json:"newPath,omitempty"`
+
+	// Mode is the octal representation of the new permissions, e.g. "0755", for chmod operations.
+	Mode string `json:"mode,omitempty"`
 }
 
 // Flag is meant to represent a CLI flag that is used by Kubebuilder to define flags that are parsed
@@ -82,15 +176,55 @@ type Flag struct {
 	Name string
 
 	// Type is the type of flag that should be created. The types that
-	// Kubebuilder supports are: string, bool, int, and float.
+	// Kubebuilder supports are: string, bool, int, float, duration,
+	// stringSlice, and stringArray.
 	// any value other than the supported will be defaulted to be a string
 	Type string
 
 	// Default is the default value that should be used for a flag.
 	// Kubebuilder will attempt to convert this value to the defined
-	// type for this flag.
+	// type for this flag. The values of stringSlice and stringArray
+	// flags are separated by commas.
 	Default string
 
 	// Usage is a description of the flag and when/why/what it is used for.
 	Usage string
+
+	// Options are the values the flag accepts, making it an enum. For stringSlice
+	// and stringArray flags, each of the values must be one of them.
+	// They are also offered in shell completion.
+	Options []string
+
+	// Required makes Kubebuilder fail before calling the plugin if the flag is not set.
+	Required bool
+
+	// Deprecated is the message shown when the flag is used. Deprecated flags
+	// are hidden from the help.
+	Deprecated string
+
+	// Hidden hides the flag from the help.
+	Hidden bool
+}
+
+// ManifestFileName is the name of the optional manifest of an external plugin, located next to its executable.
+const ManifestFileName = "plugin.yaml"
+
+// Manifest describes an external plugin. Every field is optional.
+type Manifest struct {
+	// Description is a short description of the plugin shown in the help.
+	Description string `json:"description,omitempty"`
+
+	// ProjectVersions are the project versions supported by the plugin. Defaults to "3".
+	ProjectVersions []string `json:"projectVersions,omitempty"`
+
+	// Subcommands are the subcommands implemented by the plugin: init, create api, create webhook and edit.
+	// The plugin is not offered for the other subcommands. Defaults to all of them.
+	Subcommands []string `json:"subcommands,omitempty"`
+
+	// Deprecation is the warning shown when the plugin is used. Deprecated plugins are hidden from the help.
+	Deprecation string `json:"deprecation,omitempty"`
+
+	// MinCLIVersion is the minimum version of Kubebuilder the plugin works with, such as "v4.6.0".
+	// The plugin is not available in older versions.
+	MinCLIVersion string `json:"minCLIVersion,omitempty"`
 }
@@ -137,6 +137,55 @@ func SupportsVersion(p Plugin, projectVersion config.Version) bool {
 	return false
 }
 
+// AsInit returns the plugin as an Init plugin if it provides an `init` subcommand.
+func AsInit(p Plugin) (Init, bool) {
+	return providing[Init](p, InitSubcommandName)
+}
+
+// AsCreateAPI returns the plugin as a CreateAPI plugin if it provides a `create api` subcommand.
+func AsCreateAPI(p Plugin) (CreateAPI, bool) {
+	return providing[CreateAPI](p, CreateAPISubcommandName)
+}
+
+// AsCreateWebhook returns the plugin as a CreateWebhook plugin if it provides a `create webhook` subcommand.
+func AsCreateWebhook(p Plugin) (CreateWebhook, bool) {
+	return providing[CreateWebhook](p, CreateWebhookSubcommandName)
+}
+
+// AsEdit returns the plugin as an Edit plugin if it provides an `edit` subcommand.
+func AsEdit(p Plugin) (Edit, bool) {
+	return providing[Edit](p, EditSubcommandName)
+}
+
+// AsEditResource returns the plugin as an EditResource plugin if it provides an `edit resource` subcommand.
+func AsEditResource(p Plugin) (EditResource, bool) {
+	return providing[EditResource](p, EditResourceSubcommandName)
+}
+
+// AsDeleteAPI returns the plugin as a DeleteAPI plugin if it provides a `delete api` subcommand.
+func AsDeleteAPI(p Plugin) (DeleteAPI, bool) {
+	return providing[DeleteAPI](p, DeleteAPISubcommandName)
+}
+
+// AsDeleteWebhook returns the plugin as a DeleteWebhook plugin if it provides a `delete webhook` subcommand.
+func AsDeleteWebhook(p Plugin) (DeleteWebhook, bool) {
+	return providing[DeleteWebhook](p, DeleteWebhookSubcommandName)
+}
+
+// providing returns the plugin as T if it implements it and, for plugins that implement
+// SubcommandSelector, if it also provides the subcommand.
+func providing[T Plugin](p Plugin, name string) (T, bool) {
+	var zero T
+	t, ok := p.(T)
+	if !ok {
+		return zero, false
+	}
+	if selector, isSelector := p.(SubcommandSelector); isSelector && !selector.ProvidesSubcommand(name) {
+		return zero, false
+	}
+	return t, true
+}
+
 // CommonSupportedProjectVersions returns the projects versions that are supported by all the provided Plugins
 func CommonSupportedProjectVersions(plugins ...Plugin) []config.Version {
 	// Count how many times each supported project version appears
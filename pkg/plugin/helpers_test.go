@@ -273,3 +273,33 @@ var _ = Describe("GetPluginKeyForConfig", func() {
 		Expect(GetPluginKeyForConfig(pluginChain, plugin)).To(Equal("deploy-image.first.example.com/v1-alpha"))
 	})
 })
+
+type mockEditPlugin struct {
+	mockPlugin
+	subcommands []string
+}
+
+func (mockEditPlugin) GetEditSubcommand() EditSubcommand { return nil }
+
+func (p mockEditPlugin) ProvidesSubcommand(name string) bool {
+	return slices.Contains(p.subcommands, name)
+}
+
+var _ = Describe("AsEdit", func() {
+	It("should return plugins implementing Edit", func() {
+		p := mockEditPlugin{subcommands: []string{EditSubcommandName}}
+		edit, ok := AsEdit(p)
+		Expect(ok).To(BeTrue())
+		Expect(edit).To(Equal(p))
+	})
+
+	It("should not return plugins that do not provide the edit subcommand", func() {
+		_, ok := AsEdit(mockEditPlugin{subcommands: []string{InitSubcommandName}})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should not return plugins that do not implement Edit", func() {
+		_, ok := AsEdit(mockPlugin{})
+		Expect(ok).To(BeFalse())
+	})
+})
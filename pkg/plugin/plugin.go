@@ -18,6 +18,7 @@ package plugin
 
 import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 )
 
 // Plugin is an interface that defines the common base for all plugins.
@@ -48,6 +49,35 @@ type Describable interface {
 	Description() string
 }
 
+// Diagnosable is an optional interface for plugins that can check a project for drift between the
+// project configuration and the files they scaffolded. It is used by the `doctor` command.
+type Diagnosable interface {
+	// Diagnose returns the problems found in the project. It must not modify the filesystem.
+	Diagnose(cfg config.Config, fs machinery.Filesystem) ([]Diagnostic, error)
+}
+
+// Diagnostic is a problem found in a project by a Diagnosable plugin.
+type Diagnostic struct {
+	// Check identifies the kind of problem, such as "controller-registration".
+	Check string
+	// Message describes the problem.
+	Message string
+	// Hint explains how to fix the problem.
+	Hint string
+	// Fix applies a safe fix for the problem to the provided filesystem.
+	// It is nil when the problem has to be fixed by hand.
+	Fix func(fs machinery.Filesystem) error
+}
+
+// Attached is an optional interface for plugins that are added to a project with `edit` but need to keep
+// their scaffolds up to date afterwards. For the projects that track their configuration in the PROJECT file,
+// their subcommands run after the ones of the plugin chain, even though they are not part of it.
+type Attached interface {
+	// RunsAttached returns true if the plugin runs the subcommand, identified by its name in the
+	// command line, after the plugin chain of the projects that track its configuration.
+	RunsAttached(name string) bool
+}
+
 // Init is an interface for plugins that provide an `init` subcommand.
 type Init interface {
 	Plugin
@@ -76,6 +106,27 @@ type Edit interface {
 	GetEditSubcommand() EditSubcommand
 }
 
+// EditResource is an interface for plugins that provide an `edit resource` subcommand.
+type EditResource interface {
+	Plugin
+	// GetEditResourceSubcommand returns the underlying EditResourceSubcommand interface.
+	GetEditResourceSubcommand() EditResourceSubcommand
+}
+
+// DeleteAPI is an interface for plugins that provide a `delete api` subcommand.
+type DeleteAPI interface {
+	Plugin
+	// GetDeleteAPISubcommand returns the underlying DeleteAPISubcommand interface.
+	GetDeleteAPISubcommand() DeleteAPISubcommand
+}
+
+// DeleteWebhook is an interface for plugins that provide a `delete webhook` subcommand.
+type DeleteWebhook interface {
+	Plugin
+	// GetDeleteWebhookSubcommand returns the underlying DeleteWebhookSubcommand interface.
+	GetDeleteWebhookSubcommand() DeleteWebhookSubcommand
+}
+
 // Full is an interface for plugins that provide `init`, `create api`, `create webhook` and `edit` subcommands.
 type Full interface {
 	Init
@@ -84,6 +135,14 @@ type Full interface {
 	Edit
 }
 
+// SubcommandSelector is an optional interface for plugins that implement the interfaces of every subcommand
+// but only provide some of them, such as external plugins.
+type SubcommandSelector interface {
+	// ProvidesSubcommand returns true if the plugin provides the subcommand, identified by its name
+	// in the command line: `init`, `create api`, `create webhook` or `edit`.
+	ProvidesSubcommand(name string) bool
+}
+
 // Bundle allows to group plugins under a single key.
 type Bundle interface {
 	Plugin
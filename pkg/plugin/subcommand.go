@@ -30,6 +30,21 @@ type UpdatesMetadata interface {
 	UpdateMetadata(CLIMetadata, *SubcommandMetadata)
 }
 
+// Names of the subcommands that plugins can provide, as typed in the command line.
+const (
+	InitSubcommandName          = "init"
+	CreateAPISubcommandName     = "create api"
+	CreateWebhookSubcommandName = "create webhook"
+	EditSubcommandName          = "edit"
+	EditResourceSubcommandName  = "edit resource"
+	DeleteAPISubcommandName     = "delete api"
+	DeleteWebhookSubcommandName = "delete webhook"
+)
+
+// FlagValuesAnnotation is the annotation of the flags bound by subcommands that lists
+// the values they accept, which are offered in shell completion.
+const FlagValuesAnnotation = "kubebuilder.io/flag-values"
+
 // HasFlags is an interface that implements the optional bind flags method.
 type HasFlags interface {
 	// BindFlags binds flags to the CLI subcommand.
@@ -92,3 +107,20 @@ type CreateWebhookSubcommand interface {
 type EditSubcommand interface {
 	Subcommand
 }
+
+// EditResourceSubcommand is an interface that represents an `edit resource` subcommand.
+type EditResourceSubcommand interface {
+	Subcommand
+}
+
+// DeleteAPISubcommand is an interface that represents a `delete api` subcommand.
+type DeleteAPISubcommand interface {
+	Subcommand
+	RequiresResource
+}
+
+// DeleteWebhookSubcommand is an interface that represents a `delete webhook` subcommand.
+type DeleteWebhookSubcommand interface {
+	Subcommand
+	RequiresResource
+}
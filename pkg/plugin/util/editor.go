@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// diskEditor is used by the package functions, which edit the files on disk
+var diskEditor = FileEditor{fs: afero.NewOsFs()}
+
+// FileEditor edits files through the filesystem it is created with. Scaffolders must use it with the
+// filesystem injected by the CLI, instead of the package functions with the same names, so that their
+// changes are kept in memory by --dry-run and restored when the command fails.
+type FileEditor struct {
+	fs afero.Fs
+}
+
+// NewFileEditor returns a FileEditor that reads and writes the files through fs
+func NewFileEditor(fs machinery.Filesystem) FileEditor {
+	return FileEditor{fs: fs.FS}
+}
+
+// readFile returns the content of the file
+func (e FileEditor) readFile(filename string) ([]byte, error) {
+	content, err := afero.ReadFile(e.fs, filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", filename, err)
+	}
+	return content, nil
+}
+
+// InsertCode searches target content in the file and insert `toInsert` after the target.
+func (e FileEditor) InsertCode(filename, target, code string) error {
+	contents, err := e.readFile(filename)
+	if err != nil {
+		return err
+	}
+	idx := strings.Index(string(contents), target)
+	if idx == -1 {
+		return fmt.Errorf("string %s not found in %s", target, string(contents))
+	}
+	out := string(contents[:idx+len(target)]) + code + string(contents[idx+len(target):])
+	if errWriteFile := afero.WriteFile(e.fs, filename, []byte(out), 0o644); errWriteFile != nil {
+		return fmt.Errorf("failed to write file %q: %w", filename, errWriteFile)
+	}
+
+	return nil
+}
+
+// InsertCodeIfNotExist insert code if it does not already exist
+func (e FileEditor) InsertCodeIfNotExist(filename, target, code string) error {
+	contents, err := e.readFile(filename)
+	if err != nil {
+		return err
+	}
+
+	idx := strings.Index(string(contents), code)
+	if idx != -1 {
+		return nil
+	}
+
+	return e.InsertCode(filename, target, code)
+}
+
+// AppendCodeIfNotExist checks if the code does not already exist in the file, and if not, appends it to the end.
+func (e FileEditor) AppendCodeIfNotExist(filename, code string) error {
+	contents, err := e.readFile(filename)
+	if err != nil {
+		return err
+	}
+
+	if strings.Contains(string(contents), code) {
+		return nil // Code already exists, no need to append.
+	}
+
+	return e.AppendCodeAtTheEnd(filename, code)
+}
+
+// AppendCodeAtTheEnd appends the given code at the end of the file.
+func (e FileEditor) AppendCodeAtTheEnd(filename, code string) error {
+	f, err := e.fs.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open file %q: %w", filename, err)
+	}
+	defer func() {
+		if err = f.Close(); err != nil {
+			return
+		}
+	}()
+
+	if _, errWriteString := f.WriteString(code); errWriteString != nil {
+		return fmt.Errorf("failed to write to file %q: %w", filename, errWriteString)
+	}
+
+	return nil
+}
+
+// UncommentCode searches for target in the file and remove the comment prefix
+// of the target content. The target content may span multiple lines.
+func (e FileEditor) UncommentCode(filename, target, prefix string) error {
+	content, err := e.readFile(filename)
+	if err != nil {
+		return err
+	}
+	strContent := string(content)
+
+	idx := strings.Index(strContent, target)
+	if idx < 0 {
+		return fmt.Errorf("unable to find the code %q to be uncommented", target)
+	}
+
+	out := new(bytes.Buffer)
+	_, err = out.Write(content[:idx])
+	if err != nil {
+		return fmt.Errorf("failed to write to file %q: %w", filename, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewBufferString(target))
+	if !scanner.Scan() {
+		return nil
+	}
+	for {
+		if _, err = out.WriteString(strings.TrimPrefix(scanner.Text(), prefix)); err != nil {
+			return fmt.Errorf("failed to write to file %q: %w", filename, err)
+		}
+		// Avoid writing a newline in case the previous line was the last in target.
+		if !scanner.Scan() {
+			break
+		}
+		if _, err = out.WriteString("\n"); err != nil {
+			return fmt.Errorf("failed to write to file %q: %w", filename, err)
+		}
+	}
+
+	if _, err = out.Write(content[idx+len(target):]); err != nil {
+		return fmt.Errorf("failed to write to file %q: %w", filename, err)
+	}
+	if err = afero.WriteFile(e.fs, filename, out.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write file %q: %w", filename, err)
+	}
+
+	return nil
+}
+
+// CommentCode searches for target in the file and adds the comment prefix
+// to the target content. The target content may span multiple lines.
+func (e FileEditor) CommentCode(filename, target, prefix string) error {
+	// Read the file content
+	content, err := e.readFile(filename)
+	if err != nil {
+		return err
+	}
+	strContent := string(content)
+
+	// Find the target code to be commented
+	idx := strings.Index(strContent, target)
+	if idx < 0 {
+		return fmt.Errorf("failed to find the code %q to be commented", target)
+	}
+
+	// Create a buffer to hold the modified content
+	out := new(bytes.Buffer)
+	if _, err = out.Write(content[:idx]); err != nil {
+		return fmt.Errorf("failed to write to file %q: %w", filename, err)
+	}
+
+	// Add the comment prefix to each line of the target code
+	scanner := bufio.NewScanner(bytes.NewBufferString(target))
+	for scanner.Scan() {
+		if _, err = out.WriteString(prefix + scanner.Text() + "\n"); err != nil {
+			return fmt.Errorf("failed to write to file %q: %w", filename, err)
+		}
+	}
+
+	// Write the rest of the file content
+	if _, err = out.Write(content[idx+len(target):]); err != nil {
+		return fmt.Errorf("failed to write to file %q: %w", filename, err)
+	}
+
+	// Write the modified content back to the file
+	if err = afero.WriteFile(e.fs, filename, out.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write file %q: %w", filename, err)
+	}
+
+	return nil
+}
+
+// ReplaceInFile replaces all instances of old with new in the file at path.
+func (e FileEditor) ReplaceInFile(path, oldValue, newValue string) error {
+	info, err := e.fs.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat file %q: %w", path, err)
+	}
+	b, err := e.readFile(path)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(string(b), oldValue) {
+		return errors.New("unable to find the content to be replaced")
+	}
+	s := strings.ReplaceAll(string(b), oldValue, newValue)
+	if err = afero.WriteFile(e.fs, path, []byte(s), info.Mode()); err != nil {
+		return fmt.Errorf("failed to write file %q: %w", path, err)
+	}
+	return nil
+}
+
+// ReplaceRegexInFile finds all strings that match `match` and replaces them
+// with `replace` in the file at path.
+func (e FileEditor) ReplaceRegexInFile(path, match, replace string) error {
+	matcher, err := regexp.Compile(match)
+	if err != nil {
+		return fmt.Errorf("failed to compile regular expression %q: %w", match, err)
+	}
+	info, err := e.fs.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat file %q: %w", path, err)
+	}
+	b, err := e.readFile(path)
+	if err != nil {
+		return err
+	}
+	s := matcher.ReplaceAllString(string(b), replace)
+	if s == string(b) {
+		return errors.New("unable to find the content to be replaced")
+	}
+
+	if err = afero.WriteFile(e.fs, path, []byte(s), info.Mode()); err != nil {
+		return fmt.Errorf("failed to write file %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// HasFileContentWith check if given `text` can be found in file
+func (e FileEditor) HasFileContentWith(path, text string) (bool, error) {
+	contents, err := e.readFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.Contains(string(contents), text), nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// ResourceRenamer rewrites the references to a resource that is renamed or moved to another group,
+// version or kind in the content of the files scaffolded for it.
+type ResourceRenamer struct {
+	from resource.Resource
+	to   resource.Resource
+}
+
+// NewResourceRenamer returns a ResourceRenamer for the resource from renamed as the resource to.
+func NewResourceRenamer(from, to resource.Resource) ResourceRenamer {
+	return ResourceRenamer{from: from, to: to}
+}
+
+// Text rewrites the references to the resource in plain text, such as manifests, comments, markers
+// and string literals. Only the forms used by the scaffolds are replaced: qualified group versions,
+// qualified groups, plurals, kinds and the lowercase kinds used in names and paths. The custom webhook
+// paths of the resource are kept, unless they are the default ones.
+func (r ResourceRenamer) Text(content string) string {
+	custom := r.customWebhookPaths()
+	for i, path := range custom {
+		content = strings.ReplaceAll(content, path, webhookPathPlaceholder(i))
+	}
+	content = r.text(content)
+	for i, path := range custom {
+		content = strings.ReplaceAll(content, webhookPathPlaceholder(i), path)
+	}
+	return content
+}
+
+// text rewrites the references to the resource in plain text.
+func (r ResourceRenamer) text(content string) string {
+	from, to := r.from, r.to
+	fromLower, toLower := strings.ToLower(from.Kind), strings.ToLower(to.Kind)
+
+	content = strings.ReplaceAll(content,
+		from.QualifiedGroup()+"/"+from.Version, to.QualifiedGroup()+"/"+to.Version)
+	content = strings.ReplaceAll(content, from.QualifiedGroup(), to.QualifiedGroup())
+
+	// Webhook paths and names, such as /mutate-crew-testproject-org-v1-captain and mcaptain-v1.kb.io
+	content = strings.ReplaceAll(content,
+		fmt.Sprintf("-%s-%s-%s", dashed(from.QualifiedGroup()), from.Version, fromLower),
+		fmt.Sprintf("-%s-%s-%s", dashed(to.QualifiedGroup()), to.Version, toLower))
+	for _, prefix := range []string{"m", "v"} {
+		content = strings.ReplaceAll(content,
+			fmt.Sprintf("%s%s-%s.kb.io", prefix, fromLower, from.Version),
+			fmt.Sprintf("%s%s-%s.kb.io", prefix, toLower, to.Version))
+	}
+	content = r.replaceWebhookVersions(content)
+
+	// Names prefixed with the group in multi-group layouts, such as crew-captain or crew_captain
+	if from.Group != "" && to.Group != "" {
+		for _, sep := range []string{"-", "_"} {
+			content = replaceBounded(content, from.Group+sep+fromLower, to.Group+sep+toLower, isLowerAlphanumeric)
+		}
+	}
+
+	content = replaceBounded(content, from.Plural, to.Plural, isLowerAlphanumeric)
+	content = replaceKind(content, from.Kind, to.Kind)
+	return replaceBounded(content, fromLower, toLower, isLowerAlphanumeric)
+}
+
+// replaceWebhookVersions replaces the version of the webhook markers of the resource.
+func (r ResourceRenamer) replaceWebhookVersions(content string) string {
+	if r.from.Version == r.to.Version {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.Contains(line, "+kubebuilder:webhook:") &&
+			strings.Contains(line, "groups="+r.to.QualifiedGroup()+",") {
+			lines[i] = replaceBounded(line, "versions="+r.from.Version, "versions="+r.to.Version, isLowerAlphanumeric)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// customWebhookPaths returns the webhook paths of the resource that are not the default ones.
+func (r ResourceRenamer) customWebhookPaths() []string {
+	if r.from.Webhooks == nil {
+		return nil
+	}
+
+	var paths []string
+	if path := r.from.Webhooks.DefaultingPath; path != "" && path != defaultWebhookPath(r.from, "mutate") {
+		paths = append(paths, path)
+	}
+	if path := r.from.Webhooks.ValidationPath; path != "" && path != defaultWebhookPath(r.from, "validate") {
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// defaultWebhookPath returns the path scaffolded for a webhook of the resource, such as
+// /mutate-crew-testproject-org-v1-captain.
+func defaultWebhookPath(res resource.Resource, prefix string) string {
+	group := dashed(res.QualifiedGroup())
+	if res.Core && res.QualifiedGroup() == "core" {
+		group = ""
+	}
+	return fmt.Sprintf("/%s-%s-%s-%s", prefix, group, res.Version, strings.ToLower(res.Kind))
+}
+
+// webhookPathPlaceholder returns the text that replaces a custom webhook path while renaming.
+func webhookPathPlaceholder(i int) string {
+	return fmt.Sprintf("\x00webhook-path-%d\x00", i)
+}
+
+// dashed returns the qualified group as used in webhook paths.
+func dashed(qualifiedGroup string) string {
+	return strings.ReplaceAll(qualifiedGroup, ".", "-")
+}
+
+// replaceKind replaces the occurrences of kind that are not followed by a lowercase letter, which
+// matches the kind in identifiers such as CaptainList or SetupCaptainWebhookWithManager but not
+// another kind such as Captains.
+func replaceKind(content, from, to string) string {
+	if from == to {
+		return content
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(content, from)
+		if i < 0 {
+			b.WriteString(content)
+			return b.String()
+		}
+		end := i + len(from)
+		b.WriteString(content[:i])
+		if end < len(content) && isLower(content[end]) {
+			b.WriteString(from)
+		} else {
+			b.WriteString(to)
+		}
+		content = content[end:]
+	}
+}
+
+// replaceBounded replaces the occurrences of from that are not preceded or followed by a
+// character for which isWord returns true.
+func replaceBounded(content, from, to string, isWord func(byte) bool) string {
+	if from == to || from == "" {
+		return content
+	}
+
+	var b strings.Builder
+	for offset := 0; ; {
+		i := strings.Index(content[offset:], from)
+		if i < 0 {
+			b.WriteString(content)
+			return b.String()
+		}
+		start, end := offset+i, offset+i+len(from)
+		if (start > 0 && isWord(content[start-1])) || (end < len(content) && isWord(content[end])) {
+			offset = end
+			continue
+		}
+		b.WriteString(content[:start])
+		b.WriteString(to)
+		content, offset = content[end:], 0
+	}
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isLowerAlphanumeric(c byte) bool {
+	return isLower(c) || (c >= '0' && c <= '9')
+}
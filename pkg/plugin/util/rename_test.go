@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("ResourceRenamer", func() {
+	var renamer ResourceRenamer
+
+	BeforeEach(func() {
+		renamer = NewResourceRenamer(
+			resource.Resource{
+				GVK:    resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"},
+				Plural: "captains",
+			},
+			resource.Resource{
+				GVK:    resource.GVK{Group: "fleet", Domain: "test.io", Version: "v2", Kind: "Frigate"},
+				Plural: "frigates",
+			},
+		)
+	})
+
+	It("should rename the kind based identifiers and keep the other words", func() {
+		Expect(renamer.Text("CaptainReconciler reconciles a Captain object")).
+			To(Equal("FrigateReconciler reconciles a Frigate object"))
+		Expect(renamer.Text("CaptainList captain-backup")).To(Equal("FrigateList frigate-backup"))
+		Expect(renamer.Text("Captainship captainship")).To(Equal("Captainship captainship"))
+	})
+
+	It("should rename the RBAC markers", func() {
+		Expect(renamer.Text("// +kubebuilder:rbac:groups=crew.test.io,resources=captains/status,verbs=get")).
+			To(Equal("// +kubebuilder:rbac:groups=fleet.test.io,resources=frigates/status,verbs=get"))
+	})
+
+	It("should rename the webhook markers", func() {
+		Expect(renamer.Text("// +kubebuilder:webhook:path=/mutate-crew-test-io-v1-captain,mutating=true," +
+			"groups=crew.test.io,resources=captains,verbs=create;update,versions=v1," +
+			"name=mcaptain-v1.kb.io,admissionReviewVersions=v1")).
+			To(Equal("// +kubebuilder:webhook:path=/mutate-fleet-test-io-v2-frigate,mutating=true," +
+				"groups=fleet.test.io,resources=frigates,verbs=create;update,versions=v2," +
+				"name=mfrigate-v2.kb.io,admissionReviewVersions=v1"))
+	})
+
+	It("should keep the custom webhook paths and rename the default ones", func() {
+		renamer = NewResourceRenamer(
+			resource.Resource{
+				GVK:    resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"},
+				Plural: "captains",
+				Webhooks: &resource.Webhooks{
+					DefaultingPath: "/custom-mutate-captain",
+					ValidationPath: "/validate-crew-test-io-v1-captain",
+				},
+			},
+			resource.Resource{
+				GVK:    resource.GVK{Group: "fleet", Domain: "test.io", Version: "v2", Kind: "Frigate"},
+				Plural: "frigates",
+			},
+		)
+
+		Expect(renamer.Text("// +kubebuilder:webhook:path=/custom-mutate-captain,mutating=true," +
+			"groups=crew.test.io,resources=captains,name=mcaptain-v1.kb.io")).
+			To(Equal("// +kubebuilder:webhook:path=/custom-mutate-captain,mutating=true," +
+				"groups=fleet.test.io,resources=frigates,name=mfrigate-v2.kb.io"))
+		Expect(renamer.Text("/validate-crew-test-io-v1-captain")).To(Equal("/validate-fleet-test-io-v2-frigate"))
+	})
+})
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// GetStoredResource returns the resource tracked in the project configuration for the provided GVK.
+// Resources are matched by group, version and kind because external APIs may have a different domain
+// than the project domain.
+func GetStoredResource(cfg config.Config, gvk resource.GVK) (resource.Resource, error) {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return resource.Resource{}, fmt.Errorf("failed to load resources from project configuration: %w", err)
+	}
+
+	for _, res := range resources {
+		if res.Group == gvk.Group && res.Version == gvk.Version && res.Kind == gvk.Kind {
+			return res, nil
+		}
+	}
+
+	return resource.Resource{}, config.ResourceNotFoundError{GVK: gvk}
+}
+
+// ParseGVK parses a GVK provided as "<group>/<version>/<Kind>" for a project with the provided domain.
+func ParseGVK(value, domain string) (resource.GVK, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 3 {
+		return resource.GVK{}, fmt.Errorf("invalid GVK %q: expected the format <group>/<version>/<Kind>", value)
+	}
+
+	gvk := resource.GVK{
+		Group:   parts[0],
+		Domain:  domain,
+		Version: parts[1],
+		Kind:    parts[2],
+	}
+	if err := gvk.Validate(); err != nil {
+		return resource.GVK{}, fmt.Errorf("invalid GVK %q: %w", value, err)
+	}
+	return gvk, nil
+}
+
+// RenameResource returns a copy of the resource moved to the provided group, version and kind.
+// The plural is only kept when the kind does not change, the path is updated for APIs that live
+// in the project, and the controller names are renamed as they are in the scaffolded files. Webhook paths
+// are only renamed when they are the default ones, custom paths are kept.
+func RenameResource(cfg config.Config, res resource.Resource, to resource.GVK) resource.Resource {
+	renamed := res.Copy()
+	renamed.Group = to.Group
+	renamed.Version = to.Version
+	renamed.Kind = to.Kind
+
+	if to.Kind != res.Kind {
+		renamed.Plural = resource.RegularPlural(to.Kind)
+	}
+	if res.Path == resource.APIPackagePath(cfg.GetRepository(), res.Group, res.Version, cfg.IsMultiGroup()) {
+		renamed.Path = resource.APIPackagePath(cfg.GetRepository(), to.Group, to.Version, cfg.IsMultiGroup())
+	}
+
+	renamer := NewResourceRenamer(res, renamed)
+	if renamed.Controllers != nil {
+		for i, controller := range *renamed.Controllers {
+			(*renamed.Controllers)[i].Name = renamer.Text(controller.Name)
+		}
+	}
+	if renamed.Webhooks != nil {
+		if renamed.Webhooks.DefaultingPath != "" {
+			renamed.Webhooks.DefaultingPath = renamer.Text(renamed.Webhooks.DefaultingPath)
+		}
+		if renamed.Webhooks.ValidationPath != "" {
+			renamed.Webhooks.ValidationPath = renamer.Text(renamed.Webhooks.ValidationPath)
+		}
+	}
+	return renamed
+}
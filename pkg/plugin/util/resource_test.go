@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Resource helpers", func() {
+	Describe("ParseGVK", func() {
+		It("should parse a GVK with the project domain", func() {
+			gvk, err := ParseGVK("crew/v1beta1/Captain", "test.io")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gvk).To(Equal(resource.GVK{Group: "crew", Domain: "test.io", Version: "v1beta1", Kind: "Captain"}))
+		})
+
+		It("should fail if the value does not have three parts", func() {
+			_, err := ParseGVK("crew/Captain", "test.io")
+			Expect(err).To(MatchError(ContainSubstring("expected the format <group>/<version>/<Kind>")))
+		})
+
+		It("should fail if the GVK is not valid", func() {
+			_, err := ParseGVK("crew/v1/captain", "test.io")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("RenameResource", func() {
+		var (
+			cfg     config.Config
+			captain resource.Resource
+		)
+
+		BeforeEach(func() {
+			cfg = cfgv3.New()
+			Expect(cfg.SetRepository("github.com/example/test")).To(Succeed())
+
+			captain = resource.Resource{
+				GVK:         resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"},
+				Plural:      "captains",
+				Path:        "github.com/example/test/api/v1",
+				API:         &resource.API{CRDVersion: "v1", Namespaced: true},
+				Controllers: &resource.Controllers{{Name: "captain"}, {Name: "captain-backup"}},
+			}
+		})
+
+		It("should rename the kind, the plural and the controllers", func() {
+			renamed := RenameResource(cfg, captain,
+				resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Frigate"})
+			Expect(renamed.Plural).To(Equal("frigates"))
+			Expect(renamed.Path).To(Equal(captain.Path))
+			Expect(renamed.GetControllerNames()).To(Equal([]string{"frigate", "frigate-backup"}))
+			Expect(captain.GetControllerNames()).To(Equal([]string{"captain", "captain-backup"}))
+		})
+
+		It("should keep a custom plural and move the package when only the version changes", func() {
+			captain.Plural = "captainz"
+			renamed := RenameResource(cfg, captain,
+				resource.GVK{Group: "crew", Domain: "test.io", Version: "v2", Kind: "Captain"})
+			Expect(renamed.Plural).To(Equal("captainz"))
+			Expect(renamed.Path).To(Equal("github.com/example/test/api/v2"))
+		})
+
+		It("should keep the path of APIs that live in another package", func() {
+			captain.Path = "github.com/example/test/pkg/apis/crew/v1"
+			renamed := RenameResource(cfg, captain,
+				resource.GVK{Group: "crew", Domain: "test.io", Version: "v2", Kind: "Captain"})
+			Expect(renamed.Path).To(Equal(captain.Path))
+		})
+
+		It("should keep the custom webhook paths and rename the default ones", func() {
+			captain.Webhooks = &resource.Webhooks{
+				WebhookVersion: "v1",
+				DefaultingPath: "/custom-mutate-captain",
+				ValidationPath: "/validate-crew-test-io-v1-captain",
+			}
+			renamed := RenameResource(cfg, captain,
+				resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Frigate"})
+			Expect(renamed.Webhooks.DefaultingPath).To(Equal("/custom-mutate-captain"))
+			Expect(renamed.Webhooks.ValidationPath).To(Equal("/validate-crew-test-io-v1-frigate"))
+		})
+	})
+})
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// NextStep is a step that users have to run once a subcommand is done, such as regenerating the manifests.
+type NextStep struct {
+	// Description explains what the step does, e.g. "regenerate the manifests".
+	Description string `json:"description"`
+	// Command is the command line to run, if any.
+	Command string `json:"command,omitempty"`
+}
+
+var (
+	nextStepsMu      sync.Mutex
+	nextStepsHandler func(...NextStep)
+)
+
+// PrintNextSteps prints the steps that users have to run once the subcommand is done.
+// When the CLI writes a structured output, the steps are reported in it instead.
+func PrintNextSteps(steps ...NextStep) {
+	if len(steps) == 0 {
+		return
+	}
+
+	nextStepsMu.Lock()
+	handler := nextStepsHandler
+	nextStepsMu.Unlock()
+	if handler != nil {
+		handler(steps...)
+		return
+	}
+
+	writeNextSteps(os.Stdout, steps)
+}
+
+// HandleNextSteps makes PrintNextSteps pass the steps to handler instead of printing them,
+// until the returned function is called.
+func HandleNextSteps(handler func(...NextStep)) (restore func()) {
+	nextStepsMu.Lock()
+	defer nextStepsMu.Unlock()
+
+	previous := nextStepsHandler
+	nextStepsHandler = handler
+	return func() {
+		nextStepsMu.Lock()
+		defer nextStepsMu.Unlock()
+		nextStepsHandler = previous
+	}
+}
+
+// writeNextSteps writes the steps in a human-readable format.
+func writeNextSteps(w io.Writer, steps []NextStep) {
+	var b strings.Builder
+	if len(steps) == 1 {
+		b.WriteString("Next: ")
+		writeNextStep(&b, steps[0], "")
+	} else {
+		b.WriteString("Next steps:\n")
+		for i, step := range steps {
+			fmt.Fprintf(&b, "%d. ", i+1)
+			writeNextStep(&b, step, "   ")
+		}
+	}
+	_, _ = io.WriteString(w, b.String())
+}
+
+// writeNextStep writes a single step, indenting its command line.
+func writeNextStep(b *strings.Builder, step NextStep, indent string) {
+	if step.Command == "" {
+		fmt.Fprintf(b, "%s\n", step.Description)
+		return
+	}
+	fmt.Fprintf(b, "%s with:\n%s$ %s\n", step.Description, indent, step.Command)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrintNextSteps", func() {
+	It("should print a single step with its command", func() {
+		var out bytes.Buffer
+		writeNextSteps(&out, []NextStep{{Description: "regenerate the manifests", Command: "make manifests"}})
+		Expect(out.String()).To(Equal("Next: regenerate the manifests with:\n$ make manifests\n"))
+	})
+
+	It("should number several steps", func() {
+		var out bytes.Buffer
+		writeNextSteps(&out, []NextStep{
+			{Description: "regenerate the manifests", Command: "make manifests"},
+			{Description: "configure the webhooks"},
+		})
+		Expect(out.String()).To(Equal("Next steps:\n" +
+			"1. regenerate the manifests with:\n   $ make manifests\n" +
+			"2. configure the webhooks\n"))
+	})
+
+	It("should pass the steps to the handler while one is set", func() {
+		var handled []NextStep
+		restore := HandleNextSteps(func(steps ...NextStep) {
+			handled = append(handled, steps...)
+		})
+		PrintNextSteps(NextStep{Description: "regenerate the manifests", Command: "make manifests"})
+		restore()
+
+		Expect(handled).To(Equal([]NextStep{{Description: "regenerate the manifests", Command: "make manifests"}}))
+		Expect(nextStepsHandler).To(BeNil())
+	})
+})
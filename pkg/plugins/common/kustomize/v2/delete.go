@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+)
+
+type deleteSubcommand struct {
+	config   config.Config
+	resource *resource.Resource
+}
+
+func (p *deleteSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *deleteSubcommand) InjectResource(res *resource.Resource) error {
+	stored, err := util.GetStoredResource(p.config, res.GVK)
+	if err != nil {
+		return fmt.Errorf("no resource found for %s/%s, Kind %s: %w", res.QualifiedGroup(), res.Version, res.Kind, err)
+	}
+
+	*res = stored
+	p.resource = res
+	return nil
+}
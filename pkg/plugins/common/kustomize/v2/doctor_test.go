@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+const crdKustomization = `resources:
+- bases/crew.test.io_captains.yaml
+# +kubebuilder:scaffold:crdkustomizeresource
+
+patches:
+# +kubebuilder:scaffold:crdkustomizewebhookpatch
+`
+
+var _ = Describe("Diagnose", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+	)
+
+	newResource := func(kind string) resource.Resource {
+		return resource.Resource{
+			GVK:    resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: kind},
+			Plural: resource.RegularPlural(kind),
+			Path:   "test.io/project/api/v1",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(fs.FS, "config/crd/kustomization.yaml", []byte(crdKustomization), 0o644)).To(Succeed())
+
+		cfg = cfgv3.New()
+		Expect(cfg.SetDomain("test.io")).To(Succeed())
+		Expect(cfg.AddResource(newResource("Captain"))).To(Succeed())
+	})
+
+	It("should not report problems when every CRD is listed", func() {
+		Expect(Plugin{}.Diagnose(cfg, fs)).To(BeEmpty())
+	})
+
+	It("should report and fix the CRDs that are not listed", func() {
+		sailor := newResource("Sailor")
+		sailor.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true}
+		Expect(cfg.AddResource(sailor)).To(Succeed())
+
+		diagnostics, err := Plugin{}.Diagnose(cfg, fs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Message).To(ContainSubstring("Kind Sailor"))
+		Expect(diagnostics[0].Fix).NotTo(BeNil())
+
+		Expect(diagnostics[0].Fix(fs)).To(Succeed())
+		content, err := afero.ReadFile(fs.FS, "config/crd/kustomization.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("- bases/crew.test.io_sailors.yaml"))
+		Expect(string(content)).NotTo(ContainSubstring("webhook_in_sailors.yaml"))
+		Expect(Plugin{}.Diagnose(cfg, fs)).To(BeEmpty())
+	})
+
+	It("should report a missing kustomization, which cannot be fixed", func() {
+		Expect(fs.FS.Remove("config/crd/kustomization.yaml")).To(Succeed())
+
+		diagnostics, err := Plugin{}.Diagnose(cfg, fs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Fix).To(BeNil())
+	})
+})
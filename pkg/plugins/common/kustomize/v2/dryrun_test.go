@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Scaffolding with --dry-run", func() {
+	var (
+		cfg    config.Config
+		res    *resource.Resource
+		osFs   machinery.Filesystem
+		tmpDir string
+	)
+
+	// snapshot returns the content of every file of the working directory
+	snapshot := func() map[string]string {
+		files := make(map[string]string)
+		Expect(filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			content, err := os.ReadFile(path)
+			files[path] = string(content)
+			return err
+		})).To(Succeed())
+		return files
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "kustomize-dry-run")
+		Expect(err).NotTo(HaveOccurred())
+
+		originalDir, err := os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Chdir(tmpDir)).To(Succeed())
+		DeferCleanup(func() {
+			_ = os.Chdir(originalDir)
+			_ = os.RemoveAll(tmpDir)
+		})
+
+		cfg = cfgv3.New()
+		Expect(cfg.SetDomain(testDomain)).To(Succeed())
+		Expect(cfg.SetProjectName("project")).To(Succeed())
+		res = &resource.Resource{
+			GVK: resource.GVK{
+				Group:   "crew",
+				Domain:  testDomain,
+				Version: "v1",
+				Kind:    "Captain",
+			},
+			Plural: "captains",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+			Webhooks: &resource.Webhooks{
+				WebhookVersion: "v1",
+				Defaulting:     true,
+				Validation:     true,
+			},
+		}
+
+		osFs = machinery.Filesystem{FS: afero.NewOsFs()}
+		Expect((&initSubcommand{config: cfg}).Scaffold(osFs)).To(Succeed())
+	})
+
+	It("should leave the working tree byte-identical when editing the project files", func() {
+		before := snapshot()
+
+		dryRun := machinery.NewDryRun(osFs)
+		createCmd := createSubcommand{config: cfg, resource: res}
+		Expect((&createAPISubcommand{createSubcommand: createCmd}).Scaffold(dryRun.Filesystem())).To(Succeed())
+		Expect((&createWebhookSubcommand{createSubcommand: createCmd}).Scaffold(dryRun.Filesystem())).To(Succeed())
+
+		Expect(snapshot()).To(Equal(before))
+
+		changes, err := dryRun.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		paths := make([]string, 0, len(changes))
+		for _, change := range changes {
+			paths = append(paths, change.Path)
+		}
+		Expect(paths).To(ContainElements(
+			filepath.Join("config", "default", "kustomization.yaml"),
+			filepath.Join("config", "rbac", "kustomization.yaml"),
+		))
+	})
+})
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+)
+
+var _ plugin.EditResourceSubcommand = &editResourceSubcommand{}
+
+type editResourceSubcommand struct {
+	config config.Config
+
+	// fromGVK and toGVK are the GVKs of the resource before and after the rename, as provided by the flags
+	fromGVK string
+	toGVK   string
+
+	from resource.Resource
+	to   resource.Resource
+}
+
+func (p *editResourceSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.fromGVK, "from", "", "resource to rename, as <group>/<version>/<Kind>")
+	fs.StringVar(&p.toGVK, "to", "", "new group, version and kind of the resource, as <group>/<version>/<Kind>")
+}
+
+func (p *editResourceSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+
+	fromGVK, err := util.ParseGVK(p.fromGVK, c.GetDomain())
+	if err != nil {
+		return fmt.Errorf("invalid --from flag: %w", err)
+	}
+	toGVK, err := util.ParseGVK(p.toGVK, c.GetDomain())
+	if err != nil {
+		return fmt.Errorf("invalid --to flag: %w", err)
+	}
+
+	p.from, err = util.GetStoredResource(c, fromGVK)
+	if err != nil {
+		return fmt.Errorf("no resource found for %s: %w", p.fromGVK, err)
+	}
+	p.to = util.RenameResource(c, p.from, toGVK)
+
+	return nil
+}
+
+func (p *editResourceSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewEditResourceScaffolder(p.config, p.from, p.to)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to rename api manifests: %w", err)
+	}
+
+	return nil
+}
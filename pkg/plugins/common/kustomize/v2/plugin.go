@@ -17,11 +17,15 @@ limitations under the License.
 package v2
 
 import (
+	"fmt"
+
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
 )
 
 // KustomizeVersion is the kubernetes-sigs/kustomize version to be used in the project
@@ -38,6 +42,10 @@ var (
 	_ plugin.Init          = Plugin{}
 	_ plugin.CreateAPI     = Plugin{}
 	_ plugin.CreateWebhook = Plugin{}
+	_ plugin.EditResource  = Plugin{}
+	_ plugin.DeleteAPI     = Plugin{}
+	_ plugin.DeleteWebhook = Plugin{}
+	_ plugin.Diagnosable   = Plugin{}
 )
 
 // Plugin implements the plugin.Full interface
@@ -45,6 +53,9 @@ type Plugin struct {
 	initSubcommand
 	createAPISubcommand
 	createWebhookSubcommand
+	editResourceSubcommand
+	deleteAPISubcommand
+	deleteWebhookSubcommand
 }
 
 // Name returns the name of the plugin
@@ -67,6 +78,19 @@ func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
 	return &p.createWebhookSubcommand
 }
 
+// GetEditResourceSubcommand will return the subcommand which is responsible for renaming apis
+func (p Plugin) GetEditResourceSubcommand() plugin.EditResourceSubcommand {
+	return &p.editResourceSubcommand
+}
+
+// GetDeleteAPISubcommand will return the subcommand which is responsible for removing apis
+func (p Plugin) GetDeleteAPISubcommand() plugin.DeleteAPISubcommand { return &p.deleteAPISubcommand }
+
+// GetDeleteWebhookSubcommand will return the subcommand which is responsible for removing webhooks
+func (p Plugin) GetDeleteWebhookSubcommand() plugin.DeleteWebhookSubcommand {
+	return &p.deleteWebhookSubcommand
+}
+
 // Description returns a short description of the plugin
 func (Plugin) Description() string {
 	return "Scaffolds base Kustomize configuration"
@@ -76,3 +100,12 @@ func (Plugin) Description() string {
 func (p Plugin) DeprecationWarning() string {
 	return ""
 }
+
+// Diagnose checks that the CRDs of the APIs tracked in the project configuration are deployed
+func (Plugin) Diagnose(cfg config.Config, fs machinery.Filesystem) ([]plugin.Diagnostic, error) {
+	diagnostics, err := scaffolds.Diagnose(cfg, fs)
+	if err != nil {
+		return nil, fmt.Errorf("error checking the kustomize manifests: %w", err)
+	}
+	return diagnostics, nil
+}
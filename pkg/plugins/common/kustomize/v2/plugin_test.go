@@ -31,6 +31,15 @@ var _ = Describe("Plugin", func() {
 		Expect(p.SupportedProjectVersions()).To(ContainElement(cfgv3.Version))
 	})
 
+	It("should provide the delete subcommands", func() {
+		Expect(p.GetDeleteAPISubcommand()).NotTo(BeNil())
+		Expect(p.GetDeleteWebhookSubcommand()).NotTo(BeNil())
+	})
+
+	It("should provide the edit resource subcommand", func() {
+		Expect(p.GetEditResourceSubcommand()).NotTo(BeNil())
+	})
+
 	It("should not be deprecated", func() {
 		Expect(p.DeprecationWarning()).To(BeEmpty())
 	})
@@ -70,6 +70,8 @@ func (s *apiScaffolder) Scaffold() error {
 		machinery.WithResource(&s.resource),
 	)
 
+	editor := pluginutil.NewFileEditor(s.fs)
+
 	// Keep track of these values before the update
 	if s.resource.HasAPI() {
 		if err := scaffold.Execute(
@@ -90,9 +92,9 @@ func (s *apiScaffolder) Scaffold() error {
 			}
 		}
 
-		err := pluginutil.UncommentCode(kustomizeFilePath, "#- ../crd", `#`)
+		err := editor.UncommentCode(kustomizeFilePath, "#- ../crd", `#`)
 		if err != nil {
-			hasCRUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath, "- ../crd")
+			hasCRUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath, "- ../crd")
 			if !hasCRUncommented || errCheck != nil {
 				log.Error("unable to find the target #- ../crd to uncomment in the file",
 					"file_path", kustomizeFilePath)
@@ -103,7 +105,7 @@ func (s *apiScaffolder) Scaffold() error {
 
 		// Add scaffolded CRD Admin, Editor and Viewer roles in config/rbac/kustomization.yaml
 		rbacKustomizeFilePath := "config/rbac/kustomization.yaml"
-		err = pluginutil.AppendCodeIfNotExist(rbacKustomizeFilePath,
+		err = editor.AppendCodeIfNotExist(rbacKustomizeFilePath,
 			comment)
 		if err != nil {
 			log.Error("failed to append the admin/edit/view roles comment in the file",
@@ -113,14 +115,14 @@ func (s *apiScaffolder) Scaffold() error {
 		if s.config.IsMultiGroup() && s.resource.Group != "" {
 			crdName = strings.ToLower(s.resource.Group) + "_" + crdName
 		}
-		err = pluginutil.InsertCodeIfNotExist(rbacKustomizeFilePath, comment,
+		err = editor.InsertCodeIfNotExist(rbacKustomizeFilePath, comment,
 			fmt.Sprintf("\n- %[1]s_admin_role.yaml\n- %[1]s_editor_role.yaml\n- %[1]s_viewer_role.yaml", crdName))
 		if err != nil {
 			log.Error("failed to add admin, editor and viewer roles in the file",
 				"file_path", rbacKustomizeFilePath)
 		}
 		// Add an empty line at the end of the file
-		err = pluginutil.AppendCodeIfNotExist(rbacKustomizeFilePath,
+		err = editor.AppendCodeIfNotExist(rbacKustomizeFilePath,
 			`
 
 `)
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/rbac"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/samples"
+)
+
+const rbacKustomizeFilePath = "config/rbac/kustomization.yaml"
+
+var _ plugins.Scaffolder = &deleteAPIScaffolder{}
+
+// deleteAPIScaffolder contains configuration for removing the kustomize manifests of an API.
+type deleteAPIScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewDeleteAPIScaffolder returns a new Scaffolder for API deletion operations
+func NewDeleteAPIScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &deleteAPIScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) Scaffold() error {
+	if !s.resource.HasAPI() {
+		return nil
+	}
+
+	log.Info("Removing kustomize manifests...")
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err := scaffold.Remove(
+		&samples.CRDSample{},
+		&samples.Kustomization{},
+		&rbac.CRDAdminRole{},
+		&rbac.CRDEditorRole{},
+		&rbac.CRDViewerRole{},
+		&crd.Kustomization{},
+	); err != nil {
+		return fmt.Errorf("error removing kustomize API manifests: %w", err)
+	}
+
+	// The CRD manifest is generated by controller-gen, so it is not removed by any template
+	crdPath := filepath.Join("config", "crd", "bases",
+		fmt.Sprintf("%s_%s.yaml", s.resource.QualifiedGroup(), s.resource.Plural))
+	if err := removeIfExists(s.fs.FS, crdPath); err != nil {
+		return err
+	}
+
+	crdName := strings.ToLower(s.resource.Kind)
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		crdName = strings.ToLower(s.resource.Group) + "_" + crdName
+	}
+	return removeLines(s.fs.FS, rbacKustomizeFilePath,
+		fmt.Sprintf("- %s_admin_role.yaml", crdName),
+		fmt.Sprintf("- %s_editor_role.yaml", crdName),
+		fmt.Sprintf("- %s_viewer_role.yaml", crdName),
+	)
+}
+
+// removeIfExists deletes the file at path, if it exists.
+func removeIfExists(fs afero.Fs, path string) error {
+	exists, err := afero.Exists(fs, path)
+	if err != nil {
+		return fmt.Errorf("error checking %s: %w", path, err)
+	}
+	if !exists {
+		return nil
+	}
+	if err = fs.Remove(path); err != nil {
+		return fmt.Errorf("error removing %s: %w", path, err)
+	}
+	return nil
+}
+
+// removeLines removes the lines of the file at path that are equal to any of the provided ones,
+// ignoring the surrounding whitespace. Missing files are skipped.
+func removeLines(fs afero.Fs, path string, lines ...string) error {
+	exists, err := afero.Exists(fs, path)
+	if err != nil {
+		return fmt.Errorf("error checking %s: %w", path, err)
+	}
+	if !exists {
+		log.Warn("skipping missing file", "file", path)
+		return nil
+	}
+
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	fileLines := strings.Split(string(content), "\n")
+	updated := slices.DeleteFunc(slices.Clone(fileLines), func(line string) bool {
+		return slices.Contains(lines, strings.TrimSpace(line))
+	})
+	if len(updated) == len(fileLines) {
+		return nil
+	}
+
+	if err = afero.WriteFile(fs, path, []byte(strings.Join(updated, "\n")), 0o644); err != nil {
+		return fmt.Errorf("error updating %s: %w", path, err)
+	}
+	return nil
+}
+
+// replaceLines replaces the lines of the file at path that are equal to any of the keys of the provided
+// map, ignoring the surrounding whitespace, by the corresponding value. Missing files are skipped.
+func replaceLines(fs afero.Fs, path string, lines map[string]string) error {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	exists, err := afero.Exists(fs, path)
+	if err != nil {
+		return fmt.Errorf("error checking %s: %w", path, err)
+	}
+	if !exists {
+		log.Warn("skipping missing file", "file", path)
+		return nil
+	}
+
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	fileLines := strings.Split(string(content), "\n")
+	for i, line := range fileLines {
+		trimmed := strings.TrimSpace(line)
+		if replacement, found := lines[trimmed]; found {
+			fileLines[i] = strings.Replace(line, trimmed, replacement, 1)
+		}
+	}
+
+	if err = afero.WriteFile(fs, path, []byte(strings.Join(fileLines, "\n")), 0o644); err != nil {
+		return fmt.Errorf("error updating %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd/patches"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/kdefault"
+)
+
+var _ plugins.Scaffolder = &deleteWebhookScaffolder{}
+
+// deleteWebhookScaffolder contains configuration for removing the kustomize manifests of the webhooks
+// of a resource.
+type deleteWebhookScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewDeleteWebhookScaffolder returns a new Scaffolder for webhook deletion operations
+func NewDeleteWebhookScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &deleteWebhookScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) InjectFS(fs machinery.Filesystem) { s.fs = fs }
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) Scaffold() error {
+	// Only conversion webhooks are wired per resource in the kustomize manifests
+	if !s.resource.HasConversionWebhook() {
+		return nil
+	}
+
+	log.Info("Removing kustomize manifests of the conversion webhook...")
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	// The CRD of the resource is kept, so only the webhook patch is removed from its kustomization
+	withoutWebhooks := s.resource.Copy()
+	withoutWebhooks.Webhooks = nil
+
+	if err := scaffold.Remove(
+		&patches.EnableWebhookPatch{},
+		&kdefault.KustomizationCAConversionUpdater{},
+		&crd.Kustomization{
+			RetainedResourcesMixin: machinery.RetainedResourcesMixin{
+				RetainedResources: []resource.Resource{withoutWebhooks},
+			},
+		},
+	); err != nil {
+		return fmt.Errorf("error removing kustomize webhook manifests: %w", err)
+	}
+
+	log.Warn("Review the CA injection replacements for the CRD in the file, "+
+		"since the ones that were uncommented are not removed",
+		"file", kustomizeFilePath)
+
+	return nil
+}
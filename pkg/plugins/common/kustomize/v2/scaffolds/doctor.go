@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd"
+)
+
+// checkCRDKustomization identifies the diagnostics of the CRDs that are not listed in the kustomization.
+const checkCRDKustomization = "crd-kustomization"
+
+// Diagnose checks that the CRDs of the APIs tracked in the project configuration are listed in
+// config/crd/kustomization.yaml. Missing entries are fixed with the same updater used by "create api".
+func Diagnose(cfg config.Config, fs machinery.Filesystem) ([]plugin.Diagnostic, error) {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources: %w", err)
+	}
+	resources = slices.DeleteFunc(resources, func(res resource.Resource) bool { return !res.HasAPI() })
+	if len(resources) == 0 {
+		return nil, nil
+	}
+
+	content, err := afero.ReadFile(fs.FS, crdKustomizeFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return []plugin.Diagnostic{{
+			Check:   checkCRDKustomization,
+			Message: fmt.Sprintf("%s was not found, so the CRDs are not deployed", crdKustomizeFilePath),
+			Hint:    fmt.Sprintf("restore %s from version control", crdKustomizeFilePath),
+		}}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", crdKustomizeFilePath, err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+
+	var diagnostics []plugin.Diagnostic
+	for _, res := range resources {
+		entry := fmt.Sprintf("- bases/%s_%s.yaml", res.QualifiedGroup(), res.Plural)
+		if slices.Contains(lines, entry) {
+			continue
+		}
+
+		// Only the CRD is listed, the patches of the conversion webhook are left as they are
+		withoutWebhooks := res.Copy()
+		withoutWebhooks.Webhooks = nil
+		diagnostics = append(diagnostics, plugin.Diagnostic{
+			Check: checkCRDKustomization,
+			Message: fmt.Sprintf("the CRD of %s/%s, Kind %s is not listed in %s",
+				res.QualifiedGroup(), res.Version, res.Kind, crdKustomizeFilePath),
+			Hint: fmt.Sprintf("add %q to the resources of %s", entry, crdKustomizeFilePath),
+			Fix: func(fs machinery.Filesystem) error {
+				scaffold := machinery.NewScaffold(fs,
+					machinery.WithConfig(cfg),
+					machinery.WithResource(&withoutWebhooks),
+				)
+				if err := scaffold.Execute(&crd.Kustomization{}); err != nil {
+					return fmt.Errorf("error updating %s: %w", crdKustomizeFilePath, err)
+				}
+				return nil
+			},
+		})
+	}
+
+	return diagnostics, nil
+}
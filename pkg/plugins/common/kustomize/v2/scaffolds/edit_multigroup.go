@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd/patches"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/rbac"
+)
+
+var _ plugins.Scaffolder = &editMultiGroupScaffolder{}
+
+// editMultiGroupScaffolder contains configuration for moving the kustomize manifests whose name
+// depends on the layout of the project.
+type editMultiGroupScaffolder struct {
+	config     config.Config
+	multiGroup bool
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewEditMultiGroupScaffolder returns a new Scaffolder for layout change operations. It must run
+// before the layout is updated in the project configuration.
+func NewEditMultiGroupScaffolder(cfg config.Config, multiGroup bool) plugins.Scaffolder {
+	return &editMultiGroupScaffolder{
+		config:     cfg,
+		multiGroup: multiGroup,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *editMultiGroupScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *editMultiGroupScaffolder) Scaffold() error {
+	wasMultiGroup := s.config.IsMultiGroup()
+	if wasMultiGroup == s.multiGroup {
+		return nil
+	}
+
+	log.Info("Moving kustomize manifests to the new layout...")
+
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	entries := map[string]map[string]string{
+		crdKustomizeFilePath:  {},
+		rbacKustomizeFilePath: {},
+	}
+	moved := map[string]bool{}
+	for _, res := range resources {
+		if res.Group == "" {
+			continue
+		}
+
+		// The roles are scaffolded once for all the versions of a kind
+		roles := res.Group + "/" + res.Kind
+		if res.HasAPI() && !moved[roles] {
+			moved[roles] = true
+			if err = s.moveRoles(res, wasMultiGroup, entries[rbacKustomizeFilePath]); err != nil {
+				return err
+			}
+		}
+		if res.HasConversionWebhook() {
+			if err = s.movePatches(res, wasMultiGroup, entries[crdKustomizeFilePath]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for path, lines := range entries {
+		if err = replaceLines(s.fs.FS, path, lines); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveRoles moves the roles of the resource to the files of the new layout, updating their names.
+func (s *editMultiGroupScaffolder) moveRoles(
+	res resource.Resource,
+	wasMultiGroup bool,
+	entries map[string]string,
+) error {
+	for _, role := range []func() machinery.Template{
+		func() machinery.Template { return &rbac.CRDAdminRole{} },
+		func() machinery.Template { return &rbac.CRDEditorRole{} },
+		func() machinery.Template { return &rbac.CRDViewerRole{} },
+	} {
+		from, to := role(), role()
+		fromPaths, err := templatePaths(res, wasMultiGroup, from)
+		if err != nil {
+			return err
+		}
+		toPaths, err := templatePaths(res, s.multiGroup, to)
+		if err != nil {
+			return err
+		}
+
+		fromName, toName := "name: "+roleName(from), "name: "+roleName(to)
+		if err = moveFile(s.fs.FS, fromPaths[0], toPaths[0], func(content string) string {
+			return strings.ReplaceAll(content, fromName, toName)
+		}); err != nil {
+			return err
+		}
+		entries["- "+filepath.Base(fromPaths[0])] = "- " + filepath.Base(toPaths[0])
+	}
+	return nil
+}
+
+// movePatches moves the CRD patches of the conversion webhook of the resource to the files of the
+// new layout. The CA injection patch was only scaffolded by previous versions, so it may not exist.
+func (s *editMultiGroupScaffolder) movePatches(
+	res resource.Resource,
+	wasMultiGroup bool,
+	entries map[string]string,
+) error {
+	fromPaths, err := templatePaths(res, wasMultiGroup, &patches.EnableWebhookPatch{}, &patches.EnableCAInjectionPatch{})
+	if err != nil {
+		return err
+	}
+	toPaths, err := templatePaths(res, s.multiGroup, &patches.EnableWebhookPatch{}, &patches.EnableCAInjectionPatch{})
+	if err != nil {
+		return err
+	}
+
+	for i, fromPath := range fromPaths {
+		exists, err := afero.Exists(s.fs.FS, fromPath)
+		if err != nil {
+			return fmt.Errorf("error checking %s: %w", fromPath, err)
+		}
+		if !exists {
+			continue
+		}
+		if err = moveFile(s.fs.FS, fromPath, toPaths[i], func(content string) string { return content }); err != nil {
+			return err
+		}
+
+		fromEntry := "- path: patches/" + filepath.Base(fromPath)
+		toEntry := "- path: patches/" + filepath.Base(toPaths[i])
+		entries[fromEntry] = toEntry
+		entries["#"+fromEntry] = "#" + toEntry
+	}
+	return nil
+}
+
+// roleName returns the name of the role scaffolded by the template.
+func roleName(template machinery.Template) string {
+	switch role := template.(type) {
+	case *rbac.CRDAdminRole:
+		return role.RoleName
+	case *rbac.CRDEditorRole:
+		return role.RoleName
+	case *rbac.CRDViewerRole:
+		return role.RoleName
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/rbac"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/samples"
+)
+
+const (
+	crdKustomizeFilePath     = "config/crd/kustomization.yaml"
+	samplesKustomizeFilePath = "config/samples/kustomization.yaml"
+)
+
+var _ plugins.Scaffolder = &editResourceScaffolder{}
+
+// editResourceScaffolder contains configuration for renaming the kustomize manifests of an API.
+type editResourceScaffolder struct {
+	config config.Config
+	from   resource.Resource
+	to     resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewEditResourceScaffolder returns a new Scaffolder for resource rename operations
+func NewEditResourceScaffolder(cfg config.Config, from, to resource.Resource) plugins.Scaffolder {
+	return &editResourceScaffolder{
+		config: cfg,
+		from:   from,
+		to:     to,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *editResourceScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *editResourceScaffolder) Scaffold() error {
+	if !s.from.HasAPI() {
+		return nil
+	}
+
+	log.Info("Renaming kustomize manifests...")
+
+	fromPaths, err := s.manifests(s.from)
+	if err != nil {
+		return err
+	}
+	toPaths, err := s.manifests(s.to)
+	if err != nil {
+		return err
+	}
+
+	renamer := util.NewResourceRenamer(s.from, s.to)
+	entries := make(map[string]map[string]string)
+	for i, fromPath := range fromPaths {
+		if err = moveFile(s.fs.FS, fromPath, toPaths[i], renamer.Text); err != nil {
+			return err
+		}
+
+		// The manifests are listed in the kustomization file of their directory
+		kustomization := filepath.Join(filepath.Dir(fromPath), "kustomization.yaml")
+		if filepath.Dir(fromPath) == filepath.Join("config", "crd", "bases") {
+			kustomization = crdKustomizeFilePath
+		}
+		if entries[kustomization] == nil {
+			entries[kustomization] = make(map[string]string)
+		}
+		fromEntry, _ := filepath.Rel(filepath.Dir(kustomization), fromPath)
+		toEntry, _ := filepath.Rel(filepath.Dir(kustomization), toPaths[i])
+		entries[kustomization]["- "+filepath.ToSlash(fromEntry)] = "- " + filepath.ToSlash(toEntry)
+	}
+
+	for _, path := range []string{crdKustomizeFilePath, samplesKustomizeFilePath, rbacKustomizeFilePath} {
+		if err = replaceLines(s.fs.FS, path, entries[path]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// manifests returns the paths of the manifests of the resource, in the same order for a resource
+// and its renamed copy.
+func (s *editResourceScaffolder) manifests(res resource.Resource) ([]string, error) {
+	templates := []machinery.Template{
+		&samples.CRDSample{},
+		&rbac.CRDAdminRole{},
+		&rbac.CRDEditorRole{},
+		&rbac.CRDViewerRole{},
+	}
+
+	paths, err := templatePaths(res, s.config.IsMultiGroup(), templates...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The CRD manifest is generated by controller-gen, so no template provides its path
+	return append(paths, filepath.Join("config", "crd", "bases",
+		fmt.Sprintf("%s_%s.yaml", res.QualifiedGroup(), res.Plural))), nil
+}
+
+// templatePaths returns the paths of the files scaffolded by the templates for the resource in the
+// provided layout.
+func templatePaths(res resource.Resource, multiGroup bool, templates ...machinery.Template) ([]string, error) {
+	paths := make([]string, 0, len(templates))
+	for _, template := range templates {
+		if builder, ok := template.(machinery.HasMultiGroup); ok {
+			builder.InjectMultiGroup(multiGroup)
+		}
+		if builder, ok := template.(machinery.HasResource); ok {
+			builder.InjectResource(&res)
+		}
+		if err := template.SetTemplateDefaults(); err != nil {
+			return nil, fmt.Errorf("error getting the path of %T: %w", template, err)
+		}
+		paths = append(paths, template.GetPath())
+	}
+	return paths, nil
+}
+
+// moveFile moves the file at fromPath to toPath, rewriting its content. Missing files are skipped.
+func moveFile(fs afero.Fs, fromPath, toPath string, rewrite func(string) string) error {
+	content, err := afero.ReadFile(fs, fromPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("skipping missing file", "file", fromPath)
+			return nil
+		}
+		return fmt.Errorf("error reading %s: %w", fromPath, err)
+	}
+
+	if toPath != fromPath {
+		exists, existsErr := afero.Exists(fs, toPath)
+		if existsErr != nil {
+			return fmt.Errorf("error checking %s: %w", toPath, existsErr)
+		}
+		if exists {
+			return fmt.Errorf("unable to move %s: file %s already exists", fromPath, toPath)
+		}
+	}
+
+	if err = afero.WriteFile(fs, toPath, []byte(rewrite(string(content))), 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", toPath, err)
+	}
+	if toPath != fromPath {
+		if err = fs.Remove(fromPath); err != nil {
+			return fmt.Errorf("error removing %s: %w", fromPath, err)
+		}
+	}
+	return nil
+}
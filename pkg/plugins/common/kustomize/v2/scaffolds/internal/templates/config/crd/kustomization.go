@@ -21,11 +21,13 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
 var (
-	_ machinery.Template = &Kustomization{}
-	_ machinery.Inserter = &Kustomization{}
+	_ machinery.Template                 = &Kustomization{}
+	_ machinery.Inserter                 = &Kustomization{}
+	_ machinery.HasRetainedCodeFragments = &Kustomization{}
 )
 
 // Kustomization scaffolds a file that defines the kustomization scheme for the crd folder
@@ -33,6 +35,7 @@ type Kustomization struct {
 	machinery.TemplateMixin
 	machinery.MultiGroupMixin
 	machinery.ResourceMixin
+	machinery.RetainedResourcesMixin
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -84,7 +87,7 @@ func (f *Kustomization) GetCodeFragments() machinery.CodeFragmentsMap {
 		suffix = f.Resource.Group + "_" + f.Resource.Plural
 	}
 
-	if !f.Resource.Webhooks.IsEmpty() && f.Resource.Webhooks.Conversion {
+	if f.Resource.HasConversionWebhook() {
 		webhookPatch := fmt.Sprintf(webhookPatchCodeFragment, suffix)
 
 		marker := machinery.NewMarkerFor(f.Path, webhookPatchMarker)
@@ -101,6 +104,15 @@ func (f *Kustomization) GetCodeFragments() machinery.CodeFragmentsMap {
 	return fragments
 }
 
+// GetRetainedCodeFragments implements machinery.HasRetainedCodeFragments
+func (f *Kustomization) GetRetainedCodeFragments() machinery.CodeFragmentsMap {
+	return f.RetainedCodeFragments(func(res *resource.Resource) machinery.CodeFragmentsMap {
+		kustomization := *f
+		kustomization.Resource = res
+		return kustomization.GetCodeFragments()
+	})
+}
+
 var kustomizationTemplate = `# This kustomization.yaml is not intended to be run by itself,
 # since it depends on service name and namespace that are out of this kustomize package.
 # It should be run by config/default
@@ -28,6 +28,7 @@ var _ machinery.Template = &CertManagerMetricsPatch{}
 type CertManagerMetricsPatch struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	Force bool
 }
@@ -41,7 +42,7 @@ func (f *CertManagerMetricsPatch) SetTemplateDefaults() error {
 	f.TemplateBody = metricsManagerPatchTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		// If file exists (ex. because a webhook was already created), skip creation.
 		f.IfExistsAction = machinery.SkipFile
@@ -28,6 +28,7 @@ var _ machinery.Template = &ManagerWebhookPatch{}
 type ManagerWebhookPatch struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	Force bool
 }
@@ -41,7 +42,7 @@ func (f *ManagerWebhookPatch) SetTemplateDefaults() error {
 	f.TemplateBody = managerWebhookPatchTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		// If file exists (ex. because a webhook was already created), skip creation.
 		f.IfExistsAction = machinery.SkipFile
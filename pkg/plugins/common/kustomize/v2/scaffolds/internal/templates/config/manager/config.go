@@ -29,6 +29,7 @@ type Config struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.MergeableMixin
 
 	// Image is controller manager image name
 	Image string
@@ -46,7 +47,7 @@ func (f *Config) SetTemplateDefaults() error {
 	f.TemplateBody = configTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
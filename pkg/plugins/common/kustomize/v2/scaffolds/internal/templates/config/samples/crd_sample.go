@@ -29,6 +29,7 @@ type CRDSample struct {
 	machinery.TemplateMixin
 	machinery.ResourceMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	Force bool
 }
@@ -45,7 +46,7 @@ func (f *CRDSample) SetTemplateDefaults() error {
 	f.Path = f.Resource.Replacer().Replace(f.Path)
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.Error
 	}
@@ -28,6 +28,7 @@ var _ machinery.Template = &Kustomization{}
 type Kustomization struct {
 	machinery.TemplateMixin
 	machinery.ResourceMixin
+	machinery.MergeableMixin
 
 	Force bool
 }
@@ -41,7 +42,7 @@ func (f *Kustomization) SetTemplateDefaults() error {
 	f.TemplateBody = kustomizeWebhookTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		// If file exists (ex. because a webhook was already created), skip creation.
 		f.IfExistsAction = machinery.SkipFile
@@ -68,11 +68,13 @@ func (s *webhookScaffolder) InjectFS(fs machinery.Filesystem) { s.fs = fs }
 func (s *webhookScaffolder) Scaffold() error {
 	log.Info("Writing kustomize manifests for you to edit...")
 
+	editor := pluginutil.NewFileEditor(s.fs)
+
 	// Will validate the scaffold
 	// Users that scaffolded the project previously
 	// with the bugs will receive a message to help
 	// them out fix their scaffold.
-	validateScaffoldedProject()
+	validateScaffoldedProject(editor)
 
 	// Initialize the machinery.Scaffold that will write the files to disk
 	scaffold := machinery.NewScaffold(s.fs,
@@ -119,21 +121,21 @@ func (s *webhookScaffolder) Scaffold() error {
 	// Apply project-specific customizations:
 	// - Add reference to allow-webhook-traffic.yaml in network policy configuration.
 	// - Enable all webhook-related sections in config/default/kustomization.yaml.
-	addNetworkPoliciesForWebhooks()
+	addNetworkPoliciesForWebhooks(editor)
 	// enableWebhookDefaults ensures all necessary components for webhook functionality
 	// are enabled in config/default/kustomization.yaml, including:
 	// - webhook and cert-manager directories
 	// - manager patches
 	// - replacements for certificate injection
-	enableWebhookDefaults()
+	enableWebhookDefaults(editor)
 	if s.resource.HasValidationWebhook() {
-		uncommentCodeForValidationWebhooks()
+		uncommentCodeForValidationWebhooks(editor)
 	}
 	if s.resource.HasDefaultingWebhook() {
-		uncommentCodeForDefaultWebhooks()
+		uncommentCodeForDefaultWebhooks(editor)
 	}
 	if s.resource.HasConversionWebhook() {
-		uncommentCodeForConversionWebhooks(s.resource)
+		uncommentCodeForConversionWebhooks(editor, s.resource)
 	}
 
 	const helmPluginKey = "helm.kubebuilder.io/v1-alpha"
@@ -142,7 +144,7 @@ func (s *webhookScaffolder) Scaffold() error {
 	if !errors.As(err, &config.PluginKeyNotFoundError{}) {
 		testChartPath := ".github/workflows/test-chart.yml"
 		//nolint:lll
-		_ = pluginutil.UncommentCode(
+		_ = editor.UncommentCode(
 			testChartPath, `#      - name: Install cert-manager via Helm
 #        run: |
 #          helm repo add jetstack https://charts.jetstack.io
@@ -157,7 +159,7 @@ func (s *webhookScaffolder) Scaffold() error {
 `, "#",
 		)
 
-		_ = pluginutil.ReplaceInFile(testChartPath, "# TODO: Uncomment if cert-manager is enabled", "")
+		_ = editor.ReplaceInFile(testChartPath, "# TODO: Uncomment if cert-manager is enabled", "")
 	}
 
 	return nil
@@ -166,9 +168,9 @@ func (s *webhookScaffolder) Scaffold() error {
 // uncommentCodeForConversionWebhooks enables CA injection logic in Kustomize manifests
 // for ConversionWebhooks by uncommenting certificate sources and CRD annotation targets.
 // This is required to make cert-manager correctly inject the CA bundle into CRDs.
-func uncommentCodeForConversionWebhooks(r resource.Resource) {
+func uncommentCodeForConversionWebhooks(editor pluginutil.FileEditor, r resource.Resource) {
 	crdName := fmt.Sprintf("%s.%s", r.Plural, r.QualifiedGroup())
-	err := pluginutil.UncommentCode(
+	err := editor.UncommentCode(
 		kustomizeFilePath,
 		fmt.Sprintf(`# - source: # Uncomment the following block if you have a ConversionWebhook (--conversion)
 #     kind: Certificate
@@ -194,7 +196,7 @@ func uncommentCodeForConversionWebhooks(r resource.Resource) {
 			"to inject the CA properly.",
 			"crdName", crdName, "file", kustomizeFilePath)
 	}
-	err = pluginutil.UncommentCode(
+	err = editor.UncommentCode(
 		kustomizeFilePath,
 		fmt.Sprintf(`# - source:
 #     kind: Certificate
@@ -221,10 +223,10 @@ func uncommentCodeForConversionWebhooks(r resource.Resource) {
 			"crdName", crdName, "file", kustomizeFilePath)
 	}
 
-	err = pluginutil.UncommentCode(kustomizeCRDFilePath, `#configurations:
+	err = editor.UncommentCode(kustomizeCRDFilePath, `#configurations:
 #- kustomizeconfig.yaml`, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeCRDFilePath,
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeCRDFilePath,
 			`configurations:
 - kustomizeconfig.yaml`)
 		if !hasWebHookUncommented || errCheck != nil {
@@ -235,8 +237,8 @@ func uncommentCodeForConversionWebhooks(r resource.Resource) {
 	}
 }
 
-func uncommentCodeForDefaultWebhooks() {
-	err := pluginutil.UncommentCode(
+func uncommentCodeForDefaultWebhooks(editor pluginutil.FileEditor) {
+	err := editor.UncommentCode(
 		kustomizeFilePath,
 		`# - source: # Uncomment the following block if you have a DefaultingWebhook (--defaulting )
 #     kind: Certificate
@@ -271,7 +273,7 @@ func uncommentCodeForDefaultWebhooks() {
 		"#",
 	)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath,
 			`   targets:
      - select:
          kind: MutatingWebhookConfiguration`)
@@ -284,8 +286,8 @@ func uncommentCodeForDefaultWebhooks() {
 	}
 }
 
-func uncommentCodeForValidationWebhooks() {
-	err := pluginutil.UncommentCode(
+func uncommentCodeForValidationWebhooks(editor pluginutil.FileEditor) {
+	err := editor.UncommentCode(
 		kustomizeFilePath,
 		`# - source: # Uncomment the following block if you have a ValidatingWebhook (--programmatic-validation)
 #     kind: Certificate
@@ -320,7 +322,7 @@ func uncommentCodeForValidationWebhooks() {
 		"#",
 	)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath,
 			`   targets:
      - select:
          kind: ValidatingWebhookConfiguration`)
@@ -333,30 +335,30 @@ func uncommentCodeForValidationWebhooks() {
 	}
 }
 
-func enableWebhookDefaults() {
-	err := pluginutil.UncommentCode(kustomizeFilePath, "#- ../webhook", `#`)
+func enableWebhookDefaults(editor pluginutil.FileEditor) {
+	err := editor.UncommentCode(kustomizeFilePath, "#- ../webhook", `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath, "- ../webhook")
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath, "- ../webhook")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the target #- ../webhook to uncomment in the file",
 				"file", kustomizeFilePath)
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, "#patches:", `#`)
+	err = editor.UncommentCode(kustomizeFilePath, "#patches:", `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath, "patches:")
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath, "patches:")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the line '#patches:' to uncomment in the file",
 				"file", kustomizeFilePath)
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, `#- path: manager_webhook_patch.yaml
+	err = editor.UncommentCode(kustomizeFilePath, `#- path: manager_webhook_patch.yaml
 #  target:
 #    kind: Deployment`, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath,
 			"- path: manager_webhook_patch.yaml")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the target #- path: manager_webhook_patch.yaml to uncomment in the file",
@@ -364,9 +366,9 @@ func enableWebhookDefaults() {
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, `#- ../certmanager`, `#`)
+	err = editor.UncommentCode(kustomizeFilePath, `#- ../certmanager`, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath,
 			"../certmanager")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the '../certmanager' section to uncomment in the file. "+
@@ -376,9 +378,9 @@ func enableWebhookDefaults() {
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, `#replacements:`, `#`)
+	err = editor.UncommentCode(kustomizeFilePath, `#replacements:`, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath,
 			"replacements:")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("Unable to find the '#replacements:' section to uncomment in the file"+
@@ -388,7 +390,7 @@ func enableWebhookDefaults() {
 		}
 	}
 
-	err = pluginutil.UncommentCode(
+	err = editor.UncommentCode(
 		kustomizeFilePath,
 		`# - source: # Uncomment the following block if you have any webhook
 #     kind: Service
@@ -429,7 +431,7 @@ func enableWebhookDefaults() {
 		"#",
 	)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := editor.HasFileContentWith(kustomizeFilePath,
 			`     kind: Service
      version: v1
      name: webhook-service
@@ -443,9 +445,9 @@ func enableWebhookDefaults() {
 	}
 }
 
-func addNetworkPoliciesForWebhooks() {
+func addNetworkPoliciesForWebhooks(editor pluginutil.FileEditor) {
 	policyKustomizeFilePath := "config/network-policy/kustomization.yaml"
-	err := pluginutil.InsertCodeIfNotExist(policyKustomizeFilePath,
+	err := editor.InsertCodeIfNotExist(policyKustomizeFilePath,
 		"resources:", allowWebhookTrafficFragment)
 	if err != nil {
 		log.Error("failed to add the line '- allow-webhook-traffic.yaml' at the end of the file "+
@@ -455,8 +457,8 @@ func addNetworkPoliciesForWebhooks() {
 
 // Deprecated: remove it when go/v4 and/or kustomize/v2 be removed
 // validateScaffoldedProject will output a message to help users fix their scaffold
-func validateScaffoldedProject() {
-	hasCertManagerPatch, _ := pluginutil.HasFileContentWith(kustomizeFilePath,
+func validateScaffoldedProject(editor pluginutil.FileEditor) {
+	hasCertManagerPatch, _ := editor.HasFileContentWith(kustomizeFilePath,
 		"crdkustomizecainjectionpatch")
 
 	if hasCertManagerPatch {
@@ -26,17 +26,23 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.CreateAPISubcommand = &createAPISubcommand{}
+var (
+	_ plugin.CreateAPISubcommand = &createAPISubcommand{}
+	_ plugin.HasPreScaffold      = &createAPISubcommand{}
+	_ plugin.HasPostScaffold     = &createAPISubcommand{}
+)
 
 const (
-	defaultAPIVersion = "v1alpha1"
+	defaultAPIVersion = external.APIVersionV1Alpha1
 )
 
 type createAPISubcommand struct {
 	Path        string
 	Args        []string
+	pluginKey   string
 	pluginChain []string
 	config      config.Config
+	protocol    pluginProtocol
 }
 
 // InjectConfig injects the project configuration so external plugins can read the PROJECT file.
@@ -73,21 +79,27 @@ func (p *createAPISubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *p
 }
 
 func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
-	bindExternalPluginFlags(fs, "api", p.Path, p.Args)
+	p.protocol = bindExternalPluginFlags(fs, "api", p.Path, p.Args)
+}
+
+func (p *createAPISubcommand) PreScaffold(machinery.Filesystem) error {
+	return makeHookRequest(rpcMethodPreScaffold, p.request(), p.Path, p.config)
 }
 
 func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return handlePluginResponse(fs, p.request(), p.Path, p.config, p.pluginKey, p.protocol)
+}
+
+func (p *createAPISubcommand) PostScaffold() error {
+	return makeHookRequest(rpcMethodPostScaffold, p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the plugin for the subcommand
+func (p *createAPISubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "create api",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
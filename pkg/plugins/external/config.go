@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// applyConfigPatch validates the config patch returned by the plugin against the project configuration
+// and applies it. The configuration is saved by the CLI once every plugin has been executed.
+func applyConfigPatch(cfg config.Config, pluginKey string, patch *external.ConfigPatch) error {
+	if cfg == nil {
+		return errors.New("no project configuration is available to apply the config patch to")
+	}
+	if cfg.GetVersion().Compare(cfgv3.Version) != 0 {
+		return fmt.Errorf("config patches are only supported for project version %q, got %q",
+			cfgv3.Version, cfg.GetVersion())
+	}
+
+	// Apply the patch to a copy first, so that an invalid patch leaves the configuration untouched
+	content, err := cfg.MarshalYAML()
+	if err != nil {
+		return fmt.Errorf("error marshaling config: %w", err)
+	}
+	scratch := cfgv3.New()
+	if err = scratch.UnmarshalYAML(content); err != nil {
+		return fmt.Errorf("error unmarshaling config: %w", err)
+	}
+	if err = patchConfig(scratch, pluginKey, patch); err != nil {
+		return fmt.Errorf("invalid config patch: %w", err)
+	}
+
+	return patchConfig(cfg, pluginKey, patch)
+}
+
+// patchConfig adds or updates the resources of the patch and stores the plugin configuration
+func patchConfig(cfg config.Config, pluginKey string, patch *external.ConfigPatch) error {
+	for _, res := range patch.Resources {
+		if res.Plural == "" {
+			res.Plural = resource.RegularPlural(res.Kind)
+		}
+		if err := res.Validate(); err != nil {
+			return fmt.Errorf("invalid resource %q: %w", res.Kind, err)
+		}
+		if err := cfg.UpdateResource(res); err != nil {
+			return fmt.Errorf("error updating resource %q: %w", res.Kind, err)
+		}
+	}
+
+	if patch.PluginConfig != nil {
+		if err := cfg.EncodePluginConfig(pluginKey, patch.PluginConfig); err != nil {
+			return fmt.Errorf("error encoding the configuration of plugin %q: %w", pluginKey, err)
+		}
+	}
+
+	return nil
+}
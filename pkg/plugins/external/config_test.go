@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var _ = Describe("Config patch", func() {
+	const pluginKey = "myexternalplugin/v1"
+
+	var (
+		fs     machinery.Filesystem
+		cfg    config.Config
+		getter *mockSequenceOutputGetter
+		sc     *createAPISubcommand
+	)
+
+	BeforeEach(func() {
+		getter = &mockSequenceOutputGetter{}
+		outputGetter = getter
+		currentDirGetter = &mockRootOsWdGetter{}
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+
+		cfg = cfgv3.New()
+		Expect(cfg.SetDomain(exampleDomain)).To(Succeed())
+
+		p := Plugin{PName: "myexternalplugin", PVersion: plugin.Version{Number: 1}}
+		sc = p.GetCreateAPISubcommand().(*createAPISubcommand)
+		Expect(sc.InjectConfig(cfg)).To(Succeed())
+	})
+
+	It("should add the resources and the plugin configuration to the project", func() {
+		getter.responses = []external.PluginResponse{{
+			ConfigPatch: &external.ConfigPatch{
+				Resources: []resource.Resource{{
+					GVK: resource.GVK{Group: apiGroupApps, Domain: exampleDomain, Version: "v1", Kind: kindMyKind},
+					API: &resource.API{CRDVersion: "v1", Namespaced: true},
+				}},
+				PluginConfig: map[string]any{"image": "example.com/image:v1"},
+			},
+		}}
+
+		Expect(sc.Scaffold(fs)).To(Succeed())
+
+		res, err := cfg.GetResource(resource.GVK{
+			Group: apiGroupApps, Domain: exampleDomain, Version: "v1", Kind: kindMyKind,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.API.Namespaced).To(BeTrue())
+
+		var pluginConfig map[string]any
+		Expect(cfg.DecodePluginConfig(pluginKey, &pluginConfig)).To(Succeed())
+		Expect(pluginConfig).To(HaveKeyWithValue("image", "example.com/image:v1"))
+	})
+
+	It("should reject the whole patch if a resource is invalid", func() {
+		getter.responses = []external.PluginResponse{{
+			ConfigPatch: &external.ConfigPatch{
+				Resources: []resource.Resource{
+					{GVK: resource.GVK{Group: apiGroupApps, Domain: exampleDomain, Version: "v1", Kind: kindMyKind}},
+					{GVK: resource.GVK{Group: apiGroupApps, Domain: exampleDomain, Version: "v1", Kind: "invalid-kind"}},
+				},
+				PluginConfig: map[string]any{"image": "example.com/image:v1"},
+			},
+		}}
+
+		Expect(sc.Scaffold(fs)).To(MatchError(ContainSubstring(`invalid config patch: invalid resource "invalid-kind"`)))
+		Expect(cfg.ResourcesLength()).To(Equal(0))
+		Expect(cfg.DecodePluginConfig(pluginKey, &map[string]any{})).
+			To(MatchError(config.PluginKeyNotFoundError{Key: pluginKey}))
+	})
+})
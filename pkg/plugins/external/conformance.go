@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var supportedFlagTypes = []string{
+	flagTypeString, flagTypeBool, flagTypeInt, flagTypeFloat,
+	flagTypeDuration, flagTypeStringSlice, flagTypeStringArray,
+}
+
+// requestArgs maps the name of the subcommands to the argument sent in the flags and metadata requests
+var requestArgs = map[string]string{
+	plugin.InitSubcommandName:          "--init",
+	plugin.CreateAPISubcommandName:     "--api",
+	plugin.CreateWebhookSubcommandName: "--webhook",
+	plugin.EditSubcommandName:          "--edit",
+}
+
+// ConformanceProblem is a difference between the behavior of an external plugin and the protocol.
+type ConformanceProblem struct {
+	// Subcommand is the subcommand the problem was found for, if any.
+	Subcommand string `json:"subcommand,omitempty"`
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// CheckConformance executes the plugin at path with the requests Kubebuilder sends to discover the flags
+// and metadata of each subcommand, and returns the problems found in the plugin and its responses.
+// An error is only returned if the plugin cannot be checked at all.
+func CheckConformance(fs afero.Fs, path string) ([]ConformanceProblem, error) {
+	info, err := fs.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("error checking external plugin %q: %w", path, err)
+	}
+	if info.IsDir() || info.Mode()&0o111 == 0 {
+		return []ConformanceProblem{{Message: "the plugin is not an executable file"}}, nil
+	}
+
+	var problems []ConformanceProblem
+	p := Plugin{Path: path}
+	if err = p.LoadManifest(fs); err != nil {
+		problems = append(problems, ConformanceProblem{Message: err.Error()})
+	}
+
+	for _, subcommand := range subcommandNames {
+		if !p.ProvidesSubcommand(subcommand) {
+			continue
+		}
+		for _, message := range checkSubcommandConformance(path, subcommand) {
+			problems = append(problems, ConformanceProblem{Subcommand: subcommand, Message: message})
+		}
+	}
+
+	return problems, nil
+}
+
+// checkSubcommandConformance sends the flags and metadata requests of a subcommand to the plugin
+// and returns the problems found in the responses
+func checkSubcommandConformance(path, subcommand string) []string {
+	var problems []string
+
+	res, err := conformanceRequest(path, "flags", requestArgs[subcommand])
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("flags request: %v", err))
+	} else {
+		problems = append(problems, checkFlagsResponse(res)...)
+	}
+
+	if _, err = conformanceRequest(path, "metadata", requestArgs[subcommand]); err != nil {
+		problems = append(problems, fmt.Sprintf("metadata request: %v", err))
+	}
+
+	return problems
+}
+
+// conformanceRequest sends a request to the plugin and strictly decodes its response
+func conformanceRequest(path, command, arg string) (*external.PluginResponse, error) {
+	req, err := json.Marshal(external.PluginRequest{
+		APIVersion: defaultAPIVersion,
+		Command:    command,
+		Args:       []string{arg},
+		Universe:   map[string]string{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	out, err := outputGetter.GetExecOutput(req, path)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	res := &external.PluginResponse{}
+	dec := json.NewDecoder(bytes.NewReader(out))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(res); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid response: unexpected output after the response")
+	}
+	if res.Error {
+		return nil, fmt.Errorf("the plugin returned an error: %s", strings.Join(res.ErrorMsgs, "; "))
+	}
+	switch res.APIVersion {
+	case "", external.APIVersionV1Alpha1, external.APIVersionV2Alpha1:
+	default:
+		return nil, fmt.Errorf("unsupported apiVersion %q", res.APIVersion)
+	}
+
+	return res, nil
+}
+
+// checkFlagsResponse returns the problems found in the flags declared by the plugin
+func checkFlagsResponse(res *external.PluginResponse) []string {
+	var problems []string
+
+	if res.UniverseFilter != nil {
+		if res.APIVersion != external.APIVersionV2Alpha1 {
+			problems = append(problems, fmt.Sprintf("universeFilter is only used with apiVersion %q",
+				external.APIVersionV2Alpha1))
+		}
+		if err := validatePatterns(slices.Concat(res.UniverseFilter.Include, res.UniverseFilter.Exclude)); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid universeFilter pattern %v", err))
+		}
+	}
+
+	names := make(map[string]struct{}, len(res.Flags))
+	for _, flag := range res.Flags {
+		if flag.Name == "" {
+			problems = append(problems, "a flag has no name")
+			continue
+		}
+		if _, duplicated := names[flag.Name]; duplicated {
+			problems = append(problems, fmt.Sprintf("flag %q is declared more than once", flag.Name))
+		}
+		names[flag.Name] = struct{}{}
+
+		if !gvkFlagFilter(flag) || !helpFlagFilter(flag) {
+			problems = append(problems, fmt.Sprintf("flag %q is reserved by Kubebuilder and ignored", flag.Name))
+		}
+		problems = append(problems, checkFlag(flag)...)
+	}
+
+	return problems
+}
+
+// checkFlag returns the problems found in the type, default value and options of a flag
+func checkFlag(flag external.Flag) []string {
+	if flag.Type != "" && !slices.Contains(supportedFlagTypes, flag.Type) {
+		return []string{fmt.Sprintf("flag %q has the unsupported type %q", flag.Name, flag.Type)}
+	}
+
+	var problems []string
+	if flag.Default != "" {
+		var err error
+		switch flag.Type {
+		case flagTypeBool:
+			_, err = strconv.ParseBool(flag.Default)
+		case flagTypeInt:
+			_, err = strconv.Atoi(flag.Default)
+		case flagTypeFloat:
+			_, err = strconv.ParseFloat(flag.Default, 64)
+		case flagTypeDuration:
+			_, err = time.ParseDuration(flag.Default)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("flag %q has an invalid default value %q for its type %q",
+				flag.Name, flag.Default, flag.Type))
+		}
+	}
+
+	if len(flag.Options) != 0 && flag.Default != "" {
+		defaults := []string{flag.Default}
+		if flag.Type == flagTypeStringSlice || flag.Type == flagTypeStringArray {
+			defaults = splitFlagDefault(flag.Default)
+		}
+		for _, value := range defaults {
+			if !slices.Contains(flag.Options, value) {
+				problems = append(problems, fmt.Sprintf("flag %q has the default value %q that is not one of its options",
+					flag.Name, value))
+			}
+		}
+	}
+
+	return problems
+}
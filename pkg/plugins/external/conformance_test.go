@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var _ = Describe("CheckConformance", func() {
+	const (
+		pluginPath   = "plugins/sample/v1/sample"
+		manifestPath = "plugins/sample/v1/plugin.yaml"
+	)
+
+	var (
+		fs     afero.Fs
+		getter *mockSequenceOutputGetter
+	)
+
+	BeforeEach(func() {
+		fs = afero.NewMemMapFs()
+		Expect(afero.WriteFile(fs, pluginPath, []byte("#!/bin/sh"), 0o755)).To(Succeed())
+		Expect(afero.WriteFile(fs, manifestPath, []byte("subcommands: [edit]\n"), 0o644)).To(Succeed())
+
+		getter = &mockSequenceOutputGetter{}
+		outputGetter = getter
+	})
+
+	AfterEach(func() {
+		outputGetter = &execOutputGetter{}
+	})
+
+	It("should not report problems for a conforming plugin", func() {
+		getter.responses = []external.PluginResponse{
+			{
+				APIVersion: external.APIVersionV2Alpha1,
+				Command:    "flags",
+				Flags: []external.Flag{
+					{Name: "mode", Type: "string", Default: "fast", Options: []string{"fast", "safe"}},
+					{Name: "timeout", Type: "duration", Default: "30s"},
+				},
+				UniverseFilter: &external.UniverseFilter{Include: []string{"api/**"}},
+			},
+			{APIVersion: external.APIVersionV2Alpha1, Command: "metadata"},
+		}
+
+		problems, err := CheckConformance(fs, pluginPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(problems).To(BeEmpty())
+
+		Expect(getter.requests).To(HaveLen(2))
+		Expect(getter.requests[0].Command).To(Equal("flags"))
+		Expect(getter.requests[0].Args).To(Equal([]string{"--edit"}))
+		Expect(getter.requests[1].Command).To(Equal("metadata"))
+	})
+
+	It("should report the problems found in the responses", func() {
+		getter.responses = []external.PluginResponse{
+			{
+				APIVersion: external.APIVersionV1Alpha1,
+				Command:    "flags",
+				Flags: []external.Flag{
+					{Name: "mode", Type: "enum"},
+					{Name: "replicas", Type: "int", Default: "many"},
+					{Name: "replicas", Type: "int"},
+					{Name: "group"},
+					{Name: "tier", Default: "gold", Options: []string{"free", "paid"}},
+				},
+				UniverseFilter: &external.UniverseFilter{Include: []string{"api/**"}},
+			},
+			{APIVersion: "v9", Command: "metadata"},
+		}
+
+		problems, err := CheckConformance(fs, pluginPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		messages := make([]string, 0, len(problems))
+		for _, problem := range problems {
+			Expect(problem.Subcommand).To(Equal(plugin.EditSubcommandName))
+			messages = append(messages, problem.Message)
+		}
+		Expect(messages).To(ConsistOf(
+			`universeFilter is only used with apiVersion "v2alpha1"`,
+			`flag "mode" has the unsupported type "enum"`,
+			`flag "replicas" has an invalid default value "many" for its type "int"`,
+			`flag "replicas" is declared more than once`,
+			`flag "group" is reserved by Kubebuilder and ignored`,
+			`flag "tier" has the default value "gold" that is not one of its options`,
+			`metadata request: unsupported apiVersion "v9"`,
+		))
+	})
+
+	It("should report plugin errors and invalid manifests", func() {
+		Expect(afero.WriteFile(fs, manifestPath, []byte("subcommands: [edit, delete]\n"), 0o644)).To(Succeed())
+		getter.responses = []external.PluginResponse{
+			{Command: "flags", Error: true, ErrorMsgs: []string{"boom"}},
+			{Command: "metadata"},
+			{Command: "flags"},
+			{Command: "metadata"},
+			{Command: "flags"},
+			{Command: "metadata"},
+			{Command: "flags"},
+			{Command: "metadata"},
+		}
+
+		problems, err := CheckConformance(fs, pluginPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(problems).To(HaveLen(2))
+		Expect(problems[0].Subcommand).To(BeEmpty())
+		Expect(problems[0].Message).To(ContainSubstring(`unknown subcommand "delete"`))
+		Expect(problems[1]).To(Equal(ConformanceProblem{
+			Subcommand: plugin.InitSubcommandName,
+			Message:    "flags request: the plugin returned an error: boom",
+		}))
+	})
+
+	It("should report plugins that are not executable", func() {
+		Expect(fs.Chmod(pluginPath, 0o644)).To(Succeed())
+
+		problems, err := CheckConformance(fs, pluginPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(problems).To(Equal([]ConformanceProblem{{Message: "the plugin is not an executable file"}}))
+		Expect(getter.requests).To(BeEmpty())
+	})
+
+	It("should fail if the plugin does not exist", func() {
+		_, err := CheckConformance(fs, "plugins/missing/v1/missing")
+		Expect(err).To(HaveOccurred())
+	})
+})
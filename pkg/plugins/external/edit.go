@@ -26,13 +26,19 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.EditSubcommand = &editSubcommand{}
+var (
+	_ plugin.EditSubcommand  = &editSubcommand{}
+	_ plugin.HasPreScaffold  = &editSubcommand{}
+	_ plugin.HasPostScaffold = &editSubcommand{}
+)
 
 type editSubcommand struct {
 	Path        string
 	Args        []string
+	pluginKey   string
 	pluginChain []string
 	config      config.Config
+	protocol    pluginProtocol
 }
 
 // InjectConfig injects the project configuration to access plugin chain information
@@ -65,21 +71,27 @@ func (p *editSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin
 }
 
 func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
-	bindExternalPluginFlags(fs, "edit", p.Path, p.Args)
+	p.protocol = bindExternalPluginFlags(fs, "edit", p.Path, p.Args)
+}
+
+func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
+	return makeHookRequest(rpcMethodPreScaffold, p.request(), p.Path, p.config)
 }
 
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return handlePluginResponse(fs, p.request(), p.Path, p.config, p.pluginKey, p.protocol)
+}
+
+func (p *editSubcommand) PostScaffold() error {
+	return makeHookRequest(rpcMethodPostScaffold, p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the plugin for the subcommand
+func (p *editSubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "edit",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
@@ -28,8 +28,10 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/yaml"
 
@@ -40,9 +42,13 @@ import (
 )
 
 const (
-	flagTypeBool   = "bool"
-	flagTypeString = "string"
-	flagTypeFloat  = "float"
+	flagTypeBool        = "bool"
+	flagTypeString      = "string"
+	flagTypeInt         = "int"
+	flagTypeFloat       = "float"
+	flagTypeDuration    = "duration"
+	flagTypeStringSlice = "stringSlice"
+	flagTypeStringArray = "stringArray"
 
 	flagNameGroup   = "group"
 	flagNameVersion = "version"
@@ -97,19 +103,32 @@ func (o *osWdGetter) GetCurrentDir() (string, error) {
 }
 
 func makePluginRequest(req external.PluginRequest, path string) (*external.PluginResponse, error) {
-	reqBytes, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling plugin request: %w", err)
-	}
+	var res *external.PluginResponse
+	if session := sessions.get(path); session != nil {
+		var err error
+		if res, err = session.call(rpcMethodFor(req.Command), req); err != nil {
+			return nil, fmt.Errorf("error executing plugin request: %w", err)
+		}
+	} else {
+		reqBytes, err := json.Marshal(req)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling plugin request: %w", err)
+		}
 
-	out, err := outputGetter.GetExecOutput(reqBytes, path)
-	if err != nil {
-		return nil, fmt.Errorf("error executing plugin request: %w", err)
-	}
+		out, err := outputGetter.GetExecOutput(reqBytes, path)
+		if err != nil {
+			return nil, fmt.Errorf("error executing plugin request: %w", err)
+		}
+
+		res = &external.PluginResponse{}
+		if err = json.Unmarshal(out, res); err != nil {
+			return nil, fmt.Errorf("error unmarshalling plugin response: %w", err)
+		}
 
-	res := external.PluginResponse{}
-	if err = json.Unmarshal(out, &res); err != nil {
-		return nil, fmt.Errorf("error unmarshalling plugin response: %w", err)
+		// Plugins supporting the persistent mode answer the next requests from a single process.
+		if res.RPC {
+			sessions.start(path)
+		}
 	}
 
 	// Error if the plugin failed.
@@ -117,7 +136,7 @@ func makePluginRequest(req external.PluginRequest, path string) (*external.Plugi
 		return nil, fmt.Errorf("%s", strings.Join(res.ErrorMsgs, "\n"))
 	}
 
-	return &res, nil
+	return res, nil
 }
 
 // getUniverseMap is a helper function that is used to read the current directory to build
@@ -163,35 +182,63 @@ func getUniverseMap(fs machinery.Filesystem) (map[string]string, error) {
 	return universe, nil
 }
 
-func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, path string, cfg config.Config) error {
-	var err error
+// getConfigMap returns the project configuration as a map to include it in the requests.
+// It returns nil if no configuration is provided.
+func getConfigMap(cfg config.Config) (map[string]any, error) {
+	if cfg == nil {
+		return nil, nil
+	}
 
-	req.Universe, err = getUniverseMap(fs)
+	configData, err := cfg.MarshalYAML()
 	if err != nil {
-		return fmt.Errorf("error getting universe map: %w", err)
+		return nil, fmt.Errorf("error marshaling config: %w", err)
 	}
 
+	var configMap map[string]any
+	if err = yaml.Unmarshal(configData, &configMap); err != nil {
+		return nil, fmt.Errorf("error unmarshaling config to map: %w", err)
+	}
+
+	return configMap, nil
+}
+
+func handlePluginResponse(
+	fs machinery.Filesystem,
+	req external.PluginRequest,
+	path string,
+	cfg config.Config,
+	pluginKey string,
+	protocol pluginProtocol,
+) error {
+	var err error
+
 	// Marshal config to include in the request if config is provided
-	if cfg != nil {
-		var configData []byte
-		configData, err = cfg.MarshalYAML()
-		if err != nil {
-			return fmt.Errorf("error marshaling config: %w", err)
-		}
+	req.Config, err = getConfigMap(cfg)
+	if err != nil {
+		return err
+	}
 
-		var configMap map[string]any
-		if err = yaml.Unmarshal(configData, &configMap); err != nil {
-			return fmt.Errorf("error unmarshaling config to map: %w", err)
+	var res *external.PluginResponse
+	if protocol.isV2() {
+		res, err = makePluginRequestV2(fs, req, path, protocol.universeFilter)
+	} else {
+		req.Universe, err = getUniverseMap(fs)
+		if err != nil {
+			return fmt.Errorf("error getting universe map: %w", err)
 		}
 
-		req.Config = configMap
+		res, err = makePluginRequest(req, path)
 	}
-
-	res, err := makePluginRequest(req, path)
 	if err != nil {
 		return fmt.Errorf("error making request to external plugin: %w", err)
 	}
 
+	if res.ConfigPatch != nil {
+		if err = applyConfigPatch(cfg, pluginKey, res.ConfigPatch); err != nil {
+			return err
+		}
+	}
+
 	currentDir, err := currentDirGetter.GetCurrentDir()
 	if err != nil {
 		return fmt.Errorf("error getting current directory: %w", err)
@@ -199,10 +246,15 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 
 	for filename, data := range res.Universe {
 		file := filepath.Join(currentDir, filename)
+		if protocol.isV2() {
+			if file, err = projectPath(currentDir, filename); err != nil {
+				return err
+			}
+		}
 		dir := filepath.Dir(file)
 
 		// create the directory if it does not exist
-		if err = os.MkdirAll(dir, 0o750); err != nil {
+		if err = fs.FS.MkdirAll(dir, 0o750); err != nil {
 			return fmt.Errorf("error creating the directory: %w", err)
 		}
 
@@ -220,20 +272,25 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 		}
 	}
 
+	if protocol.isV2() {
+		return applyFileOperations(fs, currentDir, res.Operations)
+	}
+
 	return nil
 }
 
 // getExternalPluginFlags is a helper function that is used to get a list of flags from an external plugin.
-// It will return []Flag if successful or an error if there is an issue attempting to get the list of flags.
-func getExternalPluginFlags(req external.PluginRequest, path string) ([]external.Flag, error) {
+// It will return []Flag and the protocol declared by the plugin if successful or an error if there is
+// an issue attempting to get the list of flags.
+func getExternalPluginFlags(req external.PluginRequest, path string) ([]external.Flag, pluginProtocol, error) {
 	req.Universe = map[string]string{}
 
 	res, err := makePluginRequest(req, path)
 	if err != nil {
-		return nil, fmt.Errorf("error making request to external plugin: %w", err)
+		return nil, newPluginProtocol(nil), fmt.Errorf("error making request to external plugin: %w", err)
 	}
 
-	return res.Flags, nil
+	return res.Flags, newPluginProtocol(res), nil
 }
 
 // isBooleanFlag is a helper function to determine if an argument flag is a boolean flag
@@ -270,16 +327,82 @@ func bindSpecificFlags(fs *pflag.FlagSet, flags []external.Flag) {
 		case flagTypeBool:
 			defaultValue, _ := strconv.ParseBool(flag.Default)
 			_ = fs.Bool(flag.Name, defaultValue, flag.Usage)
-		case "int":
+		case flagTypeInt:
 			defaultValue, _ := strconv.Atoi(flag.Default)
 			_ = fs.Int(flag.Name, defaultValue, flag.Usage)
 		case flagTypeFloat:
 			defaultValue, _ := strconv.ParseFloat(flag.Default, 64)
 			_ = fs.Float64(flag.Name, defaultValue, flag.Usage)
+		case flagTypeDuration:
+			defaultValue, _ := time.ParseDuration(flag.Default)
+			_ = fs.Duration(flag.Name, defaultValue, flag.Usage)
+		case flagTypeStringSlice:
+			_ = fs.StringSlice(flag.Name, splitFlagDefault(flag.Default), flag.Usage)
+		case flagTypeStringArray:
+			_ = fs.StringArray(flag.Name, splitFlagDefault(flag.Default), flag.Usage)
 		default:
 			_ = fs.String(flag.Name, flag.Default, flag.Usage)
 		}
+		setFlagMetadata(fs, flag)
+	}
+}
+
+// splitFlagDefault returns the comma-separated values of the default of a slice flag
+func splitFlagDefault(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
+
+// setFlagMetadata restricts the values of a bound flag to its options and sets
+// whether it is required, deprecated or hidden.
+func setFlagMetadata(fs *pflag.FlagSet, flag external.Flag) {
+	f := fs.Lookup(flag.Name)
+	if f == nil {
+		return
+	}
+
+	if len(flag.Options) != 0 {
+		f.Value = &optionsValue{Value: f.Value, options: flag.Options}
+		f.Usage = fmt.Sprintf("%s (one of: %s)", f.Usage, strings.Join(flag.Options, ", "))
+		_ = fs.SetAnnotation(f.Name, plugin.FlagValuesAnnotation, flag.Options)
+	}
+	if flag.Required {
+		f.Usage += " (required)"
+		_ = cobra.MarkFlagRequired(fs, f.Name)
+	}
+	if flag.Deprecated != "" {
+		_ = fs.MarkDeprecated(f.Name, flag.Deprecated)
+	}
+	if flag.Hidden {
+		_ = fs.MarkHidden(f.Name)
+	}
+}
+
+// optionsValue is a flag value that only accepts a set of options
+type optionsValue struct {
+	pflag.Value
+
+	options []string
+}
+
+// Set implements pflag.Value
+func (v *optionsValue) Set(value string) error {
+	if err := v.Value.Set(value); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	values := []string{v.Value.String()}
+	if slice, isSlice := v.Value.(pflag.SliceValue); isSlice {
+		values = slice.GetSlice()
+	}
+	for _, value := range values {
+		if !slices.Contains(v.options, value) {
+			return fmt.Errorf("%q is not one of: %s", value, strings.Join(v.options, ", "))
+		}
 	}
+	return nil
 }
 
 func filterFlags(flags []external.Flag, externalFlagFilters []externalFlagFilterFunc) []external.Flag {
@@ -346,7 +469,8 @@ var (
 	}
 )
 
-func bindExternalPluginFlags(fs *pflag.FlagSet, subcommand string, path string, args []string) {
+// bindExternalPluginFlags binds the flags of the subcommand and returns the protocol declared by the plugin.
+func bindExternalPluginFlags(fs *pflag.FlagSet, subcommand string, path string, args []string) pluginProtocol {
 	req := external.PluginRequest{
 		APIVersion: defaultAPIVersion,
 		Command:    "flags",
@@ -356,7 +480,7 @@ func bindExternalPluginFlags(fs *pflag.FlagSet, subcommand string, path string,
 	// Get a list of flags for the init subcommand of the external plugin
 	// If it returns an error, parse all flags passed by the user and let
 	// the external plugin return an unknown flag error.
-	flags, err := getExternalPluginFlags(req, path)
+	flags, protocol, err := getExternalPluginFlags(req, path)
 
 	// Filter Flags based on a set of filters that we do not want.
 	// can be used to filter out non-overridable flags or other
@@ -372,6 +496,8 @@ func bindExternalPluginFlags(fs *pflag.FlagSet, subcommand string, path string,
 			helpFlagFilter,
 		}))
 	}
+
+	return protocol
 }
 
 // setExternalPluginMetadata is a helper function that sets the subcommand
@@ -19,8 +19,10 @@ package external
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
@@ -81,6 +83,68 @@ var _ = Describe("helpers", func() {
 		})
 	})
 
+	Context("bindSpecificFlags", func() {
+		var fs *pflag.FlagSet
+
+		BeforeEach(func() {
+			fs = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		})
+
+		It("binds typed flags with their defaults", func() {
+			bindSpecificFlags(fs, []external.Flag{
+				{Name: "timeout", Type: flagTypeDuration, Default: "1m30s"},
+				{Name: "labels", Type: flagTypeStringSlice, Default: "a,b"},
+				{Name: "args", Type: flagTypeStringArray},
+			})
+
+			Expect(fs.Parse([]string{"--args", "x,y", "--args", "z"})).To(Succeed())
+
+			timeout, err := fs.GetDuration("timeout")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timeout.String()).To(Equal("1m30s"))
+			labels, err := fs.GetStringSlice("labels")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(Equal([]string{"a", "b"}))
+			args, err := fs.GetStringArray("args")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(Equal([]string{"x,y", "z"}))
+		})
+
+		It("rejects invalid values of typed flags", func() {
+			bindSpecificFlags(fs, []external.Flag{{Name: "timeout", Type: flagTypeDuration}})
+
+			Expect(fs.Parse([]string{"--timeout", "soon"})).NotTo(Succeed())
+		})
+
+		It("only accepts the options of enum flags", func() {
+			bindSpecificFlags(fs, []external.Flag{
+				{Name: "mode", Type: flagTypeString, Usage: "the mode", Options: []string{"fast", "safe"}},
+				{Name: "features", Type: flagTypeStringSlice, Options: []string{"a", "b"}},
+			})
+
+			Expect(fs.Lookup("mode").Usage).To(Equal("the mode (one of: fast, safe)"))
+			Expect(fs.Lookup("mode").Annotations).To(HaveKeyWithValue(plugin.FlagValuesAnnotation, []string{"fast", "safe"}))
+
+			Expect(fs.Parse([]string{"--mode", "safe", "--features", "b,a"})).To(Succeed())
+			Expect(fs.Parse([]string{"--mode", "slow"})).To(MatchError(ContainSubstring(`"slow" is not one of: fast, safe`)))
+			Expect(fs.Parse([]string{"--features", "a,c"})).To(MatchError(ContainSubstring(`"c" is not one of: a, b`)))
+		})
+
+		It("sets whether flags are required, deprecated or hidden", func() {
+			bindSpecificFlags(fs, []external.Flag{
+				{Name: "name", Type: flagTypeString, Usage: "the name", Required: true},
+				{Name: "old", Type: flagTypeString, Deprecated: "use --name instead"},
+				{Name: "internal", Type: flagTypeBool, Hidden: true},
+			})
+
+			Expect(fs.Lookup("name").Usage).To(Equal("the name (required)"))
+			Expect(fs.Lookup("name").Annotations).To(HaveKey(cobra.BashCompOneRequiredFlag))
+			Expect(fs.Lookup("old").Deprecated).To(Equal("use --name instead"))
+			Expect(fs.Lookup("old").Hidden).To(BeTrue())
+			Expect(fs.Lookup("internal").Hidden).To(BeTrue())
+		})
+	})
+
 	Context("filterFlags", func() {
 		var testFlags []external.Flag
 
@@ -26,13 +26,19 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.InitSubcommand = &initSubcommand{}
+var (
+	_ plugin.InitSubcommand  = &initSubcommand{}
+	_ plugin.HasPreScaffold  = &initSubcommand{}
+	_ plugin.HasPostScaffold = &initSubcommand{}
+)
 
 type initSubcommand struct {
 	Path        string
 	Args        []string
+	pluginKey   string
 	pluginChain []string
 	config      config.Config
+	protocol    pluginProtocol
 }
 
 // InjectConfig injects the project configuration to access plugin chain information
@@ -65,21 +71,27 @@ func (p *initSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin
 }
 
 func (p *initSubcommand) BindFlags(fs *pflag.FlagSet) {
-	bindExternalPluginFlags(fs, "init", p.Path, p.Args)
+	p.protocol = bindExternalPluginFlags(fs, "init", p.Path, p.Args)
+}
+
+func (p *initSubcommand) PreScaffold(machinery.Filesystem) error {
+	return makeHookRequest(rpcMethodPreScaffold, p.request(), p.Path, p.config)
 }
 
 func (p *initSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return handlePluginResponse(fs, p.request(), p.Path, p.config, p.pluginKey, p.protocol)
+}
+
+func (p *initSubcommand) PostScaffold() error {
+	return makeHookRequest(rpcMethodPostScaffold, p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the plugin for the subcommand
+func (p *initSubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "init",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
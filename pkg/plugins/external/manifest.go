@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"golang.org/x/mod/semver"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var subcommandNames = []string{
+	plugin.InitSubcommandName,
+	plugin.CreateAPISubcommandName,
+	plugin.CreateWebhookSubcommandName,
+	plugin.EditSubcommandName,
+}
+
+// LoadManifest reads the optional manifest located next to the plugin executable and applies it to the plugin.
+// Nothing is changed if there is no manifest.
+func (p *Plugin) LoadManifest(fs afero.Fs) error {
+	path := filepath.Join(filepath.Dir(p.Path), external.ManifestFileName)
+	content, err := afero.ReadFile(fs, path)
+	if errors.Is(err, iofs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading external plugin manifest %q: %w", path, err)
+	}
+
+	var manifest external.Manifest
+	if err = yaml.UnmarshalStrict(content, &manifest); err != nil {
+		return fmt.Errorf("error parsing external plugin manifest %q: %w", path, err)
+	}
+	if err = p.applyManifest(manifest); err != nil {
+		return fmt.Errorf("invalid external plugin manifest %q: %w", path, err)
+	}
+	return nil
+}
+
+// applyManifest validates the manifest and sets the plugin fields it declares
+func (p *Plugin) applyManifest(manifest external.Manifest) error {
+	if len(manifest.ProjectVersions) != 0 {
+		versions := make([]config.Version, 0, len(manifest.ProjectVersions))
+		for _, value := range manifest.ProjectVersions {
+			var version config.Version
+			if err := version.Parse(value); err != nil {
+				return fmt.Errorf("invalid project version %q: %w", value, err)
+			}
+			versions = append(versions, version)
+		}
+		p.PSupportedProjectVersions = versions
+	}
+
+	if manifest.Subcommands != nil {
+		for _, name := range manifest.Subcommands {
+			if !slices.Contains(subcommandNames, name) {
+				return fmt.Errorf("unknown subcommand %q, must be one of: %s", name, strings.Join(subcommandNames, ", "))
+			}
+		}
+		p.PSubcommands = append([]string{}, manifest.Subcommands...)
+	}
+
+	if manifest.MinCLIVersion != "" && !semver.IsValid(canonicalVersion(manifest.MinCLIVersion)) {
+		return fmt.Errorf("invalid minimum CLI version %q", manifest.MinCLIVersion)
+	}
+	p.MinCLIVersion = manifest.MinCLIVersion
+
+	p.PDescription = manifest.Description
+	p.PDeprecationWarning = manifest.Deprecation
+	return nil
+}
+
+// SupportsCLIVersion returns true if the plugin works with the given version of the CLI.
+// Versions that are not semantic versions, such as development builds, are always supported.
+func (p Plugin) SupportsCLIVersion(version string) bool {
+	if p.MinCLIVersion == "" || !semver.IsValid(canonicalVersion(version)) {
+		return true
+	}
+	return semver.Compare(canonicalVersion(version), canonicalVersion(p.MinCLIVersion)) >= 0
+}
+
+// canonicalVersion adds the "v" prefix required by the semver package
+func canonicalVersion(version string) string {
+	return "v" + strings.TrimPrefix(version, "v")
+}
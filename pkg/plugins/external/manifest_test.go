@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+var _ = Describe("Manifest", func() {
+	const manifestPath = "plugins/sample/v1/plugin.yaml"
+
+	var (
+		fs afero.Fs
+		p  Plugin
+	)
+
+	BeforeEach(func() {
+		fs = afero.NewMemMapFs()
+		p = Plugin{
+			PName:                     "sample",
+			PSupportedProjectVersions: []config.Version{cfgv3.Version},
+			Path:                      "plugins/sample/v1/sample",
+		}
+	})
+
+	It("should keep the defaults if there is no manifest", func() {
+		Expect(p.LoadManifest(fs)).To(Succeed())
+
+		Expect(p.SupportedProjectVersions()).To(Equal([]config.Version{cfgv3.Version}))
+		Expect(p.ProvidesSubcommand(plugin.CreateWebhookSubcommandName)).To(BeTrue())
+		Expect(p.Description()).To(BeEmpty())
+	})
+
+	It("should apply the manifest", func() {
+		Expect(afero.WriteFile(fs, manifestPath, []byte(`description: Adds monitoring
+projectVersions: ["3"]
+subcommands: [init, edit]
+deprecation: use sample/v2 instead
+minCLIVersion: v4.6.0
+`), 0o644)).To(Succeed())
+
+		Expect(p.LoadManifest(fs)).To(Succeed())
+
+		Expect(p.Description()).To(Equal("Adds monitoring"))
+		Expect(p.DeprecationWarning()).To(Equal("use sample/v2 instead"))
+		Expect(p.ProvidesSubcommand(plugin.InitSubcommandName)).To(BeTrue())
+		Expect(p.ProvidesSubcommand(plugin.CreateAPISubcommandName)).To(BeFalse())
+		Expect(p.MinCLIVersion).To(Equal("v4.6.0"))
+
+		_, ok := plugin.AsCreateAPI(p)
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable("should reject invalid manifests",
+		func(content, message string) {
+			Expect(afero.WriteFile(fs, manifestPath, []byte(content), 0o644)).To(Succeed())
+			Expect(p.LoadManifest(fs)).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("unknown field", "subcomands: [init]\n", "unknown field"),
+		Entry("unknown subcommand", "subcommands: [delete]\n", `unknown subcommand "delete"`),
+		Entry("invalid project version", "projectVersions: [three]\n", `invalid project version "three"`),
+		Entry("invalid minimum CLI version", "minCLIVersion: latest\n", `invalid minimum CLI version "latest"`),
+	)
+
+	DescribeTable("SupportsCLIVersion",
+		func(minVersion, cliVersion string, supported bool) {
+			p.MinCLIVersion = minVersion
+			Expect(p.SupportsCLIVersion(cliVersion)).To(Equal(supported))
+		},
+		Entry("no minimum version", "", "4.0.0", true),
+		Entry("newer CLI", "v4.6.0", "4.7.1", true),
+		Entry("same version", "4.6.0", "v4.6.0", true),
+		Entry("older CLI", "v4.6.0", "4.5.2", false),
+		Entry("development build", "v4.6.0", "(devel)", true),
+	)
+})
@@ -17,17 +17,29 @@ limitations under the License.
 package external
 
 import (
+	"slices"
+
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
 
-var _ plugin.Full = Plugin{}
+var (
+	_ plugin.Full               = Plugin{}
+	_ plugin.SubcommandSelector = Plugin{}
+	_ plugin.Describable        = Plugin{}
+)
 
 // Plugin implements the plugin.Full interface
 type Plugin struct {
 	PName                     string
 	PVersion                  plugin.Version
 	PSupportedProjectVersions []config.Version
+	PDescription              string
+	PDeprecationWarning       string
+	// PSubcommands are the names of the subcommands implemented by the plugin, or nil if it implements all of them
+	PSubcommands []string
+	// MinCLIVersion is the minimum version of the CLI required by the plugin, if any
+	MinCLIVersion string
 
 	Path string
 	Args []string
@@ -42,39 +54,51 @@ func (p Plugin) Version() plugin.Version { return p.PVersion }
 // SupportedProjectVersions returns an array with all project versions supported by the plugin
 func (p Plugin) SupportedProjectVersions() []config.Version { return p.PSupportedProjectVersions }
 
+// Description returns a short description of the plugin
+func (p Plugin) Description() string { return p.PDescription }
+
+// ProvidesSubcommand returns true if the plugin implements the subcommand
+func (p Plugin) ProvidesSubcommand(name string) bool {
+	return p.PSubcommands == nil || slices.Contains(p.PSubcommands, name)
+}
+
 // GetInitSubcommand will return the subcommand which is responsible for initializing and common scaffolding
 func (p Plugin) GetInitSubcommand() plugin.InitSubcommand {
 	return &initSubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:      p.Path,
+		Args:      p.Args,
+		pluginKey: plugin.KeyFor(p),
 	}
 }
 
 // GetCreateAPISubcommand will return the subcommand which is responsible for scaffolding apis
 func (p Plugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand {
 	return &createAPISubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:      p.Path,
+		Args:      p.Args,
+		pluginKey: plugin.KeyFor(p),
 	}
 }
 
 // GetCreateWebhookSubcommand will return the subcommand which is responsible for scaffolding webhooks
 func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
 	return &createWebhookSubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:      p.Path,
+		Args:      p.Args,
+		pluginKey: plugin.KeyFor(p),
 	}
 }
 
 // GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
 func (p Plugin) GetEditSubcommand() plugin.EditSubcommand {
 	return &editSubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:      p.Path,
+		Args:      p.Args,
+		pluginKey: plugin.KeyFor(p),
 	}
 }
 
 // DeprecationWarning define the deprecation message or return empty when plugin is not deprecated
 func (p Plugin) DeprecationWarning() string {
-	return ""
+	return p.PDeprecationWarning
 }
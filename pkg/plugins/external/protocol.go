@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"fmt"
+	iofs "io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// maxFileRequestRounds limits how many times a request is sent again with the files requested by the plugin
+const maxFileRequestRounds = 10
+
+// pluginProtocol is the protocol negotiated with an external plugin for a subcommand
+type pluginProtocol struct {
+	// apiVersion is the version of PluginRequest and PluginResponse understood by the plugin
+	apiVersion string
+	// universeFilter selects the files sent in the universe for the v2alpha1 APIVersion
+	universeFilter *external.UniverseFilter
+}
+
+// newPluginProtocol returns the protocol declared by the plugin in its response to the `flags` command.
+// Plugins that do not declare the v2alpha1 APIVersion use the v1alpha1 one.
+func newPluginProtocol(res *external.PluginResponse) pluginProtocol {
+	if res == nil || res.APIVersion != external.APIVersionV2Alpha1 {
+		return pluginProtocol{apiVersion: defaultAPIVersion}
+	}
+
+	return pluginProtocol{apiVersion: external.APIVersionV2Alpha1, universeFilter: res.UniverseFilter}
+}
+
+// isV2 returns true if the plugin understands the v2alpha1 APIVersion
+func (p pluginProtocol) isV2() bool {
+	return p.apiVersion == external.APIVersionV2Alpha1
+}
+
+// makePluginRequestV2 sends a v2alpha1 request with the files selected by the filter, and sends it
+// again with the files requested by the plugin until it returns a complete response.
+func makePluginRequestV2(
+	fs machinery.Filesystem,
+	req external.PluginRequest,
+	pluginPath string,
+	filter *external.UniverseFilter,
+) (*external.PluginResponse, error) {
+	var include, exclude []string
+	if filter != nil {
+		include, exclude = filter.Include, filter.Exclude
+	}
+	if err := validatePatterns(slices.Concat(include, exclude)); err != nil {
+		return nil, fmt.Errorf("invalid universe filter: %w", err)
+	}
+
+	var err error
+	req.APIVersion = external.APIVersionV2Alpha1
+	req.Files, req.Universe, err = getFilteredUniverse(fs, include, exclude)
+	if err != nil {
+		return nil, fmt.Errorf("error getting universe map: %w", err)
+	}
+
+	for range maxFileRequestRounds {
+		res, err := makePluginRequest(req, pluginPath)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.FileRequests) == 0 {
+			return res, nil
+		}
+
+		if err := validatePatterns(res.FileRequests); err != nil {
+			return nil, fmt.Errorf("invalid file request: %w", err)
+		}
+		added := 0
+		for _, file := range req.Files {
+			if _, found := req.Universe[file]; found || !matchAny(res.FileRequests, file) {
+				continue
+			}
+			content, err := afero.ReadFile(fs.FS, filepath.FromSlash(file))
+			if err != nil {
+				return nil, fmt.Errorf("error reading file %q: %w", file, err)
+			}
+			req.Universe[file] = string(content)
+			added++
+		}
+		if added == 0 {
+			return nil, fmt.Errorf("external plugin requested files that are not available: %s",
+				strings.Join(res.FileRequests, ", "))
+		}
+	}
+
+	return nil, fmt.Errorf("external plugin kept requesting files after %d requests", maxFileRequestRounds)
+}
+
+// getFilteredUniverse returns the slash-separated paths of the files that are not excluded,
+// and the content of the ones that are included.
+func getFilteredUniverse(fs machinery.Filesystem, include, exclude []string) ([]string, map[string]string, error) {
+	var files []string
+	universe := map[string]string{}
+
+	err := afero.Walk(fs.FS, ".", func(walkPath string, info iofs.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("error walking path %q: %w", walkPath, err)
+		}
+
+		file := filepath.ToSlash(walkPath)
+		if info.IsDir() {
+			if file != "." && matchAny(exclude, file) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if matchAny(exclude, file) {
+			return nil
+		}
+
+		files = append(files, file)
+		if !matchAny(include, file) {
+			return nil
+		}
+
+		content, err := afero.ReadFile(fs.FS, walkPath)
+		if err != nil {
+			return fmt.Errorf("error reading file %q: %w", walkPath, err)
+		}
+		universe[file] = string(content)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error walking the directory: %w", err)
+	}
+
+	return files, universe, nil
+}
+
+// validatePatterns returns an error if any of the glob patterns is malformed
+func validatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		for _, element := range strings.Split(pattern, "/") {
+			if _, err := path.Match(element, ""); err != nil {
+				return fmt.Errorf("%q: %w", pattern, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchAny returns true if the slash-separated path matches any of the patterns
+func matchAny(patterns []string, name string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		return matchElements(strings.Split(pattern, "/"), strings.Split(name, "/"))
+	})
+}
+
+// matchElements matches the path elements against the pattern elements, where "**" matches
+// any number of path elements.
+func matchElements(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := range len(name) + 1 {
+				if matchElements(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if matched, err := path.Match(pattern[0], name[0]); err != nil || !matched {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// projectPath returns the location of a path sent by the plugin, which must be relative to the project root
+func projectPath(currentDir, file string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(file)) {
+		return "", fmt.Errorf("invalid path %q: it must be relative to the project root", file)
+	}
+
+	return filepath.Join(currentDir, filepath.FromSlash(file)), nil
+}
+
+// applyFileOperations applies the delete, rename and chmod operations returned by the plugin
+func applyFileOperations(fs machinery.Filesystem, currentDir string, operations []external.FileOperation) error {
+	for _, operation := range operations {
+		file, err := projectPath(currentDir, operation.Path)
+		if err != nil {
+			return err
+		}
+
+		switch operation.Type {
+		case external.FileOperationDelete:
+			if err := fs.FS.RemoveAll(file); err != nil {
+				return fmt.Errorf("error deleting %q: %w", file, err)
+			}
+		case external.FileOperationRename:
+			target, err := projectPath(currentDir, operation.NewPath)
+			if err != nil {
+				return err
+			}
+			if err := fs.FS.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+				return fmt.Errorf("error creating the directory: %w", err)
+			}
+			if err := fs.FS.Rename(file, target); err != nil {
+				return fmt.Errorf("error renaming %q to %q: %w", file, target, err)
+			}
+		case external.FileOperationChmod:
+			mode, err := strconv.ParseUint(operation.Mode, 8, 32)
+			if err != nil || mode > uint64(os.ModePerm) {
+				return fmt.Errorf("invalid mode %q for %q: it must be an octal permission such as 0644",
+					operation.Mode, file)
+			}
+			if err := fs.FS.Chmod(file, os.FileMode(mode)); err != nil {
+				return fmt.Errorf("error changing the mode of %q: %w", file, err)
+			}
+		default:
+			return fmt.Errorf("unknown operation %q for %q", operation.Type, file)
+		}
+	}
+
+	return nil
+}
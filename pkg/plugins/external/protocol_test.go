@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// mockSequenceOutputGetter records the requests and returns the responses in order.
+type mockSequenceOutputGetter struct {
+	requests  []external.PluginRequest
+	responses []external.PluginResponse
+}
+
+var _ ExecOutputGetter = &mockSequenceOutputGetter{}
+
+func (m *mockSequenceOutputGetter) GetExecOutput(request []byte, _ string) ([]byte, error) {
+	var req external.PluginRequest
+	if err := json.Unmarshal(request, &req); err != nil {
+		return nil, fmt.Errorf("error unmarshalling request: %w", err)
+	}
+	m.requests = append(m.requests, req)
+
+	if len(m.responses) == 0 {
+		return nil, fmt.Errorf("unexpected request")
+	}
+	res := m.responses[0]
+	m.responses = m.responses[1:]
+
+	out, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling response: %w", err)
+	}
+	return out, nil
+}
+
+type mockRootOsWdGetter struct{}
+
+var _ OsWdGetter = &mockRootOsWdGetter{}
+
+func (m *mockRootOsWdGetter) GetCurrentDir() (string, error) {
+	return "", nil
+}
+
+var _ = Describe("Protocol v2alpha1", func() {
+	const pluginPath = "plugin.sh"
+
+	var (
+		fs     machinery.Filesystem
+		getter *mockSequenceOutputGetter
+	)
+
+	BeforeEach(func() {
+		getter = &mockSequenceOutputGetter{}
+		outputGetter = getter
+		currentDirGetter = &mockRootOsWdGetter{}
+
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		for path, content := range map[string]string{
+			"main.go":           "package main\n",
+			"vendor/lib/lib.go": "package lib\n",
+			"config/a.yaml":     "a: b\n",
+			"old.txt":           "old\n",
+			"hack/script.sh":    "#!/bin/sh\n",
+		} {
+			Expect(afero.WriteFile(fs.FS, path, []byte(content), machinery.DefaultFilePermission)).To(Succeed())
+		}
+	})
+
+	It("should negotiate the protocol with the response to the flags command", func() {
+		filter := &external.UniverseFilter{Include: []string{"*.go"}}
+		getter.responses = []external.PluginResponse{{
+			APIVersion:     external.APIVersionV2Alpha1,
+			UniverseFilter: filter,
+		}}
+
+		sc := editSubcommand{Path: pluginPath}
+		sc.BindFlags(pflag.NewFlagSet("test", pflag.ContinueOnError))
+
+		Expect(sc.protocol.isV2()).To(BeTrue())
+		Expect(sc.protocol.universeFilter).To(Equal(filter))
+	})
+
+	It("should keep using v1alpha1 for plugins that do not declare v2alpha1", func() {
+		getter.responses = []external.PluginResponse{{APIVersion: defaultAPIVersion}}
+
+		sc := editSubcommand{Path: pluginPath}
+		sc.BindFlags(pflag.NewFlagSet("test", pflag.ContinueOnError))
+
+		Expect(sc.protocol.isV2()).To(BeFalse())
+		Expect(sc.protocol.apiVersion).To(Equal(defaultAPIVersion))
+	})
+
+	It("should send the filtered universe, the requested files, and apply the operations", func() {
+		getter.responses = []external.PluginResponse{
+			{FileRequests: []string{"config/**"}},
+			{
+				Universe: map[string]string{"config/b.yaml": "b: c\n"},
+				Operations: []external.FileOperation{
+					{Type: external.FileOperationDelete, Path: "config/a.yaml"},
+					{Type: external.FileOperationRename, Path: "old.txt", NewPath: "docs/new.txt"},
+					{Type: external.FileOperationChmod, Path: "hack/script.sh", Mode: "0755"},
+				},
+			},
+		}
+
+		sc := editSubcommand{
+			Path: pluginPath,
+			protocol: pluginProtocol{
+				apiVersion: external.APIVersionV2Alpha1,
+				universeFilter: &external.UniverseFilter{
+					Include: []string{"**/*.go"},
+					Exclude: []string{"vendor"},
+				},
+			},
+		}
+		Expect(sc.Scaffold(fs)).To(Succeed())
+
+		Expect(getter.requests).To(HaveLen(2))
+		Expect(getter.requests[0].APIVersion).To(Equal(external.APIVersionV2Alpha1))
+		Expect(getter.requests[0].Files).To(ConsistOf("main.go", "config/a.yaml", "old.txt", "hack/script.sh"))
+		Expect(getter.requests[0].Universe).To(Equal(map[string]string{"main.go": "package main\n"}))
+		Expect(getter.requests[1].Universe).To(Equal(map[string]string{
+			"main.go":       "package main\n",
+			"config/a.yaml": "a: b\n",
+		}))
+
+		content, err := afero.ReadFile(fs.FS, "config/b.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("b: c\n"))
+
+		exists, err := afero.Exists(fs.FS, "config/a.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		exists, err = afero.Exists(fs.FS, "old.txt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		content, err = afero.ReadFile(fs.FS, "docs/new.txt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("old\n"))
+
+		info, err := fs.FS.Stat("hack/script.sh")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(BeEquivalentTo(0o755))
+	})
+
+	It("should fail if the plugin requests files that do not exist", func() {
+		getter.responses = []external.PluginResponse{{FileRequests: []string{"missing.txt"}}}
+
+		sc := editSubcommand{Path: pluginPath, protocol: pluginProtocol{apiVersion: external.APIVersionV2Alpha1}}
+		Expect(sc.Scaffold(fs)).To(MatchError(ContainSubstring("requested files that are not available: missing.txt")))
+	})
+
+	It("should reject operations outside of the project", func() {
+		getter.responses = []external.PluginResponse{{
+			Operations: []external.FileOperation{{Type: external.FileOperationDelete, Path: "../outside"}},
+		}}
+
+		sc := editSubcommand{Path: pluginPath, protocol: pluginProtocol{apiVersion: external.APIVersionV2Alpha1}}
+		Expect(sc.Scaffold(fs)).To(MatchError(ContainSubstring(`invalid path "../outside"`)))
+	})
+
+	DescribeTable("matchAny should match glob patterns against slash-separated paths",
+		func(pattern, name string, matched bool) {
+			Expect(matchAny([]string{pattern}, name)).To(Equal(matched))
+		},
+		Entry("exact path", "config/a.yaml", "config/a.yaml", true),
+		Entry("wildcard in one element", "config/*.yaml", "config/a.yaml", true),
+		Entry("wildcard does not cross directories", "*.yaml", "config/a.yaml", false),
+		Entry("double star matches any depth", "**/*.yaml", "config/rbac/role.yaml", true),
+		Entry("double star matches no directory", "**/*.yaml", "a.yaml", true),
+		Entry("trailing double star matches the content of a directory", "vendor/**", "vendor/lib/lib.go", true),
+		Entry("different directory", "api/**", "internal/a.go", false),
+	)
+})
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	log "log/slog"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+const (
+	// rpcArg is the argument used to start a plugin in the persistent mode
+	rpcArg = "--rpc"
+
+	jsonRPCVersion = "2.0"
+
+	rpcMethodFlags        = "flags"
+	rpcMethodMetadata     = "metadata"
+	rpcMethodPreScaffold  = "preScaffold"
+	rpcMethodScaffold     = "scaffold"
+	rpcMethodPostScaffold = "postScaffold"
+	rpcMethodLog          = "log"
+)
+
+var sessionStarter SessionStarter = &execSessionStarter{}
+
+// SessionStarter is an interface that implements the method to start a plugin in the persistent mode.
+type SessionStarter interface {
+	// StartSession starts the plugin and returns a connection to its stdin and stdout.
+	// Closing the connection stops the plugin.
+	StartSession(path string) (io.ReadWriteCloser, error)
+}
+
+type execSessionStarter struct{}
+
+func (e *execSessionStarter) StartSession(path string) (io.ReadWriteCloser, error) {
+	cmd := exec.Command(path, rpcArg) //nolint:gosec
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("error getting stdin of %q: %w", path, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("error getting stdout of %q: %w", path, err)
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, fmt.Errorf("error starting %q: %w", path, err)
+	}
+
+	return &processConn{WriteCloser: stdin, Reader: stdout, cmd: cmd}, nil
+}
+
+// processConn is the connection to a plugin process started in the persistent mode
+type processConn struct {
+	io.WriteCloser
+	io.Reader
+
+	cmd *exec.Cmd
+}
+
+// Close closes the stdin of the plugin, which must exit, and waits for it
+func (c *processConn) Close() error {
+	closeErr := c.WriteCloser.Close()
+	if err := c.cmd.Wait(); err != nil {
+		return fmt.Errorf("error waiting for %q: %w", c.cmd.Path, err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("error closing stdin of %q: %w", c.cmd.Path, closeErr)
+	}
+	return nil
+}
+
+// rpcRequest is a JSON-RPC 2.0 request sent to a plugin
+type rpcRequest struct {
+	JSONRPC string                 `json:"jsonrpc"`
+	ID      int                    `json:"id"`
+	Method  string                 `json:"method"`
+	Params  external.PluginRequest `json:"params"`
+}
+
+// rpcMessage is a JSON-RPC 2.0 message received from a plugin, either a response or a notification
+type rpcMessage struct {
+	JSONRPC string                   `json:"jsonrpc"`
+	ID      *int                     `json:"id,omitempty"`
+	Method  string                   `json:"method,omitempty"`
+	Params  json.RawMessage          `json:"params,omitempty"`
+	Result  *external.PluginResponse `json:"result,omitempty"`
+	Error   *rpcError                `json:"error,omitempty"`
+}
+
+// rpcError is the error object of a JSON-RPC 2.0 response
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// rpcLogParams are the parameters of the log notifications sent by plugins
+type rpcLogParams struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// rpcSession is a plugin started once per CLI invocation that answers the requests over JSON-RPC
+type rpcSession struct {
+	mu     sync.Mutex
+	conn   io.ReadWriteCloser
+	enc    *json.Encoder
+	dec    *json.Decoder
+	nextID int
+}
+
+func newRPCSession(conn io.ReadWriteCloser) *rpcSession {
+	return &rpcSession{conn: conn, enc: json.NewEncoder(conn), dec: json.NewDecoder(conn)}
+}
+
+// call sends a request and waits for its response, logging the notifications received meanwhile
+func (s *rpcSession) call(method string, req external.PluginRequest) (*external.PluginResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	id := s.nextID
+	if err := s.enc.Encode(rpcRequest{JSONRPC: jsonRPCVersion, ID: id, Method: method, Params: req}); err != nil {
+		return nil, fmt.Errorf("error sending %q request: %w", method, err)
+	}
+
+	for {
+		var msg rpcMessage
+		if err := s.dec.Decode(&msg); err != nil {
+			return nil, fmt.Errorf("error reading %q response: %w", method, err)
+		}
+
+		switch {
+		case msg.ID == nil:
+			logNotification(msg)
+		case *msg.ID != id:
+			return nil, fmt.Errorf("unexpected response id %d to %q request %d", *msg.ID, method, id)
+		case msg.Error != nil:
+			return nil, fmt.Errorf("%s (code %d)", msg.Error.Message, msg.Error.Code)
+		case msg.Result == nil:
+			return nil, fmt.Errorf("empty %q response", method)
+		default:
+			return msg.Result, nil
+		}
+	}
+}
+
+// logNotification logs the messages that plugins send through log notifications
+func logNotification(msg rpcMessage) {
+	if msg.Method != rpcMethodLog {
+		return
+	}
+
+	var params rpcLogParams
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		log.Warn("invalid log notification from external plugin", "error", err)
+		return
+	}
+
+	switch params.Level {
+	case "error":
+		log.Error(params.Message)
+	case "warn", "warning":
+		log.Warn(params.Message)
+	case "debug":
+		log.Debug(params.Message)
+	default:
+		log.Info(params.Message)
+	}
+}
+
+// rpcMethodFor returns the method used to send the request for a command
+func rpcMethodFor(command string) string {
+	switch command {
+	case rpcMethodFlags, rpcMethodMetadata:
+		return command
+	default:
+		return rpcMethodScaffold
+	}
+}
+
+// sessionRegistry keeps the plugins running in the persistent mode during a CLI invocation
+type sessionRegistry struct {
+	mu sync.Mutex
+	// sessions maps the plugin paths to their session, or to nil if starting it failed
+	sessions map[string]*rpcSession
+}
+
+var sessions = &sessionRegistry{sessions: map[string]*rpcSession{}}
+
+// get returns the session of the plugin, or nil if it is not running in the persistent mode
+func (r *sessionRegistry) get(path string) *rpcSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.sessions[path]
+}
+
+// start starts the plugin in the persistent mode, unless it was already attempted.
+// Plugins that fail to start keep being executed once per request.
+func (r *sessionRegistry) start(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, found := r.sessions[path]; found {
+		return
+	}
+
+	conn, err := sessionStarter.StartSession(path)
+	if err != nil {
+		log.Warn("failed to start external plugin in persistent mode, falling back to one process per request",
+			"path", path, "error", err)
+		r.sessions[path] = nil
+		return
+	}
+	r.sessions[path] = newRPCSession(conn)
+}
+
+// closeAll stops every running plugin
+func (r *sessionRegistry) closeAll() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	paths := make([]string, 0, len(r.sessions))
+	for path := range r.sessions {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	var errs []error
+	for _, path := range paths {
+		if session := r.sessions[path]; session != nil {
+			if err := session.conn.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("error stopping external plugin %q: %w", path, err))
+			}
+		}
+	}
+	r.sessions = map[string]*rpcSession{}
+
+	return errors.Join(errs...)
+}
+
+// CloseSessions stops the external plugins started in the persistent mode.
+// It must be called once the command has been executed.
+func CloseSessions() error {
+	return sessions.closeAll()
+}
+
+// makeHookRequest sends a pre-scaffold or post-scaffold request to a plugin running in the persistent mode.
+// Plugins executed once per request do not support these hooks, so nothing is done for them.
+func makeHookRequest(method string, req external.PluginRequest, path string, cfg config.Config) error {
+	session := sessions.get(path)
+	if session == nil {
+		return nil
+	}
+
+	var err error
+	if req.Config, err = getConfigMap(cfg); err != nil {
+		return err
+	}
+
+	res, err := session.call(method, req)
+	if err != nil {
+		return fmt.Errorf("error making %s request to external plugin: %w", method, err)
+	}
+	if res.Error {
+		return fmt.Errorf("%s", strings.Join(res.ErrorMsgs, "\n"))
+	}
+
+	return nil
+}
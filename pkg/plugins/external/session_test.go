@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// fakeRPCConn connects the CLI to a fake plugin running in a goroutine.
+type fakeRPCConn struct {
+	io.Reader
+	io.Writer
+
+	requests io.Closer
+	done     chan struct{}
+}
+
+func (c *fakeRPCConn) Close() error {
+	err := c.requests.Close()
+	<-c.done
+	return err
+}
+
+// fakeSessionStarter starts a fake plugin that answers every request with the responses by method.
+type fakeSessionStarter struct {
+	responses map[string]external.PluginResponse
+	methods   []string
+	starts    int
+}
+
+func (s *fakeSessionStarter) StartSession(string) (io.ReadWriteCloser, error) {
+	s.starts++
+	requestsReader, requestsWriter := io.Pipe()
+	responsesReader, responsesWriter := io.Pipe()
+	conn := &fakeRPCConn{
+		Reader:   responsesReader,
+		Writer:   requestsWriter,
+		requests: requestsWriter,
+		done:     make(chan struct{}),
+	}
+
+	go func() {
+		defer GinkgoRecover()
+		defer close(conn.done)
+		defer func() { _ = responsesWriter.Close() }()
+
+		dec := json.NewDecoder(requestsReader)
+		enc := json.NewEncoder(responsesWriter)
+		for {
+			var req rpcRequest
+			if err := dec.Decode(&req); err != nil {
+				return
+			}
+			s.methods = append(s.methods, req.Method)
+
+			Expect(enc.Encode(map[string]any{
+				"jsonrpc": jsonRPCVersion,
+				"method":  rpcMethodLog,
+				"params":  rpcLogParams{Level: "info", Message: "handling " + req.Method},
+			})).To(Succeed())
+			res := s.responses[req.Method]
+			Expect(enc.Encode(map[string]any{"jsonrpc": jsonRPCVersion, "id": req.ID, "result": res})).To(Succeed())
+		}
+	}()
+
+	return conn, nil
+}
+
+// mockCountingOutputGetter returns the same response to every request and counts them.
+type mockCountingOutputGetter struct {
+	response external.PluginResponse
+	calls    int
+}
+
+func (m *mockCountingOutputGetter) GetExecOutput([]byte, string) ([]byte, error) {
+	m.calls++
+	return json.Marshal(m.response)
+}
+
+var _ = Describe("Persistent mode", func() {
+	const pluginPath = "rpc-plugin"
+
+	var (
+		fs      machinery.Filesystem
+		getter  *mockCountingOutputGetter
+		starter *fakeSessionStarter
+		sc      *createAPISubcommand
+	)
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		currentDirGetter = &mockRootOsWdGetter{}
+		starter = &fakeSessionStarter{responses: map[string]external.PluginResponse{
+			rpcMethodFlags:        {Flags: getFlags()},
+			rpcMethodPreScaffold:  {},
+			rpcMethodScaffold:     {Universe: map[string]string{"rpc.txt": "content\n"}},
+			rpcMethodPostScaffold: {},
+		}}
+		sessionStarter = starter
+		sc = &createAPISubcommand{Path: pluginPath}
+	})
+
+	AfterEach(func() {
+		Expect(CloseSessions()).To(Succeed())
+		sessionStarter = &execSessionStarter{}
+	})
+
+	It("should send every request after the first one to a single process", func() {
+		getter = &mockCountingOutputGetter{response: external.PluginResponse{RPC: true, Metadata: getMetadata()}}
+		outputGetter = getter
+
+		var meta plugin.SubcommandMetadata
+		sc.UpdateMetadata(plugin.CLIMetadata{}, &meta)
+		Expect(meta.Description).To(Equal(getMetadata().Description))
+
+		flagset := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		sc.BindFlags(flagset)
+		Expect(flagset.Lookup("captain")).NotTo(BeNil())
+
+		Expect(sc.PreScaffold(fs)).To(Succeed())
+		Expect(sc.Scaffold(fs)).To(Succeed())
+		Expect(sc.PostScaffold()).To(Succeed())
+
+		Expect(getter.calls).To(Equal(1))
+		Expect(starter.starts).To(Equal(1))
+		Expect(starter.methods).To(Equal([]string{
+			rpcMethodFlags, rpcMethodPreScaffold, rpcMethodScaffold, rpcMethodPostScaffold,
+		}))
+
+		content, err := afero.ReadFile(fs.FS, "rpc.txt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("content\n"))
+	})
+
+	It("should return the errors of the hooks", func() {
+		starter.responses[rpcMethodPreScaffold] = external.PluginResponse{Error: true, ErrorMsgs: []string{"invalid"}}
+		outputGetter = &mockCountingOutputGetter{response: external.PluginResponse{RPC: true}}
+
+		sc.BindFlags(pflag.NewFlagSet("test", pflag.ContinueOnError))
+		Expect(sc.PreScaffold(fs)).To(MatchError("invalid"))
+	})
+
+	It("should not start plugins that do not support it", func() {
+		getter = &mockCountingOutputGetter{response: external.PluginResponse{Flags: getFlags()}}
+		outputGetter = getter
+
+		sc.BindFlags(pflag.NewFlagSet("test", pflag.ContinueOnError))
+		Expect(sc.PreScaffold(fs)).To(Succeed())
+		Expect(sc.PostScaffold()).To(Succeed())
+
+		Expect(getter.calls).To(Equal(1))
+		Expect(starter.starts).To(Equal(0))
+	})
+})
@@ -26,13 +26,19 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.CreateWebhookSubcommand = &createWebhookSubcommand{}
+var (
+	_ plugin.CreateWebhookSubcommand = &createWebhookSubcommand{}
+	_ plugin.HasPreScaffold          = &createWebhookSubcommand{}
+	_ plugin.HasPostScaffold         = &createWebhookSubcommand{}
+)
 
 type createWebhookSubcommand struct {
 	Path        string
 	Args        []string
+	pluginKey   string
 	pluginChain []string
 	config      config.Config
+	protocol    pluginProtocol
 }
 
 // InjectConfig injects the project configuration so external plugins can read the PROJECT file.
@@ -69,21 +75,27 @@ func (p *createWebhookSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMet
 }
 
 func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
-	bindExternalPluginFlags(fs, "webhook", p.Path, p.Args)
+	p.protocol = bindExternalPluginFlags(fs, "webhook", p.Path, p.Args)
+}
+
+func (p *createWebhookSubcommand) PreScaffold(machinery.Filesystem) error {
+	return makeHookRequest(rpcMethodPreScaffold, p.request(), p.Path, p.config)
 }
 
 func (p *createWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return handlePluginResponse(fs, p.request(), p.Path, p.config, p.pluginKey, p.protocol)
+}
+
+func (p *createWebhookSubcommand) PostScaffold() error {
+	return makeHookRequest(rpcMethodPostScaffold, p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the plugin for the subcommand
+func (p *createWebhookSubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "create webhook",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
@@ -21,7 +21,6 @@ import (
 	"fmt"
 	log "log/slog"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/spf13/pflag"
@@ -53,14 +52,9 @@ type createAPISubcommand struct {
 	// runManifests indicates whether to run manifests or not after scaffolding APIs
 	runManifests bool
 
-	// imageCommand indicates the command that we should use to init the deployment
-	imageContainerCommand string
-
-	// imageContainerPort indicates the port that we should use in the scaffold
-	imageContainerPort string
-
-	// runAsUser indicates the user-id used for running the container
-	runAsUser string
+	// container holds the options of the Operand container, such as its command, port, user-id,
+	// environment variables, volumes, probes, resources and sidecars
+	container scaffolds.ContainerOptions
 }
 
 func (p *createAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
@@ -90,6 +84,10 @@ func (p *createAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdM
 
 	Therefore, the default values informed will be used to scaffold specs for the API.
 
+	The container can also be scaffolded with environment variables, ConfigMaps and Secrets mounted as volumes, liveness and readiness probes, compute resources and sidecar containers. The environment variables and the resources are specs of the API, whose values are set in the sample.
+
+  %[1]s create api --group example.com --version v1alpha1 --kind Memcached --image=memcached:1.6.15-alpine --image-container-port="11211" --image-container-env="MEMCACHED_THREADS=4" --image-container-volume="configmap:memcached-config:/etc/memcached" --image-container-liveness-probe="tcp:11211" --image-container-readiness-probe="tcp:11211" --image-container-resources="requests.cpu=100m,requests.memory=64Mi,limits.memory=128Mi" --image-container-sidecar="exporter=quay.io/prometheus/memcached-exporter:v0.15.0" --plugins="%[2]s"
+
   %[1]s create api --group example.com --version v1alpha1 --kind Memcached --image=memcached:1.6.15-alpine --image-container-command="memcached --memory-limit=64 modern -v" --image-container-port="11211" --plugins="%[2]s" --make=false --namespaced=false
 
   # Generate the manifests
@@ -112,16 +110,38 @@ func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&p.image, "image", "", "Operand image name (e.g., memcached:1.6.15-alpine). "+
 		"The controller will be scaffolded with example code to deploy and manage this image")
 
-	fs.StringVar(&p.imageContainerCommand, "image-container-command", "",
+	fs.StringVar(&p.container.Command, "image-container-command", "",
 		"[Optional] Container command to use for image initialization "+
 			"(e.g., --image-container-command=\"memcached,--memory-limit=64,modern,-o,-v\"). "+
 			"Used to scaffold the container command in the controller and its spec in the API (CRD/CR)")
-	fs.StringVar(&p.imageContainerPort, "image-container-port", "",
+	fs.StringVar(&p.container.Port, "image-container-port", "",
 		"[Optional] Container port used by the container image "+
 			"(e.g., --image-container-port=\"11211\"). "+
 			"Used to scaffold the container port in the controller and its spec in the API (CRD/CR)")
-	fs.StringVar(&p.runAsUser, "run-as-user", "",
+	fs.StringVar(&p.container.RunAsUser, "run-as-user", "",
 		"User ID for the container (e.g., 1000); sets the securityContext.runAsUser field")
+	fs.StringArrayVar(&p.container.Env, "image-container-env", nil,
+		"[Optional] Environment variable of the container in the NAME=VALUE format; can be repeated "+
+			"(e.g., --image-container-env=\"LOG_LEVEL=debug\"). "+
+			"Used to scaffold the env spec in the API (CRD/CR) which is set in the container")
+	fs.StringArrayVar(&p.container.Volumes, "image-container-volume", nil,
+		"[Optional] ConfigMap or Secret mounted in the container, in the configmap:NAME:MOUNT_PATH or "+
+			"secret:NAME:MOUNT_PATH format; can be repeated "+
+			"(e.g., --image-container-volume=\"secret:memcached-tls:/etc/tls\")")
+	fs.StringVar(&p.container.LivenessProbe, "image-container-liveness-probe", "",
+		"[Optional] Liveness probe of the container in the http:PORT:PATH, tcp:PORT or exec:COMMAND format, "+
+			"where the command is comma-separated (e.g., --image-container-liveness-probe=\"http:8080:/healthz\")")
+	fs.StringVar(&p.container.ReadinessProbe, "image-container-readiness-probe", "",
+		"[Optional] Readiness probe of the container in the http:PORT:PATH, tcp:PORT or exec:COMMAND format, "+
+			"where the command is comma-separated (e.g., --image-container-readiness-probe=\"tcp:11211\")")
+	fs.StringVar(&p.container.Resources, "image-container-resources", "",
+		"[Optional] Compute resources of the container in the requests.NAME=QUANTITY,limits.NAME=QUANTITY format "+
+			"(e.g., --image-container-resources=\"requests.cpu=100m,limits.memory=128Mi\"). "+
+			"Used to scaffold the resources spec in the API (CRD/CR) which is set in the container")
+	fs.StringArrayVar(&p.container.Sidecars, "image-container-sidecar", nil,
+		"[Optional] Sidecar container in the NAME=IMAGE format; can be repeated "+
+			"(e.g., --image-container-sidecar=\"exporter=quay.io/prometheus/memcached-exporter:v0.15.0\"). "+
+			"The image is set in the manager environment, as the Operand image")
 
 	fs.BoolVar(&p.runMake, "make", true,
 		"Run 'make generate' after generating files (enabled by default; use --make=false to disable)")
@@ -166,26 +186,12 @@ func (p *createAPISubcommand) PreScaffold(machinery.Filesystem) error {
 		return fmt.Errorf("you MUST inform the image that will be used in the reconciliation")
 	}
 
-	// Validate imageContainerPort is a valid integer if provided
-	if len(p.imageContainerPort) > 0 {
-		port, err := strconv.Atoi(p.imageContainerPort)
-		if err != nil {
-			return fmt.Errorf("--image-container-port must be a valid integer, got %q: %w", p.imageContainerPort, err)
-		}
-		if port < 1 || port > 65535 {
-			return fmt.Errorf("--image-container-port must be between 1 and 65535, got %d", port)
-		}
+	kind := ""
+	if p.resource != nil {
+		kind = p.resource.Kind
 	}
-
-	// Validate runAsUser is a valid int64 if provided
-	if len(p.runAsUser) > 0 {
-		userID, err := strconv.ParseInt(p.runAsUser, 10, 64)
-		if err != nil {
-			return fmt.Errorf("--run-as-user must be a valid integer, got %q: %w", p.runAsUser, err)
-		}
-		if userID < 0 {
-			return fmt.Errorf("--run-as-user must be non-negative, got %d", userID)
-		}
+	if err := p.container.Validate(kind); err != nil {
+		return fmt.Errorf("invalid container options: %w", err)
 	}
 
 	isGoV3 := false
@@ -213,9 +219,7 @@ func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
 	scaffolder := scaffolds.NewDeployImageScaffolder(p.config,
 		*p.resource,
 		p.image,
-		p.imageContainerCommand,
-		p.imageContainerPort,
-		p.runAsUser)
+		p.container)
 	scaffolder.InjectFS(fs)
 	err := scaffolder.Scaffold()
 	if err != nil {
@@ -248,10 +252,16 @@ func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
 	}
 
 	configDataOptions := options{
-		Image:            p.image,
-		ContainerCommand: p.imageContainerCommand,
-		ContainerPort:    p.imageContainerPort,
-		RunAsUser:        p.runAsUser,
+		Image:              p.image,
+		ContainerCommand:   p.container.Command,
+		ContainerPort:      p.container.Port,
+		RunAsUser:          p.container.RunAsUser,
+		ContainerEnv:       p.container.Env,
+		ContainerVolumes:   p.container.Volumes,
+		LivenessProbe:      p.container.LivenessProbe,
+		ReadinessProbe:     p.container.ReadinessProbe,
+		ContainerResources: p.container.Resources,
+		Sidecars:           p.container.Sidecars,
 	}
 	cfg.Resources = append(cfg.Resources, ResourceData{
 		Group:   p.resource.Group,
@@ -287,8 +297,11 @@ func (p *createAPISubcommand) PostScaffold() error {
 		}
 	}
 
-	fmt.Print("Next: check the implementation of your new API and controller. " +
-		"If you do changes in the API run the manifests with:\n$ make manifests\n")
+	util.PrintNextSteps(util.NextStep{
+		Description: "check the implementation of your new API and controller. " +
+			"If you do changes in the API run the manifests",
+		Command: "make manifests",
+	})
 
 	return nil
 }
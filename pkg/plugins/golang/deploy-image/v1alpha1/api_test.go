@@ -82,6 +82,27 @@ var _ = Describe("createAPISubcommand", func() {
 			Expect(err.Error()).To(ContainSubstring("you MUST inform the image"))
 		})
 
+		It("should reject invalid container options", func() {
+			subCmd.image = "memcached:1.6.15-alpine"
+			subCmd.container.Volumes = []string{"configmap:memcached-config:etc/memcached"}
+
+			err := subCmd.PreScaffold(fs)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid --image-container-volume"))
+		})
+
+		It("should reject a sidecar named as the Operand container", func() {
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			subCmd.image = "memcached:1.6.15-alpine"
+			subCmd.container.Sidecars = []string{"memcached=busybox:1.36.1"}
+
+			err := subCmd.PreScaffold(fs)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("it is the name of the Memcached container"))
+		})
+
 		It("should succeed when image is provided", func() {
 			subCmd.image = "memcached:1.6.15-alpine"
 
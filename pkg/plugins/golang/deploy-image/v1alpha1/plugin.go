@@ -65,10 +65,16 @@ type ResourceData struct {
 }
 
 type options struct {
-	Image            string `json:"image,omitempty"`
-	ContainerCommand string `json:"containerCommand,omitempty"`
-	ContainerPort    string `json:"containerPort,omitempty"`
-	RunAsUser        string `json:"runAsUser,omitempty"`
+	Image              string   `json:"image,omitempty"`
+	ContainerCommand   string   `json:"containerCommand,omitempty"`
+	ContainerPort      string   `json:"containerPort,omitempty"`
+	RunAsUser          string   `json:"runAsUser,omitempty"`
+	ContainerEnv       []string `json:"containerEnv,omitempty"`
+	ContainerVolumes   []string `json:"containerVolumes,omitempty"`
+	LivenessProbe      string   `json:"livenessProbe,omitempty"`
+	ReadinessProbe     string   `json:"readinessProbe,omitempty"`
+	ContainerResources string   `json:"containerResources,omitempty"`
+	Sidecars           []string `json:"sidecars,omitempty"`
 }
 
 // Description returns a short description of the plugin
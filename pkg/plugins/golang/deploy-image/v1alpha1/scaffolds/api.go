@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
 	kustomizev2scaffolds "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/operand"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/api"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/config/samples"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/controllers"
@@ -45,25 +46,21 @@ type apiScaffolder struct {
 	config    config.Config
 	resource  resource.Resource
 	image     string
-	command   string
-	port      string
-	runAsUser string
+	container ContainerOptions
 
 	// fs is the filesystem that will be used by the scaffolder
 	fs machinery.Filesystem
 }
 
 // NewDeployImageScaffolder returns a new Scaffolder for declarative
-func NewDeployImageScaffolder(cfg config.Config, res resource.Resource, image,
-	command, port, runAsUser string,
+func NewDeployImageScaffolder(cfg config.Config, res resource.Resource, image string,
+	container ContainerOptions,
 ) plugins.Scaffolder {
 	return &apiScaffolder{
 		config:    cfg,
 		resource:  res,
 		image:     image,
-		command:   command,
-		port:      port,
-		runAsUser: runAsUser,
+		container: container,
 	}
 }
 
@@ -76,7 +73,12 @@ func (s *apiScaffolder) InjectFS(fs machinery.Filesystem) {
 func (s *apiScaffolder) Scaffold() error {
 	log.Info("Writing scaffold for you to edit...")
 
-	if err := s.scaffoldCreateAPI(); err != nil {
+	container, err := s.container.parse(s.resource.Kind)
+	if err != nil {
+		return fmt.Errorf("error scaffolding API/controller: invalid container options: %w", err)
+	}
+
+	if err = s.scaffoldCreateAPI(); err != nil {
 		return err
 	}
 
@@ -106,19 +108,25 @@ func (s *apiScaffolder) Scaffold() error {
 	)
 
 	if err := scaffold.Execute(
-		&api.Types{Port: s.port, SkipApplyConfig: s.hasSSAInPackage()},
+		&api.Types{
+			Port:            container.Port,
+			Env:             len(container.Env) > 0,
+			Resources:       container.Resources != nil,
+			SkipApplyConfig: s.hasSSAInPackage(),
+		},
 	); err != nil {
 		return fmt.Errorf("error updating APIs: %w", err)
 	}
 
 	if err := scaffold.Execute(
-		&samples.CRDSample{Port: s.port},
+		&samples.CRDSample{Port: container.Port, Env: container.Env, Resources: container.Resources},
 	); err != nil {
 		return fmt.Errorf("error updating config/samples: %w", err)
 	}
 
 	controller := &controllers.Controller{
 		ControllerRuntimeVersion: golangv4scaffolds.ControllerRuntimeVersion,
+		Container:                container,
 	}
 
 	if err := scaffold.Execute(
@@ -133,22 +141,18 @@ func (s *apiScaffolder) Scaffold() error {
 		return fmt.Errorf("error scaffolding controller: %w", err)
 	}
 
-	if err := s.updateControllerCode(*controller); err != nil {
-		return fmt.Errorf("error updating controller: %w", err)
-	}
-
 	defaultMainPath := "cmd/main.go"
 	if err := s.updateMainByAddingEventRecorder(defaultMainPath); err != nil {
 		return fmt.Errorf("error updating main.go: %w", err)
 	}
 
 	if err := scaffold.Execute(
-		&controllers.ControllerTest{Port: s.port},
+		&controllers.ControllerTest{Port: container.Port, Sidecars: container.Sidecars},
 	); err != nil {
 		return fmt.Errorf("error creating controller/**_controller_test.go: %w", err)
 	}
 
-	return s.addEnvVarIntoManager()
+	return s.addEnvVarIntoManager(container.Sidecars)
 }
 
 // hasSSAInPackage checks if another kind in the same group/version has SSA enabled.
@@ -172,18 +176,22 @@ func (s *apiScaffolder) hasSSAInPackage() bool {
 
 // addEnvVarIntoManager will update the config/manager/manager.yaml by adding
 // a new ENV VAR for to store the image informed which will be used in the
-// controller to create the Pod for the Kind
-func (s *apiScaffolder) addEnvVarIntoManager() error {
+// controller to create the Pod for the Kind, and one for the image of each sidecar
+func (s *apiScaffolder) addEnvVarIntoManager(sidecars []operand.Sidecar) error {
+	editor := util.NewFileEditor(s.fs)
 	managerPath := filepath.Join("config", "manager", "manager.yaml")
-	err := util.ReplaceInFile(managerPath, `env:`, `env:`)
+	err := editor.ReplaceInFile(managerPath, `env:`, `env:`)
 	if err != nil {
-		if err = util.InsertCode(managerPath, `name: manager`, "\n        env:"); err != nil {
+		if err = editor.InsertCode(managerPath, `name: manager`, "\n        env:"); err != nil {
 			return fmt.Errorf("error scaffolding env key in config/manager/manager.yaml")
 		}
 	}
 
-	if err = util.InsertCode(managerPath, `env:`,
-		fmt.Sprintf(envVarTemplate, strings.ToUpper(s.resource.Kind), s.image)); err != nil {
+	envVars := fmt.Sprintf(envVarTemplate, strings.ToUpper(s.resource.Kind)+"_IMAGE", s.image)
+	for _, sidecar := range sidecars {
+		envVars += fmt.Sprintf(envVarTemplate, sidecar.ImageEnvVar, sidecar.Image)
+	}
+	if err = editor.InsertCode(managerPath, `env:`, envVars); err != nil {
 		return fmt.Errorf("error scaffolding env key in config/manager/manager.yaml")
 	}
 
@@ -207,7 +215,7 @@ func (s *apiScaffolder) scaffoldCreateAPI() error {
 // which will have its own controller template which set the recorder so that we can use it
 // in the reconciliation to create an event inside for the finalizer
 func (s *apiScaffolder) updateMainByAddingEventRecorder(defaultMainPath string) error {
-	if err := util.InsertCode(
+	if err := util.NewFileEditor(s.fs).InsertCode(
 		defaultMainPath,
 		fmt.Sprintf(
 			`%sReconciler{
@@ -221,84 +229,6 @@ func (s *apiScaffolder) updateMainByAddingEventRecorder(defaultMainPath string)
 	return nil
 }
 
-// updateControllerCode will update the code generate on the template to add the Container information
-func (s *apiScaffolder) updateControllerCode(controller controllers.Controller) error {
-	containerName := strings.ToLower(s.resource.Kind) + "ContainerName"
-
-	if err := util.ReplaceInFile(
-		controller.Path,
-		"//TODO: scaffold container",
-		fmt.Sprintf(containerTemplate, containerName),
-	); err != nil {
-		return fmt.Errorf("error scaffolding container in the controller path %q: %w",
-			controller.Path, err)
-	}
-
-	// Scaffold the command if informed
-	if len(s.command) > 0 {
-		// TODO: improve it to be an spec in the sample and api instead so that
-		// users can change the values
-		var res string
-		for value := range strings.SplitSeq(s.command, ",") {
-			res += fmt.Sprintf(" \"%s\",", strings.TrimSpace(value))
-		}
-		// remove the latest ,
-		res = res[:len(res)-1]
-		// remove the first space to not fail in the go fmt ./...
-		res = strings.TrimLeft(res, " ")
-
-		if err := util.InsertCode(controller.Path, `SecurityContext: &corev1.SecurityContext{
-							RunAsNonRoot:             new(true),
-							AllowPrivilegeEscalation: new(false),
-							Capabilities: &corev1.Capabilities{
-								Drop: []corev1.Capability{
-									"ALL",
-								},
-							},
-						},`, fmt.Sprintf(commandTemplate, res)); err != nil {
-			return fmt.Errorf("error scaffolding command in the controller path %q: %w",
-				controller.Path, err)
-		}
-	}
-
-	// Scaffold the port if informed
-	if len(s.port) > 0 {
-		if err := util.InsertCode(
-			controller.Path,
-			`SecurityContext: &corev1.SecurityContext{
-							RunAsNonRoot:             new(true),
-							AllowPrivilegeEscalation: new(false),
-							Capabilities: &corev1.Capabilities{
-								Drop: []corev1.Capability{
-									"ALL",
-								},
-							},
-						},`,
-			fmt.Sprintf(
-				portTemplate,
-				strings.ToLower(s.resource.Kind),
-				containerName),
-		); err != nil {
-			return fmt.Errorf("error scaffolding container port in the controller path %q: %w",
-				controller.Path,
-				err)
-		}
-	}
-
-	if len(s.runAsUser) > 0 {
-		if err := util.InsertCode(
-			controller.Path,
-			`RunAsNonRoot:             new(true),`,
-			fmt.Sprintf(runAsUserTemplate, s.runAsUser),
-		); err != nil {
-			return fmt.Errorf("error scaffolding user-id in the controller path %q: %w",
-				controller.Path, err)
-		}
-	}
-
-	return nil
-}
-
 func (s *apiScaffolder) scaffoldCreateAPIFromKustomize() error {
 	kustomizeScaffolder := kustomizev2scaffolds.NewAPIScaffolder(
 		s.config,
@@ -326,38 +256,9 @@ func (s *apiScaffolder) scaffoldCreateAPIFromGolang() error {
 	return nil
 }
 
-const containerTemplate = `Containers: []corev1.Container{{
-						Image:           image,
-						Name:            %s,
-						ImagePullPolicy: corev1.PullIfNotPresent,
-						// Ensure restrictive context for the container
-						// More info: https://kubernetes.io/docs/concepts/security/pod-security-standards/#restricted
-						SecurityContext: &corev1.SecurityContext{
-							RunAsNonRoot:             new(true),
-							AllowPrivilegeEscalation: new(false),
-							Capabilities: &corev1.Capabilities{
-								Drop: []corev1.Capability{
-									"ALL",
-								},
-							},
-						},
-					}}`
-
-const runAsUserTemplate = `
-							RunAsUser:                new(int64(%s)),`
-
-const commandTemplate = `
-						Command: []string{%s},`
-
-const portTemplate = `
-						Ports: []corev1.ContainerPort{{
-							ContainerPort: %s.Spec.ContainerPort,
-							Name:          %s,
-						}},`
-
 const recorderTemplate = `
 		Recorder: mgr.GetEventRecorder("%s-controller"),`
 
 const envVarTemplate = `
-        - name: %s_IMAGE
+        - name: %s
           value: %s`
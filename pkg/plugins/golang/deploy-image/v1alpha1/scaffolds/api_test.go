@@ -28,7 +28,9 @@ import (
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/operand"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/controllers"
 )
 
 var _ = Describe("Types template", func() {
@@ -69,6 +71,105 @@ var _ = Describe("Types template", func() {
 	})
 })
 
+var _ = Describe("Controller template", func() {
+	scaffoldController := func(container operand.Container) string {
+		res := resource.Resource{
+			GVK: resource.GVK{
+				Group:   "example.com",
+				Domain:  "test.io",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural: "memcacheds",
+			Path:   "sigs.k8s.io/kubebuilder/test/api/v1alpha1",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+		cfg := cfgv3.New()
+		Expect(cfg.SetRepository("sigs.k8s.io/kubebuilder/test")).To(Succeed())
+
+		fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+		scaffold := machinery.NewScaffold(fs,
+			machinery.WithConfig(cfg),
+			machinery.WithBoilerplate("/* boilerplate */"),
+			machinery.WithResource(&res),
+		)
+		Expect(scaffold.Execute(&controllers.Controller{Container: container})).To(Succeed())
+
+		content, err := afero.ReadFile(fs.FS, filepath.Join("internal", "controller", "memcached_controller.go"))
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	It("should scaffold only the image and the security context when no option is informed", func() {
+		content := scaffoldController(operand.Container{})
+		Expect(content).To(ContainSubstring("Name:            memcachedContainerName,"))
+		Expect(content).NotTo(ContainSubstring("RunAsUser:"))
+		Expect(content).NotTo(ContainSubstring("Ports:"))
+		Expect(content).NotTo(ContainSubstring("VolumeMounts:"))
+		Expect(content).NotTo(ContainSubstring("intstr"))
+		Expect(content).NotTo(ContainSubstring("sidecarImagesForMemcached"))
+		Expect(content).NotTo(ContainSubstring("updateMemcachedContainer"))
+		Expect(content).NotTo(ContainSubstring("equality"))
+	})
+
+	It("should scaffold the options of the container in the Deployment", func() {
+		content := scaffoldController(operand.Container{
+			Command:   []string{"memcached", "--memory-limit=64"},
+			Port:      "11211",
+			RunAsUser: "1001",
+			Env:       []operand.EnvVar{{Name: "MEMCACHED_THREADS", Value: "4"}},
+			Volumes: []operand.Volume{
+				{Name: "memcached-config", MountPath: "/etc/memcached"},
+				{Name: "memcached-tls", Secret: true, MountPath: "/etc/tls"},
+			},
+			LivenessProbe:  &operand.Probe{Type: "tcp", Port: "11211"},
+			ReadinessProbe: &operand.Probe{Type: "http", Port: "8080", Path: "/ready"},
+			Resources:      &operand.Resources{Limits: []operand.Quantity{{Name: "memory", Value: "128Mi"}}},
+			Sidecars: []operand.Sidecar{
+				{Name: "exporter", Image: "prom/memcached-exporter", ImageEnvVar: "MEMCACHED_EXPORTER_IMAGE"},
+			},
+		})
+		Expect(content).To(ContainSubstring(`"k8s.io/apimachinery/pkg/util/intstr"`))
+		Expect(content).To(ContainSubstring("RunAsUser:                new(int64(1001)),"))
+		Expect(content).To(ContainSubstring("ContainerPort: memcached.Spec.ContainerPort,"))
+		Expect(content).To(ContainSubstring(`Command: []string{"memcached", "--memory-limit=64"},`))
+		Expect(content).To(MatchRegexp(`Env:\s+memcached\.Spec\.Env,`))
+		Expect(content).To(ContainSubstring(`MountPath: "/etc/memcached",`))
+		Expect(content).To(ContainSubstring(`LocalObjectReference: corev1.LocalObjectReference{Name: "memcached-config"},`))
+		Expect(content).To(ContainSubstring(`SecretName: "memcached-tls",`))
+		Expect(content).To(ContainSubstring("TCPSocket: &corev1.TCPSocketAction{"))
+		Expect(content).To(ContainSubstring(`Path: "/ready",`))
+		Expect(content).To(ContainSubstring("Port: intstr.FromInt32(8080),"))
+		Expect(content).To(MatchRegexp(`Resources:\s+memcached\.Spec\.Resources,`))
+		Expect(content).To(ContainSubstring(`Image:           sidecarImages["exporter"],`))
+		Expect(content).To(ContainSubstring(`"exporter": "MEMCACHED_EXPORTER_IMAGE",`))
+	})
+
+	It("should update the container of the Deployment when the env and the resources change", func() {
+		content := scaffoldController(operand.Container{
+			Env:       []operand.EnvVar{{Name: "MEMCACHED_THREADS", Value: "4"}},
+			Resources: &operand.Resources{Limits: []operand.Quantity{{Name: "memory", Value: "128Mi"}}},
+		})
+		Expect(content).To(ContainSubstring(`"k8s.io/apimachinery/pkg/api/equality"`))
+		Expect(content).To(ContainSubstring("if updateMemcachedContainer(found, memcached) {"))
+		Expect(content).To(ContainSubstring("if !equality.Semantic.DeepEqual(container.Env, memcached.Spec.Env) {"))
+		Expect(content).To(ContainSubstring(
+			"if !equality.Semantic.DeepEqual(container.Resources, memcached.Spec.Resources) {"))
+
+		content = scaffoldController(operand.Container{Env: []operand.EnvVar{{Name: "MEMCACHED_THREADS", Value: "4"}}})
+		Expect(content).To(ContainSubstring("container.Env = memcached.Spec.Env"))
+		Expect(content).NotTo(ContainSubstring("container.Resources ="))
+	})
+
+	It("should scaffold an exec probe without importing intstr", func() {
+		content := scaffoldController(operand.Container{
+			LivenessProbe: &operand.Probe{Type: "exec", Command: []string{"sh", "-c", "echo ok"}},
+		})
+		Expect(content).To(ContainSubstring(`Command: []string{"sh", "-c", "echo ok"},`))
+		Expect(content).NotTo(ContainSubstring("intstr"))
+	})
+})
+
 var _ = Describe("hasSSAInPackage", func() {
 	newResource := func(kind string, ssa bool) resource.Resource {
 		return resource.Resource{
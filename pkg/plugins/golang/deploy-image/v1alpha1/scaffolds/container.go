@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	"strconv"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/operand"
+)
+
+// ContainerOptions are the options of the Operand container, as informed with the flags of the plugin
+type ContainerOptions struct {
+	Command        string
+	Port           string
+	RunAsUser      string
+	Env            []string
+	Volumes        []string
+	LivenessProbe  string
+	ReadinessProbe string
+	Resources      string
+	Sidecars       []string
+}
+
+// Validate returns an error if an option is invalid
+func (o ContainerOptions) Validate(kind string) error {
+	_, err := o.parse(kind)
+	return err
+}
+
+// parse returns the container which is scaffolded for the options
+func (o ContainerOptions) parse(kind string) (operand.Container, error) {
+	container := operand.Container{
+		Command:   operand.ParseCommand(o.Command),
+		Port:      o.Port,
+		RunAsUser: o.RunAsUser,
+	}
+
+	if len(o.Port) > 0 {
+		if err := operand.ValidatePort(o.Port); err != nil {
+			return container, fmt.Errorf("invalid --image-container-port: %w", err)
+		}
+	}
+
+	if len(o.RunAsUser) > 0 {
+		userID, err := strconv.ParseInt(o.RunAsUser, 10, 64)
+		if err != nil {
+			return container, fmt.Errorf("--run-as-user must be a valid integer, got %q: %w", o.RunAsUser, err)
+		}
+		if userID < 0 {
+			return container, fmt.Errorf("--run-as-user must be non-negative, got %d", userID)
+		}
+	}
+
+	var err error
+	if container.Env, err = operand.ParseEnv(o.Env); err != nil {
+		return container, fmt.Errorf("invalid --image-container-env: %w", err)
+	}
+	if container.Volumes, err = operand.ParseVolumes(o.Volumes); err != nil {
+		return container, fmt.Errorf("invalid --image-container-volume: %w", err)
+	}
+	if container.LivenessProbe, err = operand.ParseProbe(o.LivenessProbe); err != nil {
+		return container, fmt.Errorf("invalid --image-container-liveness-probe: %w", err)
+	}
+	if container.ReadinessProbe, err = operand.ParseProbe(o.ReadinessProbe); err != nil {
+		return container, fmt.Errorf("invalid --image-container-readiness-probe: %w", err)
+	}
+	if container.Resources, err = operand.ParseResources(o.Resources); err != nil {
+		return container, fmt.Errorf("invalid --image-container-resources: %w", err)
+	}
+	if container.Sidecars, err = operand.ParseSidecars(kind, o.Sidecars); err != nil {
+		return container, fmt.Errorf("invalid --image-container-sidecar: %w", err)
+	}
+
+	return container, nil
+}
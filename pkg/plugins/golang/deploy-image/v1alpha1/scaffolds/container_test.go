@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/operand"
+)
+
+var _ = Describe("ContainerOptions", func() {
+	It("should parse all the options of the container", func() {
+		opts := ContainerOptions{
+			Command:        "memcached, --memory-limit=64",
+			Port:           "11211",
+			RunAsUser:      "1001",
+			Env:            []string{"MEMCACHED_THREADS=4", "EMPTY="},
+			Volumes:        []string{"configmap:memcached-config:/etc/memcached", "secret:memcached-tls:/etc/tls"},
+			LivenessProbe:  "http:8080:/healthz",
+			ReadinessProbe: "exec:cat,/tmp/ready",
+			Resources:      "requests.cpu=100m, limits.memory=128Mi",
+			Sidecars:       []string{"metrics-exporter=prom/memcached-exporter:v0.14.2"},
+		}
+
+		container, err := opts.parse("Memcached")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(container).To(Equal(operand.Container{
+			Command:   []string{"memcached", "--memory-limit=64"},
+			Port:      "11211",
+			RunAsUser: "1001",
+			Env:       []operand.EnvVar{{Name: "MEMCACHED_THREADS", Value: "4"}, {Name: "EMPTY"}},
+			Volumes: []operand.Volume{
+				{Name: "memcached-config", MountPath: "/etc/memcached"},
+				{Name: "memcached-tls", Secret: true, MountPath: "/etc/tls"},
+			},
+			LivenessProbe:  &operand.Probe{Type: "http", Port: "8080", Path: "/healthz"},
+			ReadinessProbe: &operand.Probe{Type: "exec", Command: []string{"cat", "/tmp/ready"}},
+			Resources: &operand.Resources{
+				Requests: []operand.Quantity{{Name: "cpu", Value: "100m"}},
+				Limits:   []operand.Quantity{{Name: "memory", Value: "128Mi"}},
+			},
+			Sidecars: []operand.Sidecar{{
+				Name:        "metrics-exporter",
+				Image:       "prom/memcached-exporter:v0.14.2",
+				ImageEnvVar: "MEMCACHED_METRICS_EXPORTER_IMAGE",
+			}},
+		}))
+		Expect(container.HasPortProbe()).To(BeTrue())
+	})
+
+	It("should leave the container empty when no option is informed", func() {
+		container, err := ContainerOptions{}.parse("Memcached")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(container).To(Equal(operand.Container{}))
+		Expect(container.HasPortProbe()).To(BeFalse())
+	})
+
+	DescribeTable("should reject invalid options",
+		func(opts ContainerOptions, message string) {
+			Expect(opts.Validate("Memcached")).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("a port which is not a number", ContainerOptions{Port: "http"}, "invalid --image-container-port"),
+		Entry("a port out of range", ContainerOptions{Port: "70000"}, "between 1 and 65535"),
+		Entry("a negative user", ContainerOptions{RunAsUser: "-1"}, "--run-as-user must be non-negative"),
+		Entry("an env var without value", ContainerOptions{Env: []string{"FOO"}}, "expected NAME=VALUE"),
+		Entry("an invalid env var name", ContainerOptions{Env: []string{"1FOO=bar"}}, "invalid environment variable name"),
+		Entry("a duplicate env var", ContainerOptions{Env: []string{"FOO=a", "FOO=b"}}, "duplicate environment variable"),
+		Entry("a volume of unknown type", ContainerOptions{Volumes: []string{"pvc:data:/data"}}, "invalid volume type"),
+		Entry("a volume with a relative mount path", ContainerOptions{Volumes: []string{"secret:tls:etc/tls"}},
+			"expected an absolute path"),
+		Entry("a volume with an invalid name", ContainerOptions{Volumes: []string{"configmap:My_Config:/etc/config"}},
+			"invalid volume name"),
+		Entry("volumes with the same mount path",
+			ContainerOptions{Volumes: []string{"configmap:a:/etc/config", "secret:b:/etc/config"}}, "duplicate volume"),
+		Entry("an http probe without path", ContainerOptions{LivenessProbe: "http:8080"}, "expected http:PORT:PATH"),
+		Entry("a tcp probe with an invalid port", ContainerOptions{ReadinessProbe: "tcp:0"}, "between 1 and 65535"),
+		Entry("an exec probe without command", ContainerOptions{LivenessProbe: "exec:"}, "expected exec:COMMAND"),
+		Entry("a probe of unknown type", ContainerOptions{ReadinessProbe: "grpc:9090"},
+			"invalid --image-container-readiness-probe"),
+		Entry("a resource which is not a request or a limit", ContainerOptions{Resources: "cpu=100m"},
+			"expected requests.NAME=QUANTITY"),
+		Entry("an invalid quantity", ContainerOptions{Resources: "limits.memory=lots"}, "invalid quantity"),
+		Entry("a duplicate resource", ContainerOptions{Resources: "limits.cpu=1,limits.cpu=2"}, "duplicate resource"),
+		Entry("a sidecar without image", ContainerOptions{Sidecars: []string{"exporter"}}, "expected NAME=IMAGE"),
+		Entry("a sidecar named as the Operand container", ContainerOptions{Sidecars: []string{"memcached=busybox"}},
+			"it is the name of the Memcached container"),
+		Entry("a duplicate sidecar", ContainerOptions{Sidecars: []string{"exporter=a", "exporter=b"}},
+			"duplicate sidecar"),
+	)
+})
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operand parses the options of the Operand container, as informed with the flags of the plugin,
+// into the data used by the templates
+package operand
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Container holds the options of the Operand container, which are scaffolded in the Deployment
+type Container struct {
+	// Command is the entrypoint of the container
+	Command []string
+	// Port is the port exposed by the container, which is also a spec of the API
+	Port string
+	// RunAsUser is the user ID used to run the container
+	RunAsUser string
+	// Env are the environment variables of the container, which are also a spec of the API
+	Env []EnvVar
+	// Volumes are the ConfigMaps and Secrets mounted in the container
+	Volumes []Volume
+	// LivenessProbe and ReadinessProbe are the probes of the container
+	LivenessProbe  *Probe
+	ReadinessProbe *Probe
+	// Resources are the compute resources of the container, which are also a spec of the API
+	Resources *Resources
+	// Sidecars are the containers which run along with the Operand container
+	Sidecars []Sidecar
+}
+
+// HasPortProbe returns true if a probe of the container checks a port, which is done with the http
+// and tcp probes
+func (c Container) HasPortProbe() bool {
+	for _, probe := range []*Probe{c.LivenessProbe, c.ReadinessProbe} {
+		if probe != nil && probe.Type != "exec" {
+			return true
+		}
+	}
+	return false
+}
+
+// EnvVar is an environment variable of the container
+type EnvVar struct {
+	Name  string
+	Value string
+}
+
+// Volume is a ConfigMap or a Secret mounted in the container
+type Volume struct {
+	// Name is the name of the volume, which is also the name of the ConfigMap or the Secret
+	Name string
+	// Secret is true if the volume is a Secret, false if it is a ConfigMap
+	Secret    bool
+	MountPath string
+}
+
+// Probe is a liveness or readiness probe of the container
+type Probe struct {
+	// Type is one of http, tcp or exec
+	Type    string
+	Port    string
+	Path    string
+	Command []string
+}
+
+// Resources are the requests and the limits of the compute resources of the container
+type Resources struct {
+	Requests []Quantity
+	Limits   []Quantity
+}
+
+// Quantity is the amount of a compute resource, such as cpu=100m
+type Quantity struct {
+	Name  string
+	Value string
+}
+
+// Sidecar is a container which runs along with the Operand container
+type Sidecar struct {
+	Name  string
+	Image string
+	// ImageEnvVar is the environment variable of the manager which holds the image of the sidecar
+	ImageEnvVar string
+}
+
+// ParseCommand parses a comma-separated command, such as "memcached,--memory-limit=64"
+func ParseCommand(command string) []string {
+	if command == "" {
+		return nil
+	}
+	var args []string
+	for arg := range strings.SplitSeq(command, ",") {
+		args = append(args, strings.TrimSpace(arg))
+	}
+	return args
+}
+
+// ParseEnv parses environment variables in the NAME=VALUE format
+func ParseEnv(values []string) ([]EnvVar, error) {
+	var envVars []EnvVar
+	for _, value := range values {
+		name, val, found := strings.Cut(value, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid environment variable %q, expected NAME=VALUE", value)
+		}
+		if errs := validation.IsEnvVarName(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid environment variable name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if slices.ContainsFunc(envVars, func(e EnvVar) bool { return e.Name == name }) {
+			return nil, fmt.Errorf("duplicate environment variable %q", name)
+		}
+		envVars = append(envVars, EnvVar{Name: name, Value: val})
+	}
+	return envVars, nil
+}
+
+// ParseVolumes parses volumes in the configmap:NAME:MOUNT_PATH or secret:NAME:MOUNT_PATH formats
+func ParseVolumes(values []string) ([]Volume, error) {
+	var volumes []Volume
+	for _, value := range values {
+		parts := strings.SplitN(value, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid volume %q, expected configmap:NAME:MOUNT_PATH or secret:NAME:MOUNT_PATH",
+				value)
+		}
+
+		volume := Volume{Name: parts[1], MountPath: parts[2]}
+		switch strings.ToLower(parts[0]) {
+		case "configmap":
+		case "secret":
+			volume.Secret = true
+		default:
+			return nil, fmt.Errorf("invalid volume type %q in %q, expected configmap or secret", parts[0], value)
+		}
+		if errs := validation.IsDNS1123Label(volume.Name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid volume name %q: %s", volume.Name, strings.Join(errs, ", "))
+		}
+		if !path.IsAbs(volume.MountPath) {
+			return nil, fmt.Errorf("invalid mount path %q for volume %q, expected an absolute path",
+				volume.MountPath, volume.Name)
+		}
+		if slices.ContainsFunc(volumes, func(v Volume) bool {
+			return v.Name == volume.Name || v.MountPath == volume.MountPath
+		}) {
+			return nil, fmt.Errorf("duplicate volume name or mount path in %q", value)
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+// ParseProbe parses a probe in the http:PORT:PATH, tcp:PORT or exec:COMMAND formats, where the command
+// is comma-separated. It returns nil if the value is empty.
+func ParseProbe(value string) (*Probe, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	probeType, rest, _ := strings.Cut(value, ":")
+	probe := &Probe{Type: strings.ToLower(probeType)}
+	switch probe.Type {
+	case "http":
+		port, probePath, found := strings.Cut(rest, ":")
+		if !found || !strings.HasPrefix(probePath, "/") {
+			return nil, fmt.Errorf("invalid probe %q, expected http:PORT:PATH, e.g. http:8080:/healthz", value)
+		}
+		probe.Port = port
+		probe.Path = probePath
+	case "tcp":
+		probe.Port = rest
+	case "exec":
+		probe.Command = ParseCommand(rest)
+		if len(probe.Command) == 0 || probe.Command[0] == "" {
+			return nil, fmt.Errorf("invalid probe %q, expected exec:COMMAND, e.g. exec:cat,/tmp/healthy", value)
+		}
+		return probe, nil
+	default:
+		return nil, fmt.Errorf("invalid probe %q, expected http:PORT:PATH, tcp:PORT or exec:COMMAND", value)
+	}
+
+	if err := ValidatePort(probe.Port); err != nil {
+		return nil, fmt.Errorf("invalid probe %q: %w", value, err)
+	}
+	return probe, nil
+}
+
+// ParseResources parses compute resources in the requests.NAME=QUANTITY,limits.NAME=QUANTITY format,
+// such as requests.cpu=100m,limits.memory=128Mi. It returns nil if the value is empty.
+func ParseResources(value string) (*Resources, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	resources := &Resources{}
+	for item := range strings.SplitSeq(value, ",") {
+		key, quantity, found := strings.Cut(strings.TrimSpace(item), "=")
+		kind, name, hasKind := strings.Cut(key, ".")
+		if !found || !hasKind || name == "" {
+			return nil, fmt.Errorf("invalid resource %q, expected requests.NAME=QUANTITY or limits.NAME=QUANTITY",
+				item)
+		}
+		if _, err := resource.ParseQuantity(quantity); err != nil {
+			return nil, fmt.Errorf("invalid quantity %q for resource %q: %w", quantity, key, err)
+		}
+
+		var quantities *[]Quantity
+		switch kind {
+		case "requests":
+			quantities = &resources.Requests
+		case "limits":
+			quantities = &resources.Limits
+		default:
+			return nil, fmt.Errorf("invalid resource %q, expected requests.NAME=QUANTITY or limits.NAME=QUANTITY",
+				item)
+		}
+		if slices.ContainsFunc(*quantities, func(q Quantity) bool { return q.Name == name }) {
+			return nil, fmt.Errorf("duplicate resource %q", key)
+		}
+		*quantities = append(*quantities, Quantity{Name: name, Value: quantity})
+	}
+	return resources, nil
+}
+
+// ParseSidecars parses sidecar containers in the NAME=IMAGE format. The images of the sidecars are read by
+// the controller from the <KIND>_<NAME>_IMAGE environment variables of the manager.
+func ParseSidecars(kind string, values []string) ([]Sidecar, error) {
+	var sidecars []Sidecar
+	for _, value := range values {
+		name, image, found := strings.Cut(value, "=")
+		if !found || image == "" {
+			return nil, fmt.Errorf("invalid sidecar %q, expected NAME=IMAGE", value)
+		}
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid sidecar name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if name == strings.ToLower(kind) {
+			return nil, fmt.Errorf("invalid sidecar name %q: it is the name of the %s container", name, kind)
+		}
+		if slices.ContainsFunc(sidecars, func(s Sidecar) bool { return s.Name == name }) {
+			return nil, fmt.Errorf("duplicate sidecar %q", name)
+		}
+		sidecars = append(sidecars, Sidecar{
+			Name:        name,
+			Image:       image,
+			ImageEnvVar: strings.ToUpper(kind+"_"+strings.ReplaceAll(name, "-", "_")) + "_IMAGE",
+		})
+	}
+	return sidecars, nil
+}
+
+// ValidatePort returns an error if the port is not a valid port number
+func ValidatePort(port string) error {
+	number, err := strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("port must be a valid integer, got %q: %w", port, err)
+	}
+	if number < 1 || number > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535, got %d", number)
+	}
+	return nil
+}
@@ -37,6 +37,12 @@ type Types struct {
 	// Port if informed we will create the scaffold with this spec
 	Port string
 
+	// Env if true we will create the scaffold with the spec of the container environment variables
+	Env bool
+
+	// Resources if true we will create the scaffold with the spec of the container compute resources
+	Resources bool
+
 	// SkipApplyConfig adds the +kubebuilder:ac:generate=false marker so this kind is
 	// excluded from ApplyConfiguration generation when another kind in the same
 	// group/version has SSA enabled.
@@ -69,6 +75,9 @@ const typesTemplate = `{{ .Boilerplate }}
 package {{ .Resource.Version }}
 
 import (
+	{{- if or .Env .Resources }}
+	corev1 "k8s.io/api/core/v1"
+	{{- end }}
 	"k8s.io/apimachinery/pkg/runtime"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -94,6 +103,20 @@ type {{ .Resource.Kind }}Spec struct {
 	// +required
 	ContainerPort int32 ` + "`" + `json:"containerPort"` + "`" + `
 	{{- end }}
+	{{- if .Env }}
+
+	// env defines the environment variables of the container with the image
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Env []corev1.EnvVar ` + "`" + `json:"env,omitempty"` + "`" + `
+	{{- end }}
+	{{- if .Resources }}
+
+	// resources defines the compute resources of the container with the image
+	// +optional
+	Resources corev1.ResourceRequirements ` + "`" + `json:"resources,omitzero"` + "`" + `
+	{{- end }}
 }
 
 // {{ .Resource.Kind }}Status defines the observed state of {{ .Resource.Kind }}
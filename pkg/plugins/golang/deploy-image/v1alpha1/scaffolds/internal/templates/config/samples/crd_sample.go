@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/operand"
 )
 
 var _ machinery.Template = &CRDSample{}
@@ -33,6 +34,12 @@ type CRDSample struct {
 
 	// Port if informed we will create the scaffold with this spec
 	Port string
+
+	// Env if informed we will create the scaffold with this spec
+	Env []operand.EnvVar
+
+	// Resources if informed we will create the scaffold with this spec
+	Resources *operand.Resources
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -69,4 +76,28 @@ spec:
   # TODO(user): edit the following value to ensure the container has the right port to be initialized
   containerPort: {{ .Port }}
 {{ end -}}
+{{- if .Env }}
+  # TODO(user): edit the following values to set the environment variables of the container
+  env:
+  {{- range .Env }}
+  - name: {{ .Name }}
+    value: {{ printf "%q" .Value }}
+  {{- end }}
+{{ end -}}
+{{- if .Resources }}
+  # TODO(user): edit the following values to set the compute resources of the container
+  resources:
+  {{- if .Resources.Requests }}
+    requests:
+    {{- range .Resources.Requests }}
+      {{ .Name }}: {{ .Value }}
+    {{- end }}
+  {{- end }}
+  {{- if .Resources.Limits }}
+    limits:
+    {{- range .Resources.Limits }}
+      {{ .Name }}: {{ .Value }}
+    {{- end }}
+  {{- end }}
+{{ end -}}
 `
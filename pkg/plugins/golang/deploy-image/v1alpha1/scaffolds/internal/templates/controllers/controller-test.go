@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/operand"
 )
 
 var _ machinery.Template = &ControllerTest{}
@@ -35,6 +36,9 @@ type ControllerTest struct {
 	machinery.ResourceMixin
 
 	Port string
+
+	// Sidecars are the sidecar containers, whose images are also read from environment variables
+	Sidecars []operand.Sidecar
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -116,6 +120,10 @@ var _ = Describe("{{ .Resource.Kind }} controller", func() {
 			By("Setting the Image ENV VAR which stores the Operand image")
 			err= os.Setenv("{{ upper .Resource.Kind }}_IMAGE", "example.com/image:test")
 			Expect(err).NotTo(HaveOccurred())
+			{{- range .Sidecars }}
+			err = os.Setenv("{{ .ImageEnvVar }}", "example.com/{{ .Name }}:test")
+			Expect(err).NotTo(HaveOccurred())
+			{{- end }}
 
 			By("creating the custom resource for the Kind {{ .Resource.Kind }}")
 			err = k8sClient.Get(ctx, typeNamespacedName, {{ lower .Resource.Kind }})
@@ -158,6 +166,9 @@ var _ = Describe("{{ .Resource.Kind }} controller", func() {
 
 			By("Removing the Image ENV VAR which stores the Operand image")
 			_ = os.Unsetenv("{{ upper .Resource.Kind }}_IMAGE")
+			{{- range .Sidecars }}
+			_ = os.Unsetenv("{{ .ImageEnvVar }}")
+			{{- end }}
 		})
 
 		It("should successfully reconcile a custom resource for {{ .Resource.Kind }}", func() {
@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/operand"
 )
 
 var _ machinery.Template = &Controller{}
@@ -37,6 +38,9 @@ type Controller struct {
 	machinery.NamespacedMixin
 
 	ControllerRuntimeVersion string
+
+	// Container holds the options of the Operand container scaffolded in the Deployment
+	Container operand.Container
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -78,11 +82,17 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	{{- if or .Container.Env .Container.Resources }}
+	"k8s.io/apimachinery/pkg/api/equality"
+	{{- end }}
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/api/meta"
+	{{- if .Container.HasPortProbe }}
+	"k8s.io/apimachinery/pkg/util/intstr"
+	{{- end }}
 	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -335,6 +345,22 @@ func (r *{{ .Resource.Kind }}Reconciler) Reconcile(ctx context.Context, req ctrl
 		// update. Also, it will help ensure the desired state on the cluster
 		return ctrl.Result{Requeue: true}, nil
 	}
+	{{- if or .Container.Env .Container.Resources }}
+
+	// The {{ .Resource.Kind }}Spec also defines the
+	{{- if .Container.Env }} environment variables{{ end }}
+	{{- if and .Container.Env .Container.Resources }} and the{{ end }}
+	{{- if .Container.Resources }} compute resources{{ end }} of the Operand container.
+	// Therefore, the following code will ensure that the container of the Deployment is updated
+	// when they are changed in the Custom Resource which we are reconciling.
+	if update{{ .Resource.Kind }}Container(found, {{ lower .Resource.Kind }}) {
+		if err = r.Update(ctx, found); err != nil {
+			log.Error(err, "Failed to update the container of the Deployment",
+				"Deployment.Namespace", found.Namespace, "Deployment.Name", found.Name)
+			return ctrl.Result{}, err
+		}
+	}
+	{{- end }}
 
 	// The following implementation will update the status
 	meta.SetStatusCondition(&{{ lower .Resource.Kind }}.Status.Conditions, metav1.Condition{Type: typeAvailable{{ .Resource.Kind }},
@@ -369,6 +395,34 @@ func (r *{{ .Resource.Kind }}Reconciler) doFinalizerOperationsFor{{ .Resource.Ki
 		cr.Namespace)
 }
 
+{{ if or .Container.Env .Container.Resources -}}
+// update{{ .Resource.Kind }}Container applies the specs of the Custom Resource to the Operand container
+// of the Deployment, and returns true if the container was changed.
+func update{{ .Resource.Kind }}Container(
+	dep *appsv1.Deployment, {{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) bool {
+	updated := false
+	for i := range dep.Spec.Template.Spec.Containers {
+		container := &dep.Spec.Template.Spec.Containers[i]
+		if container.Name != {{ lower .Resource.Kind }}ContainerName {
+			continue
+		}
+		{{- if .Container.Env }}
+		if !equality.Semantic.DeepEqual(container.Env, {{ lower .Resource.Kind }}.Spec.Env) {
+			container.Env = {{ lower .Resource.Kind }}.Spec.Env
+			updated = true
+		}
+		{{- end }}
+		{{- if .Container.Resources }}
+		if !equality.Semantic.DeepEqual(container.Resources, {{ lower .Resource.Kind }}.Spec.Resources) {
+			container.Resources = {{ lower .Resource.Kind }}.Spec.Resources
+			updated = true
+		}
+		{{- end }}
+	}
+	return updated
+}
+
+{{ end -}}
 // deploymentFor{{ .Resource.Kind }} returns a {{ .Resource.Kind }} Deployment object
 func (r *{{ .Resource.Kind }}Reconciler) deploymentFor{{ .Resource.Kind }}(
 	{{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) (*appsv1.Deployment, error) {
@@ -379,6 +433,14 @@ func (r *{{ .Resource.Kind }}Reconciler) deploymentFor{{ .Resource.Kind }}(
 	if err != nil {
     	return nil, err
 	}
+	{{- if .Container.Sidecars }}
+
+	// Get the images of the sidecar containers
+	sidecarImages, err := sidecarImagesFor{{ .Resource.Kind }}()
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}
 
 	dep := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -432,7 +494,135 @@ func (r *{{ .Resource.Kind }}Reconciler) deploymentFor{{ .Resource.Kind }}(
 							Type: corev1.SeccompProfileTypeRuntimeDefault,
 						},
 					},
-					//TODO: scaffold container,
+					Containers: []corev1.Container{{ "{{" }}
+						Image:           image,
+						Name:            {{ lower .Resource.Kind }}ContainerName,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						// Ensure restrictive context for the container
+						// More info: https://kubernetes.io/docs/concepts/security/pod-security-standards/#restricted
+						SecurityContext: &corev1.SecurityContext{
+							RunAsNonRoot:             new(true),
+							{{- if .Container.RunAsUser }}
+							RunAsUser:                new(int64({{ .Container.RunAsUser }})),
+							{{- end }}
+							AllowPrivilegeEscalation: new(false),
+							Capabilities: &corev1.Capabilities{
+								Drop: []corev1.Capability{
+									"ALL",
+								},
+							},
+						},
+						{{- if .Container.Port }}
+						Ports: []corev1.ContainerPort{{ "{{" }}
+							ContainerPort: {{ lower .Resource.Kind }}.Spec.ContainerPort,
+							Name:          {{ lower .Resource.Kind }}ContainerName,
+						{{ "}}" }},
+						{{- end }}
+						{{- if .Container.Command }}
+						Command: []string{ {{- range $i, $arg := .Container.Command }}{{ if $i }}, {{ end }}{{ printf "%q" $arg }}{{ end -}} },
+						{{- end }}
+						{{- if .Container.Env }}
+						Env: {{ lower .Resource.Kind }}.Spec.Env,
+						{{- end }}
+						{{- if .Container.Volumes }}
+						VolumeMounts: []corev1.VolumeMount{
+							{{- range .Container.Volumes }}
+							{
+								Name:      "{{ .Name }}",
+								MountPath: "{{ .MountPath }}",
+								ReadOnly:  true,
+							},
+							{{- end }}
+						},
+						{{- end }}
+						{{- with .Container.LivenessProbe }}
+						LivenessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								{{- if eq .Type "http" }}
+								HTTPGet: &corev1.HTTPGetAction{
+									Path: "{{ .Path }}",
+									Port: intstr.FromInt32({{ .Port }}),
+								},
+								{{- else if eq .Type "tcp" }}
+								TCPSocket: &corev1.TCPSocketAction{
+									Port: intstr.FromInt32({{ .Port }}),
+								},
+								{{- else }}
+								Exec: &corev1.ExecAction{
+									Command: []string{ {{- range $i, $arg := .Command }}{{ if $i }}, {{ end }}{{ printf "%q" $arg }}{{ end -}} },
+								},
+								{{- end }}
+							},
+							// TODO(user): Adjust the timings of the liveness probe to the Operand
+							InitialDelaySeconds: 15,
+							PeriodSeconds:       20,
+						},
+						{{- end }}
+						{{- with .Container.ReadinessProbe }}
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								{{- if eq .Type "http" }}
+								HTTPGet: &corev1.HTTPGetAction{
+									Path: "{{ .Path }}",
+									Port: intstr.FromInt32({{ .Port }}),
+								},
+								{{- else if eq .Type "tcp" }}
+								TCPSocket: &corev1.TCPSocketAction{
+									Port: intstr.FromInt32({{ .Port }}),
+								},
+								{{- else }}
+								Exec: &corev1.ExecAction{
+									Command: []string{ {{- range $i, $arg := .Command }}{{ if $i }}, {{ end }}{{ printf "%q" $arg }}{{ end -}} },
+								},
+								{{- end }}
+							},
+							// TODO(user): Adjust the timings of the readiness probe to the Operand
+							InitialDelaySeconds: 5,
+							PeriodSeconds:       10,
+						},
+						{{- end }}
+						{{- if .Container.Resources }}
+						Resources: {{ lower .Resource.Kind }}.Spec.Resources,
+						{{- end }}
+					{{- range .Container.Sidecars }}
+					}, {
+						Image:           sidecarImages["{{ .Name }}"],
+						Name:            "{{ .Name }}",
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						SecurityContext: &corev1.SecurityContext{
+							RunAsNonRoot:             new(true),
+							{{- if $.Container.RunAsUser }}
+							RunAsUser:                new(int64({{ $.Container.RunAsUser }})),
+							{{- end }}
+							AllowPrivilegeEscalation: new(false),
+							Capabilities: &corev1.Capabilities{
+								Drop: []corev1.Capability{
+									"ALL",
+								},
+							},
+						},
+					{{- end }}
+					{{ "}}" }},
+					{{- if .Container.Volumes }}
+					Volumes: []corev1.Volume{
+						{{- range .Container.Volumes }}
+						{
+							Name: "{{ .Name }}",
+							VolumeSource: corev1.VolumeSource{
+								{{- if .Secret }}
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "{{ .Name }}",
+								},
+								{{- else }}
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "{{ .Name }}"},
+								},
+								{{- end }}
+							},
+						},
+						{{- end }}
+					},
+					{{- end }}
 				},
 			},
 		},
@@ -471,6 +661,28 @@ func imageFor{{ .Resource.Kind }}() (string, error) {
     }
     return image, nil
 }
+{{- if .Container.Sidecars }}
+
+// sidecarImagesFor{{ .Resource.Kind }} gets the images of the sidecar containers, by container name,
+// from the environment variables defined in the config/manager/manager.yaml
+func sidecarImagesFor{{ .Resource.Kind }}() (map[string]string, error) {
+	imageEnvVars := map[string]string{
+		{{- range .Container.Sidecars }}
+		"{{ .Name }}": "{{ .ImageEnvVar }}",
+		{{- end }}
+	}
+	images := make(map[string]string, len(imageEnvVars))
+	for name, imageEnvVar := range imageEnvVars {
+		image, found := os.LookupEnv(imageEnvVar)
+		if !found {
+			return nil, fmt.Errorf("unable to find %s environment variable with the image of the %s sidecar",
+				imageEnvVar, name)
+		}
+		images[name] = image
+	}
+	return images, nil
+}
+{{- end }}
 
 // SetupWithManager sets up the controller with the Manager.
 // The whole idea is to be watching the resources that matter for the controller.
@@ -53,6 +53,13 @@ var coreGroups = map[string]string{
 	"storage":               k8sIODomainSuffix,
 }
 
+// CoreGroupDomain returns the domain of a well-known group of the Kubernetes API, such as "k8s.io"
+// for "networking", and whether the group is one of them.
+func CoreGroupDomain(group string) (string, bool) {
+	domain, found := coreGroups[group]
+	return domain, found
+}
+
 // Options contains the information required to build a new resource.Resource.
 type Options struct {
 	// Plural is the resource's kind plural form.
@@ -87,7 +87,7 @@ func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 		"Run 'make generate' after generating files (enabled by default; use --make=false to disable)")
 
 	fs.BoolVar(&p.force, "force", false,
-		"If set, attempt to create resource even if it already exists")
+		"If set, attempt to create resource even if it already exists, merging manual edits of its files")
 
 	p.options = &goPlugin.Options{}
 
@@ -333,7 +333,10 @@ func (p *createAPISubcommand) PostScaffold() error {
 		if err != nil {
 			return fmt.Errorf("error running make generate: %w", err)
 		}
-		fmt.Print("Next: implement your new API and generate the manifests (e.g. CRDs,CRs) with:\n$ make manifests\n")
+		util.PrintNextSteps(util.NextStep{
+			Description: "implement your new API and generate the manifests (e.g. CRDs,CRs)",
+			Command:     "make manifests",
+		})
 	}
 
 	return nil
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var _ plugin.DeleteAPISubcommand = &deleteAPISubcommand{}
+
+type deleteAPISubcommand struct {
+	config config.Config
+	// For help text.
+	commandName string
+
+	resource *resource.Resource
+
+	// runMake indicates whether to run make or not after removing the API
+	runMake bool
+}
+
+func (p *deleteAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	p.commandName = cliMeta.CommandName
+
+	subcmdMeta.Description = `Remove a Kubernetes API scaffolded with "create api".
+
+The Go type, the controllers and their tests are deleted, the code inserted in cmd/main.go and
+in the controller test suite is removed, and the resource is dropped from the PROJECT file.
+Code that is still used by other resources, such as the imports of their group version, is kept.
+
+The webhooks of the resource must be deleted first with "delete webhook".
+After removing the scaffold, Kubebuilder updates dependencies and runs make generate
+unless --make=false is set.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Delete the API and controllers for Group: ship, Version: v1beta1
+  # and Kind: Frigate
+  %[1]s delete api --group ship --version v1beta1 --kind Frigate
+`, cliMeta.CommandName)
+}
+
+func (p *deleteAPISubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&p.runMake, "make", true,
+		"Run 'make generate' after removing files (enabled by default; use --make=false to disable)")
+}
+
+func (p *deleteAPISubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *deleteAPISubcommand) InjectResource(res *resource.Resource) error {
+	stored, err := util.GetStoredResource(p.config, res.GVK)
+	if err != nil {
+		return fmt.Errorf("no API found for %s/%s, Kind %s: %w", res.QualifiedGroup(), res.Version, res.Kind, err)
+	}
+
+	if !stored.HasAPI() && !stored.HasController() {
+		return fmt.Errorf("no API or controller was scaffolded for %s/%s, Kind %s",
+			stored.QualifiedGroup(), stored.Version, stored.Kind)
+	}
+	if stored.Webhooks != nil && !stored.Webhooks.IsEmpty() {
+		return fmt.Errorf("the resource has webhooks: delete them first with '%s delete webhook'", p.commandName)
+	}
+
+	*res = stored
+	p.resource = res
+
+	return nil
+}
+
+func (p *deleteAPISubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewDeleteAPIScaffolder(p.config, *p.resource)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to remove API scaffold: %w", err)
+	}
+
+	return nil
+}
+
+func (p *deleteAPISubcommand) PostScaffold() error {
+	err := util.RunCmd("Update dependencies", "go", "mod", "tidy")
+	if err != nil {
+		return fmt.Errorf("error updating go dependencies: %w", err)
+	}
+	if p.runMake && p.resource.HasAPI() {
+		err = util.RunCmd("Running make", "make", "generate")
+		if err != nil {
+			return fmt.Errorf("error running make generate: %w", err)
+		}
+	}
+
+	next := util.NextStep{Description: "regenerate the manifests (e.g. CRDs, RBAC)", Command: "make manifests"}
+	if !p.runMake && p.resource.HasAPI() {
+		next = util.NextStep{
+			Description: "regenerate the deepcopy functions and the manifests (e.g. CRDs, RBAC)",
+			Command:     "make generate manifests",
+		}
+	}
+	util.PrintNextSteps(next)
+
+	return nil
+}
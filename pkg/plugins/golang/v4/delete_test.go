@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("delete subcommands", func() {
+	var (
+		cfg    config.Config
+		res    *resource.Resource
+		stored resource.Resource
+	)
+
+	BeforeEach(func() {
+		cfg = cfgv3.New()
+		_ = cfg.SetRepository("github.com/example/test")
+
+		res = &resource.Resource{
+			GVK: resource.GVK{
+				Group:   crewGroup,
+				Domain:  testIO,
+				Version: "v1",
+				Kind:    captainKind,
+			},
+			Plural:   captains,
+			API:      &resource.API{},
+			Webhooks: &resource.Webhooks{},
+		}
+		stored = resource.Resource{
+			GVK:        res.GVK,
+			Plural:     captains,
+			Path:       "github.com/example/test/api/v1",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}
+	})
+
+	Context("deleteAPISubcommand", func() {
+		var subCmd *deleteAPISubcommand
+
+		BeforeEach(func() {
+			subCmd = &deleteAPISubcommand{commandName: testCommandName}
+			Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		})
+
+		It("should inject the resource stored in the project configuration", func() {
+			Expect(cfg.AddResource(stored)).To(Succeed())
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.Path).To(Equal(stored.Path))
+			Expect(res.HasAPI()).To(BeTrue())
+			Expect(res.HasController()).To(BeTrue())
+		})
+
+		It("should fail if the resource is not tracked in the project configuration", func() {
+			Expect(subCmd.InjectResource(res)).To(MatchError(ContainSubstring("no API found")))
+		})
+
+		It("should fail if the resource still has webhooks", func() {
+			stored.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+			Expect(cfg.AddResource(stored)).To(Succeed())
+
+			Expect(subCmd.InjectResource(res)).To(MatchError(ContainSubstring("delete them first")))
+		})
+	})
+
+	Context("deleteWebhookSubcommand", func() {
+		var subCmd *deleteWebhookSubcommand
+
+		BeforeEach(func() {
+			subCmd = &deleteWebhookSubcommand{}
+			Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		})
+
+		It("should inject the resource stored in the project configuration", func() {
+			stored.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+			Expect(cfg.AddResource(stored)).To(Succeed())
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.HasDefaultingWebhook()).To(BeTrue())
+		})
+
+		It("should fail if the resource has no webhooks", func() {
+			Expect(cfg.AddResource(stored)).To(Succeed())
+
+			Expect(subCmd.InjectResource(res)).To(MatchError(ContainSubstring("no webhook was scaffolded")))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var _ plugin.DeleteWebhookSubcommand = &deleteWebhookSubcommand{}
+
+type deleteWebhookSubcommand struct {
+	config config.Config
+
+	resource *resource.Resource
+}
+
+func (p *deleteWebhookSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Remove the webhooks scaffolded with "create webhook" for an API resource.
+
+The webhook implementation and its test are deleted, the code inserted in cmd/main.go, in the
+webhook test suite and in the e2e tests is removed, and the webhooks are dropped from the PROJECT file.
+For conversion webhooks, the hub and spoke conversion files are deleted as well.
+Code that is still used by the webhooks of other resources is kept.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Delete the webhooks for Group: ship, Version: v1beta1
+  # and Kind: Frigate
+  %[1]s delete webhook --group ship --version v1beta1 --kind Frigate
+`, cliMeta.CommandName)
+}
+
+func (p *deleteWebhookSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) InjectResource(res *resource.Resource) error {
+	stored, err := util.GetStoredResource(p.config, res.GVK)
+	if err != nil {
+		return fmt.Errorf("no resource found for %s/%s, Kind %s: %w", res.QualifiedGroup(), res.Version, res.Kind, err)
+	}
+
+	if stored.Webhooks == nil || stored.Webhooks.IsEmpty() {
+		return fmt.Errorf("no webhook was scaffolded for %s/%s, Kind %s",
+			stored.QualifiedGroup(), stored.Version, stored.Kind)
+	}
+
+	*res = stored
+	p.resource = res
+
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewDeleteWebhookScaffolder(p.config, *p.resource)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to remove webhook scaffold: %w", err)
+	}
+
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) PostScaffold() error {
+	err := util.RunCmd("Update dependencies", "go", "mod", "tidy")
+	if err != nil {
+		return fmt.Errorf("error updating go dependencies: %w", err)
+	}
+
+	util.PrintNextSteps(util.NextStep{Description: "regenerate the manifests", Command: "make manifests"})
+
+	return nil
+}
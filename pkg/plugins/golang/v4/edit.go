@@ -19,13 +19,16 @@ package v4
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/pflag"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
 )
 
@@ -41,6 +44,9 @@ type editSubcommand struct {
 	license     string
 	owner       string
 
+	// wasMultiGroup is the layout of the project before the edit
+	wasMultiGroup bool
+
 	// fs stores the FlagSet to check if flags were explicitly set
 	fs *pflag.FlagSet
 }
@@ -51,16 +57,20 @@ func (p *editSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *
 Multigroup (--multigroup):
   Enable or disable multi-group layout.
   Changes API structure: api/<version>/ becomes api/<group>/<version>/
-  Automatic: Updates PROJECT file, future APIs use new structure
-  Manual: Move existing API files, update import paths in controllers
+  Automatic: Moves the existing APIs, controllers, webhooks and their test suites to the new layout,
+  updates their imports, cmd/main.go, the kustomize manifests and the PROJECT file, and runs
+  'make manifests' to regenerate the apply configurations of the APIs using Server-Side Apply
+  Manual: Run 'make manifests generate' to regenerate the code that depends on the layout
+  Disabling requires all the APIs of the project to belong to a single group
   More info: https://book.kubebuilder.io/migration/multi-group.html
 
 Namespaced (--namespaced):
   Enable or disable namespace-scoped deployment.
   Manager watches one or more specific namespaces vs all namespaces.
   Namespaces to watch are configured via WATCH_NAMESPACE environment variable.
-  Automatic: Updates PROJECT file, scaffolds Role/RoleBinding, uses --force to regenerate manager.yaml
-  Manual: Add namespace= to RBAC markers in existing controllers, update cmd/main.go, run 'make manifests'
+  Automatic: Updates PROJECT file, scaffolds Role/RoleBinding, uses --force to regenerate manager.yaml,
+  updates the RBAC markers of the existing controllers and the manager cache in cmd/main.go
+  Manual: Run 'make manifests' to regenerate the RBAC manifests
   More info: https://book.kubebuilder.io/migration/namespace-scoped.html 
   
   WARNING - Webhooks and Namespace-Scoped Mode:
@@ -70,9 +80,10 @@ Namespaced (--namespaced):
   webhook scope with the cache.
 
 Force (--force):
-  Overwrite existing scaffolded files to apply configuration changes.
+  Regenerate existing scaffolded files to apply configuration changes.
   Example: With --namespaced, regenerates config/manager/manager.yaml to add WATCH_NAMESPACE env var.
-  Warning: This overwrites default scaffold files; manual changes in those files may be lost.
+  Manual changes are merged with the pristine scaffold output stored under .kubebuilder/pristine/;
+  conflicting changes are left between conflict markers. Files without a pristine output are overwritten.
 
 Note: To add optional plugins after initialization, use 'kubebuilder edit --plugins <plugin-name>'.
       Run 'kubebuilder edit --plugins --help' to see available plugins.
@@ -106,7 +117,7 @@ func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
 		"Enable or disable multigroup layout (organize APIs by group); use --multigroup=false to disable")
 	fs.BoolVar(&p.namespaced, "namespaced", false,
 		"Enable or disable namespace-scoped deployment (default: cluster-scoped); use --namespaced=false to disable")
-	fs.BoolVar(&p.force, "force", false, "If set, overwrite scaffolded files to apply changes (manual edits may be lost)")
+	fs.BoolVar(&p.force, "force", false, "If set, regenerate scaffolded files to apply changes, merging manual edits")
 	fs.StringVar(&p.licenseFile, "license-file", "",
 		"Path to custom license file; content copied to hack/boilerplate.go.txt")
 	fs.StringVar(&p.license, "license", "",
@@ -149,6 +160,8 @@ func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
 		}
 	}
 
+	p.wasMultiGroup = p.config.IsMultiGroup()
+
 	// If flags were not explicitly set, preserve existing PROJECT file values
 	// This prevents one flag from clearing another when using default values
 	// Only when FlagSet was bound (e.g. from CLI); tests may call PreScaffold without BindFlags
@@ -161,6 +174,33 @@ func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
 		}
 	}
 
+	if !p.multigroup && p.config.IsMultiGroup() {
+		if err := p.validateSingleGroup(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSingleGroup checks that the APIs scaffolded in the project can be moved to the single-group layout,
+// where all of them share the api/<version> packages.
+func (p *editSubcommand) validateSingleGroup() error {
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	var groups []string
+	for _, res := range resources {
+		if res.HasAPI() && !res.IsExternal() && !slices.Contains(groups, res.Group) {
+			groups = append(groups, res.Group)
+		}
+	}
+	if len(groups) > 1 {
+		return fmt.Errorf("unable to disable the multi-group layout: the project has APIs in multiple groups (%s)",
+			strings.Join(groups, ", "))
+	}
 	return nil
 }
 
@@ -174,3 +214,22 @@ func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
 
 	return nil
 }
+
+func (p *editSubcommand) PostScaffold() error {
+	// The apply configurations generated for the previous layout were removed when moving the APIs
+	if p.multigroup == p.wasMultiGroup {
+		return nil
+	}
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("failed to get resources: %w", err)
+	}
+	if !slices.ContainsFunc(resources, func(res resource.Resource) bool { return res.HasAPI() && res.API.SSA }) {
+		return nil
+	}
+
+	if err = util.RunCmd("Running make", "make", "manifests"); err != nil {
+		return fmt.Errorf("error regenerating the apply configurations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var _ plugin.EditResourceSubcommand = &editResourceSubcommand{}
+
+type editResourceSubcommand struct {
+	config config.Config
+
+	// fromGVK and toGVK are the GVKs of the resource before and after the rename, as provided by the flags
+	fromGVK string
+	toGVK   string
+
+	from resource.Resource
+	to   resource.Resource
+
+	// runMake indicates whether to run make or not after renaming the API
+	runMake bool
+}
+
+func (p *editResourceSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Rename or move a Kubernetes API scaffolded with "create api" to another group,
+version or kind.
+
+The Go type, the controllers, the webhooks and their tests are moved to the paths of the new
+group, version and kind, and the identifiers, import aliases, RBAC and webhook markers that refer
+to the resource are renamed, keeping the rest of the code of these files. The code inserted in
+cmd/main.go, in the test suites and in the e2e tests is replaced, the references to the Go types
+in other files of the project are updated, and the resource is renamed in the PROJECT file.
+
+Resources are provided as <group>/<version>/<Kind>. APIs with conversion webhooks or
+with several versions cannot be renamed. After renaming the scaffold, Kubebuilder runs
+make generate unless --make=false is set.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Rename the Kind Frigate of the Group ship and Version v1beta1 to Cruiser
+  %[1]s edit resource --from ship/v1beta1/Frigate --to ship/v1beta1/Cruiser
+
+  # Move the same API to the Group fleet and Version v1
+  %[1]s edit resource --from ship/v1beta1/Frigate --to fleet/v1/Frigate
+`, cliMeta.CommandName)
+}
+
+func (p *editResourceSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.fromGVK, "from", "", "resource to rename, as <group>/<version>/<Kind>")
+	fs.StringVar(&p.toGVK, "to", "", "new group, version and kind of the resource, as <group>/<version>/<Kind>")
+	fs.BoolVar(&p.runMake, "make", true,
+		"Run 'make generate' after renaming the API (enabled by default; use --make=false to disable)")
+}
+
+func (p *editResourceSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+
+	fromGVK, err := util.ParseGVK(p.fromGVK, c.GetDomain())
+	if err != nil {
+		return fmt.Errorf("invalid --from flag: %w", err)
+	}
+	toGVK, err := util.ParseGVK(p.toGVK, c.GetDomain())
+	if err != nil {
+		return fmt.Errorf("invalid --to flag: %w", err)
+	}
+
+	p.from, err = util.GetStoredResource(c, fromGVK)
+	if err != nil {
+		return fmt.Errorf("no API found for %s: %w", p.fromGVK, err)
+	}
+	p.to = util.RenameResource(c, p.from, toGVK)
+
+	return p.validate()
+}
+
+func (p *editResourceSubcommand) validate() error {
+	if p.from.Group == p.to.Group && p.from.Version == p.to.Version && p.from.Kind == p.to.Kind {
+		return errors.New("the --from and --to flags refer to the same resource")
+	}
+	if !p.from.HasAPI() || p.from.IsExternal() {
+		return fmt.Errorf("only APIs scaffolded with \"create api\" can be renamed, %s was not", p.fromGVK)
+	}
+	if p.from.HasConversionWebhook() {
+		return fmt.Errorf("APIs with conversion webhooks cannot be renamed, %s has one", p.fromGVK)
+	}
+	if err := p.to.Validate(); err != nil {
+		return fmt.Errorf("invalid resource %s: %w", p.toGVK, err)
+	}
+
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("failed to load resources from project configuration: %w", err)
+	}
+	others := slices.DeleteFunc(resources, func(res resource.Resource) bool { return res.IsEqualTo(p.from.GVK) })
+
+	if slices.ContainsFunc(others, func(res resource.Resource) bool {
+		return res.Group == p.from.Group && res.Kind == p.from.Kind
+	}) {
+		return fmt.Errorf("APIs with several versions cannot be renamed, %s has more than one", p.fromGVK)
+	}
+	if slices.ContainsFunc(others, func(res resource.Resource) bool {
+		return res.Group == p.to.Group && res.Version == p.to.Version && res.Kind == p.to.Kind
+	}) {
+		return fmt.Errorf("resource %s already exists", p.toGVK)
+	}
+
+	// Check that the provided group can be added to the project
+	if !p.config.IsMultiGroup() && p.from.Group != p.to.Group && len(others) != 0 &&
+		!slices.ContainsFunc(others, func(res resource.Resource) bool { return res.Group == p.to.Group }) {
+		return errors.New("multiple groups are not allowed by default, " +
+			"to enable multi-group visit https://kubebuilder.io/migration/multi-group.html")
+	}
+
+	return nil
+}
+
+func (p *editResourceSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewEditResourceScaffolder(p.config, p.from, p.to, p.runMake)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to rename API scaffold: %w", err)
+	}
+
+	return nil
+}
+
+func (p *editResourceSubcommand) PostScaffold() error {
+	if p.runMake {
+		err := util.RunCmd("Running make", "make", "generate")
+		if err != nil {
+			return fmt.Errorf("error running make generate: %w", err)
+		}
+	}
+
+	util.PrintNextSteps(util.NextStep{
+		Description: "regenerate the manifests (e.g. CRDs, RBAC)",
+		Command:     "make manifests",
+	})
+
+	return nil
+}
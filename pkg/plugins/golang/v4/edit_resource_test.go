@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("editResourceSubcommand", func() {
+	var (
+		subCmd *editResourceSubcommand
+		cfg    config.Config
+		stored resource.Resource
+	)
+
+	BeforeEach(func() {
+		subCmd = &editResourceSubcommand{fromGVK: "crew/v1/Captain"}
+		cfg = cfgv3.New()
+		_ = cfg.SetRepository("github.com/example/test")
+		_ = cfg.SetDomain(testIO)
+
+		stored = resource.Resource{
+			GVK: resource.GVK{
+				Group:   crewGroup,
+				Domain:  testIO,
+				Version: "v1",
+				Kind:    captainKind,
+			},
+			Plural:      captains,
+			Path:        "github.com/example/test/api/v1",
+			API:         &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controllers: &resource.Controllers{{Name: "captain"}, {Name: "captain-backup"}},
+		}
+	})
+
+	It("should rename the resource stored in the project configuration", func() {
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		subCmd.toGVK = "crew/v2/Frigate"
+
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		Expect(subCmd.from.Path).To(Equal(stored.Path))
+		Expect(subCmd.to.Version).To(Equal("v2"))
+		Expect(subCmd.to.Kind).To(Equal("Frigate"))
+		Expect(subCmd.to.Plural).To(Equal("frigates"))
+		Expect(subCmd.to.Path).To(Equal("github.com/example/test/api/v2"))
+		Expect(subCmd.to.GetControllerNames()).To(Equal([]string{"frigate", "frigate-backup"}))
+	})
+
+	It("should fail if the flags are not valid GVKs", func() {
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		subCmd.toGVK = "crew/Frigate"
+
+		Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("invalid --to flag")))
+	})
+
+	It("should fail if the resource is not tracked in the project configuration", func() {
+		subCmd.toGVK = "crew/v1/Frigate"
+
+		Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("no API found")))
+	})
+
+	It("should fail if both flags refer to the same resource", func() {
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		subCmd.toGVK = "crew/v1/Captain"
+
+		Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("same resource")))
+	})
+
+	It("should fail if the resource has a conversion webhook", func() {
+		stored.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true, Spoke: []string{"v2"}}
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		subCmd.toGVK = "crew/v1/Frigate"
+
+		Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("conversion webhooks")))
+	})
+
+	It("should fail if the resource has several versions", func() {
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		other := stored.Copy()
+		other.Version = "v2"
+		other.Path = "github.com/example/test/api/v2"
+		Expect(cfg.AddResource(other)).To(Succeed())
+		subCmd.toGVK = "crew/v1/Frigate"
+
+		Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("several versions")))
+	})
+
+	It("should fail if the new resource already exists", func() {
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		other := stored.Copy()
+		other.Kind = "Frigate"
+		other.Plural = "frigates"
+		Expect(cfg.AddResource(other)).To(Succeed())
+		subCmd.toGVK = "crew/v1/Frigate"
+
+		Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("already exists")))
+	})
+
+	It("should fail to add another group to a single-group project", func() {
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		other := stored.Copy()
+		other.Kind = "Sailor"
+		other.Plural = "sailors"
+		Expect(cfg.AddResource(other)).To(Succeed())
+		subCmd.toGVK = "fleet/v1/Captain"
+
+		Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("multiple groups are not allowed")))
+	})
+})
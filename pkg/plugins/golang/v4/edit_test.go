@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
 )
@@ -154,6 +155,40 @@ var _ = Describe("editSubcommand", func() {
 			Expect(subCmd.multigroup).To(BeTrue(), "multigroup should be preserved from PROJECT file")
 			Expect(subCmd.namespaced).To(BeTrue(), "namespaced should be preserved from PROJECT file")
 		})
+
+		It("should fail to disable multigroup when the APIs belong to multiple groups", func() {
+			Expect(cfg.SetMultiGroup()).To(Succeed())
+			for _, group := range []string{"crew", "ship"} {
+				Expect(cfg.AddResource(resource.Resource{
+					GVK:  resource.GVK{Group: group, Domain: "testproject.org", Version: "v1", Kind: "Captain"},
+					Path: "sigs.k8s.io/test/api/" + group + "/v1",
+					API:  &resource.API{CRDVersion: "v1", Namespaced: true},
+				})).To(Succeed())
+			}
+			Expect(fs.Set("multigroup", "false")).To(Succeed())
+
+			err := subCmd.PreScaffold(mockFS)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("APIs in multiple groups (crew, ship)"))
+		})
+
+		It("should allow disabling multigroup when only external APIs belong to other groups", func() {
+			Expect(cfg.SetMultiGroup()).To(Succeed())
+			Expect(cfg.AddResource(resource.Resource{
+				GVK:  resource.GVK{Group: "crew", Domain: "testproject.org", Version: "v1", Kind: "Captain"},
+				Path: "sigs.k8s.io/test/api/crew/v1",
+				API:  &resource.API{CRDVersion: "v1", Namespaced: true},
+			})).To(Succeed())
+			Expect(cfg.AddResource(resource.Resource{
+				GVK:        resource.GVK{Group: "cert-manager", Domain: "io", Version: "v1", Kind: "Certificate"},
+				Path:       "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1",
+				Controller: true,
+				External:   true,
+			})).To(Succeed())
+			Expect(fs.Set("multigroup", "false")).To(Succeed())
+
+			Expect(subCmd.PreScaffold(mockFS)).To(Succeed())
+		})
 	})
 
 	Context("Boilerplate update", func() {
@@ -541,4 +576,28 @@ Custom License Header
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Context("PostScaffold", func() {
+		BeforeEach(func() {
+			Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+			Expect(cfg.AddResource(resource.Resource{
+				GVK:    resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"},
+				Plural: "captains",
+				API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+			})).To(Succeed())
+		})
+
+		It("should not regenerate the apply configurations when the layout is unchanged", func() {
+			subCmd.multigroup = true
+			subCmd.wasMultiGroup = true
+
+			Expect(subCmd.PostScaffold()).To(Succeed())
+		})
+
+		It("should not regenerate the apply configurations when no API uses Server-Side Apply", func() {
+			subCmd.multigroup = true
+
+			Expect(subCmd.PostScaffold()).To(Succeed())
+		})
+	})
 })
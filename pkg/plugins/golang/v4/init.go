@@ -254,7 +254,7 @@ func (p *initSubcommand) PostScaffold() error {
 		return fmt.Errorf("error updating go dependencies: %w", err)
 	}
 
-	fmt.Printf("Next: define a resource with:\n$ %s create api\n", p.commandName)
+	util.PrintNextSteps(util.NextStep{Description: "define a resource", Command: p.commandName + " create api"})
 	return nil
 }
 
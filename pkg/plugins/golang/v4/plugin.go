@@ -17,11 +17,15 @@ limitations under the License.
 package v4
 
 import (
+	"fmt"
+
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
 )
 
 const pluginName = "base." + golang.DefaultNameQualifier
@@ -31,7 +35,13 @@ var (
 	supportedProjectVersions = []config.Version{cfgv3.Version}
 )
 
-var _ plugin.Full = Plugin{}
+var (
+	_ plugin.Full          = Plugin{}
+	_ plugin.EditResource  = Plugin{}
+	_ plugin.DeleteAPI     = Plugin{}
+	_ plugin.DeleteWebhook = Plugin{}
+	_ plugin.Diagnosable   = Plugin{}
+)
 
 // Plugin implements the plugin.Full interface
 type Plugin struct {
@@ -39,6 +49,9 @@ type Plugin struct {
 	createAPISubcommand
 	createWebhookSubcommand
 	editSubcommand
+	editResourceSubcommand
+	deleteAPISubcommand
+	deleteWebhookSubcommand
 }
 
 // Name returns the name of the plugin
@@ -64,6 +77,19 @@ func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
 // GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
 func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcommand }
 
+// GetEditResourceSubcommand will return the subcommand which is responsible for renaming apis
+func (p Plugin) GetEditResourceSubcommand() plugin.EditResourceSubcommand {
+	return &p.editResourceSubcommand
+}
+
+// GetDeleteAPISubcommand will return the subcommand which is responsible for removing apis
+func (p Plugin) GetDeleteAPISubcommand() plugin.DeleteAPISubcommand { return &p.deleteAPISubcommand }
+
+// GetDeleteWebhookSubcommand will return the subcommand which is responsible for removing webhooks
+func (p Plugin) GetDeleteWebhookSubcommand() plugin.DeleteWebhookSubcommand {
+	return &p.deleteWebhookSubcommand
+}
+
 // Description returns a short description of the plugin
 func (Plugin) Description() string {
 	return "Default scaffold (go/v4 + kustomize/v2)"
@@ -73,3 +99,12 @@ func (Plugin) Description() string {
 func (p Plugin) DeprecationWarning() string {
 	return ""
 }
+
+// Diagnose checks that the Go code of the APIs tracked in the project configuration is present and wired
+func (Plugin) Diagnose(cfg config.Config, fs machinery.Filesystem) ([]plugin.Diagnostic, error) {
+	diagnostics, err := scaffolds.Diagnose(cfg, fs)
+	if err != nil {
+		return nil, fmt.Errorf("error checking the Go code: %w", err)
+	}
+	return diagnostics, nil
+}
@@ -20,7 +20,6 @@ import (
 	"errors"
 	"fmt"
 	log "log/slog"
-	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -194,9 +193,10 @@ const acGenerateMarkerHint = "Add '// +kubebuilder:ac:generate=true' to the pack
 // On failure, logs a warning and does not stop scaffolding.
 func (s *apiScaffolder) updateGroupVersionInfo() {
 	groupVersionPath := filepath.Join(s.apiPackageDir(), "groupversion_info.go")
+	editor := util.NewFileEditor(s.fs)
 
 	// Check if marker already exists to avoid duplicates when using --force or multiple kinds
-	hasMarker, err := util.HasFileContentWith(groupVersionPath, "+kubebuilder:ac:generate=true")
+	hasMarker, err := editor.HasFileContentWith(groupVersionPath, "+kubebuilder:ac:generate=true")
 	if err != nil {
 		log.Warn("unable to check the '+kubebuilder:ac:generate=true' marker. "+acGenerateMarkerHint,
 			"path", groupVersionPath, "error", err)
@@ -209,7 +209,7 @@ func (s *apiScaffolder) updateGroupVersionInfo() {
 	// Add the marker after the object:generate marker. The anchor is checked
 	// first because the InsertCode error would echo the whole file.
 	marker := `// +kubebuilder:object:generate=true`
-	hasAnchor, err := util.HasFileContentWith(groupVersionPath, marker)
+	hasAnchor, err := editor.HasFileContentWith(groupVersionPath, marker)
 	if err != nil {
 		log.Warn("unable to check the '+kubebuilder:object:generate=true' marker. "+acGenerateMarkerHint,
 			"path", groupVersionPath, "error", err)
@@ -222,7 +222,7 @@ func (s *apiScaffolder) updateGroupVersionInfo() {
 	}
 
 	insert := "\n// +kubebuilder:ac:generate=true"
-	if err := util.InsertCode(groupVersionPath, marker, insert); err != nil {
+	if err := editor.InsertCode(groupVersionPath, marker, insert); err != nil {
 		log.Warn("unable to add the '+kubebuilder:ac:generate=true' marker. "+acGenerateMarkerHint,
 			"path", groupVersionPath, "error", err)
 	}
@@ -288,6 +288,7 @@ func (s *apiScaffolder) hasSSAInPackage() bool {
 // does not generate ApplyConfigurations for them.
 // On failure, logs a warning and does not stop scaffolding.
 func (s *apiScaffolder) optOutExistingKinds(resources []resource.Resource) {
+	editor := util.NewFileEditor(s.fs)
 	for _, res := range resources {
 		if res.GVK == s.resource.GVK || res.Group != s.resource.Group || res.Version != s.resource.Version {
 			continue
@@ -298,7 +299,7 @@ func (s *apiScaffolder) optOutExistingKinds(resources []resource.Resource) {
 
 		typesPath := filepath.Join(s.apiPackageDir(), fmt.Sprintf("%s_types.go", strings.ToLower(res.Kind)))
 
-		hasMarker, err := util.HasFileContentWith(typesPath, "+kubebuilder:ac:generate")
+		hasMarker, err := editor.HasFileContentWith(typesPath, "+kubebuilder:ac:generate")
 		if err != nil {
 			log.Warn("unable to check the '+kubebuilder:ac:generate' marker. "+
 				"Add '+kubebuilder:ac:generate=false' above the kind to exclude it "+
@@ -310,7 +311,7 @@ func (s *apiScaffolder) optOutExistingKinds(resources []resource.Resource) {
 			continue
 		}
 
-		if err := util.InsertCode(typesPath, "// +kubebuilder:object:root=true",
+		if err := editor.InsertCode(typesPath, "// +kubebuilder:object:root=true",
 			"\n// +kubebuilder:ac:generate=false"); err != nil {
 			log.Warn("unable to add the '+kubebuilder:ac:generate=false' marker. "+
 				"Add it above the kind to exclude it from ApplyConfiguration generation",
@@ -333,7 +334,7 @@ func (s *apiScaffolder) warnUntrackedKinds(resources []resource.Resource) {
 	}
 
 	pkgDir := s.apiPackageDir()
-	entries, err := os.ReadDir(pkgDir)
+	entries, err := afero.ReadDir(s.fs.FS, pkgDir)
 	if err != nil {
 		return
 	}
@@ -353,7 +354,7 @@ func (s *apiScaffolder) warnUntrackedKinds(resources []resource.Resource) {
 // Only runs when the first SSA API is created.
 // On failure, logs a warning and does not stop scaffolding.
 func (s *apiScaffolder) updateMakefile() {
-	updated, err := addApplyConfigGenToMakefile("Makefile")
+	updated, err := addApplyConfigGenToMakefile(util.NewFileEditor(s.fs), "Makefile")
 	if err != nil {
 		log.Warn("unable to update Makefile 'manifests' target to add ApplyConfiguration generation for Server-Side Apply. "+
 			"Ensure your Makefile is updated to include 'applyconfiguration' in the controller-gen manifests command. "+
@@ -369,8 +370,8 @@ func (s *apiScaffolder) updateMakefile() {
 
 // addApplyConfigGenToMakefile adds applyconfiguration generation to the manifests target.
 // Returns false when the Makefile already runs applyconfiguration generation.
-func addApplyConfigGenToMakefile(makefilePath string) (bool, error) {
-	hasApplyConfig, err := util.HasFileContentWith(makefilePath, makefileApplyConfigurationMarker)
+func addApplyConfigGenToMakefile(editor util.FileEditor, makefilePath string) (bool, error) {
+	hasApplyConfig, err := editor.HasFileContentWith(makefilePath, makefileApplyConfigurationMarker)
 	if err != nil {
 		return false, fmt.Errorf("checking for applyconfiguration generation: %w", err)
 	}
@@ -386,7 +387,7 @@ func addApplyConfigGenToMakefile(makefilePath string) (bool, error) {
 		regexp.QuoteMeta(makefileManifestsHelpWithoutSSA) +
 		"\n(\t.*?)" + regexp.QuoteMeta(makefileManifestsGeneratorsWithoutSSA)
 	replacement := "${1}" + makefileManifestsHelpWithSSA + "\n${2}" + makefileManifestsGeneratorsWithSSA
-	if err := util.ReplaceRegexInFile(makefilePath, pattern, replacement); err != nil {
+	if err := editor.ReplaceRegexInFile(makefilePath, pattern, replacement); err != nil {
 		return false, fmt.Errorf("failed to update the manifests target in %q: %w", makefilePath, err)
 	}
 	return true, nil
@@ -33,9 +33,13 @@ import (
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
 )
 
+// osFs is used by the tests that edit the files of a temporary directory
+var osFs = machinery.Filesystem{FS: afero.NewOsFs()}
+
 func ssaTestResourceGV(kind, group, version string, ssa bool) resource.Resource {
 	return resource.Resource{
 		GVK: resource.GVK{
@@ -247,7 +251,7 @@ type Captain struct{}
 
 		optOut := func(captain, navigator resource.Resource) {
 			cfg := newSSATestConfig(captain, navigator)
-			s := &apiScaffolder{config: cfg, resource: navigator}
+			s := &apiScaffolder{config: cfg, resource: navigator, fs: osFs}
 			resources, err := cfg.GetResources()
 			Expect(err).NotTo(HaveOccurred())
 			s.optOutExistingKinds(resources)
@@ -307,7 +311,7 @@ type Captain struct{}
 			captain := ssaTestResource("Captain", false)
 			navigator := ssaTestResource("Navigator", true)
 			cfg := newSSATestConfig(sailor, captain, navigator)
-			s := &apiScaffolder{config: cfg, resource: navigator}
+			s := &apiScaffolder{config: cfg, resource: navigator, fs: osFs}
 			resources, err := cfg.GetResources()
 			Expect(err).NotTo(HaveOccurred())
 
@@ -347,7 +351,7 @@ package v1
 			gvPath := filepath.Join("api", "v1", "groupversion_info.go")
 			writeGroupVersionInfo(gvPath)
 			navigator := ssaTestResource("Navigator", true)
-			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator}
+			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator, fs: osFs}
 
 			s.updateGroupVersionInfo()
 
@@ -361,7 +365,7 @@ package v1
 			gvPath := filepath.Join("api", "v1", "groupversion_info.go")
 			writeGroupVersionInfo(gvPath)
 			navigator := ssaTestResource("Navigator", true)
-			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator}
+			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator, fs: osFs}
 
 			s.updateGroupVersionInfo()
 			s.updateGroupVersionInfo()
@@ -377,7 +381,7 @@ package v1
 			navigator := ssaTestResource("Navigator", true)
 			cfg := newSSATestConfig(navigator)
 			Expect(cfg.SetMultiGroup()).To(Succeed())
-			s := &apiScaffolder{config: cfg, resource: navigator}
+			s := &apiScaffolder{config: cfg, resource: navigator, fs: osFs}
 
 			s.updateGroupVersionInfo()
 
@@ -401,7 +405,7 @@ package v1
 			DeferCleanup(func() { slog.SetDefault(previous) })
 
 			navigator := ssaTestResource("Navigator", true)
-			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator}
+			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator, fs: osFs}
 
 			s.updateGroupVersionInfo()
 
@@ -423,7 +427,7 @@ package v1
 			DeferCleanup(func() { slog.SetDefault(previous) })
 
 			navigator := ssaTestResource("Navigator", true)
-			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator}
+			s := &apiScaffolder{config: newSSATestConfig(navigator), resource: navigator, fs: osFs}
 
 			s.updateGroupVersionInfo()
 
@@ -453,7 +457,7 @@ package v1
 			content := manifestsHelp + "\n\t" + manifestsLine + "\n"
 			Expect(os.WriteFile(makefilePath, []byte(content), 0o644)).To(Succeed())
 
-			updated, err := addApplyConfigGenToMakefile(makefilePath)
+			updated, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updated).To(BeTrue())
 
@@ -467,7 +471,7 @@ package v1
 			content := manifestsHelp + "\n\t$(CONTROLLER_GEN) rbac:roleName=manager-role crd webhook paths=\"./...\"\n"
 			Expect(os.WriteFile(makefilePath, []byte(content), 0o644)).To(Succeed())
 
-			updated, err := addApplyConfigGenToMakefile(makefilePath)
+			updated, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updated).To(BeTrue())
 
@@ -480,7 +484,7 @@ package v1
 			content := manifestsHelp + "\n\t" + `"$(CONTROLLER_GEN)" ` + makefileManifestsGeneratorsWithSSA + "\n"
 			Expect(os.WriteFile(makefilePath, []byte(content), 0o644)).To(Succeed())
 
-			updated, err := addApplyConfigGenToMakefile(makefilePath)
+			updated, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updated).To(BeFalse())
 		})
@@ -489,14 +493,14 @@ package v1
 			content := manifestsHelp + "\n\t" + manifestsLine + "\n"
 			Expect(os.WriteFile(makefilePath, []byte(content), 0o644)).To(Succeed())
 
-			updated, err := addApplyConfigGenToMakefile(makefilePath)
+			updated, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updated).To(BeTrue())
 
 			afterFirstRun, err := os.ReadFile(makefilePath)
 			Expect(err).NotTo(HaveOccurred())
 
-			updated, err = addApplyConfigGenToMakefile(makefilePath)
+			updated, err = addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updated).To(BeFalse())
 
@@ -512,7 +516,7 @@ package v1
 				manifestsHelp + "\n\t" + manifestsLine + "\n"
 			Expect(os.WriteFile(makefilePath, []byte(content), 0o644)).To(Succeed())
 
-			updated, err := addApplyConfigGenToMakefile(makefilePath)
+			updated, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updated).To(BeTrue())
 
@@ -526,7 +530,7 @@ package v1
 			content := "manifests: controller-gen ## My custom help\n\t" + manifestsLine + "\n"
 			Expect(os.WriteFile(makefilePath, []byte(content), 0o644)).To(Succeed())
 
-			_, err := addApplyConfigGenToMakefile(makefilePath)
+			_, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).To(HaveOccurred())
 
 			result, err := os.ReadFile(makefilePath)
@@ -538,7 +542,7 @@ package v1
 			content := manifestsHelp + "\n\tcustom-generator paths=\"./...\"\n"
 			Expect(os.WriteFile(makefilePath, []byte(content), 0o644)).To(Succeed())
 
-			_, err := addApplyConfigGenToMakefile(makefilePath)
+			_, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), makefilePath)
 			Expect(err).To(HaveOccurred())
 
 			result, err := os.ReadFile(makefilePath)
@@ -547,7 +551,7 @@ package v1
 		})
 
 		It("should return an error when the Makefile does not exist", func() {
-			_, err := addApplyConfigGenToMakefile(filepath.Join("does", "not", "exist", "Makefile"))
+			_, err := addApplyConfigGenToMakefile(util.NewFileEditor(osFs), filepath.Join("does", "not", "exist", "Makefile"))
 			Expect(err).To(HaveOccurred())
 		})
 	})
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
+)
+
+const deepCopyFileName = "zz_generated.deepcopy.go"
+
+var _ plugins.Scaffolder = &deleteAPIScaffolder{}
+
+// deleteAPIScaffolder contains configuration for removing the scaffolded Go type
+// and controllers of an API.
+type deleteAPIScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewDeleteAPIScaffolder returns a new Scaffolder for API/controller deletion operations
+func NewDeleteAPIScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &deleteAPIScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) Scaffold() error {
+	log.Info("Removing scaffold of the API...")
+
+	retained, err := retainedResources(s.config, s.resource.GVK)
+	if err != nil {
+		return err
+	}
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	doAPI := s.resource.HasAPI()
+	doController := s.resource.HasController()
+
+	if doController {
+		builders := []machinery.Builder{
+			&controllers.SuiteTest{RetainedResourcesMixin: machinery.RetainedResourcesMixin{RetainedResources: retained}},
+			&controllers.ControllerTest{},
+		}
+		for _, name := range s.resource.GetControllerNames() {
+			builders = append(builders,
+				&controllers.Controller{ControllerName: name},
+				&cmd.MainUpdater{
+					RetainedResourcesMixin: machinery.RetainedResourcesMixin{RetainedResources: retained},
+					WireController:         true,
+					ControllerName:         name,
+				},
+			)
+		}
+
+		if err = scaffold.Remove(builders...); err != nil {
+			return fmt.Errorf("error removing controller: %w", err)
+		}
+	}
+
+	if doAPI || s.resource.IsExternal() {
+		if err = scaffold.Remove(&cmd.MainUpdater{
+			RetainedResourcesMixin: machinery.RetainedResourcesMixin{RetainedResources: retained},
+			WireResource:           doAPI,
+		}); err != nil {
+			return fmt.Errorf("error updating cmd/main.go: %w", err)
+		}
+	}
+
+	if doAPI {
+		builders := []machinery.Builder{&api.Types{}}
+		// The group version package is only removed once it does not contain any other API
+		if !hasAPIInPackage(retained, s.resource) {
+			builders = append(builders, &api.Group{})
+			if err = removeDeepCopy(s.fs, s.config.IsMultiGroup(), s.resource); err != nil {
+				return err
+			}
+			if err = removeApplyConfigurations(s.fs, s.config.IsMultiGroup(), s.resource); err != nil {
+				return err
+			}
+		} else if s.resource.API != nil && s.resource.API.SSA {
+			log.Warn("Remove the apply configurations generated for the removed kind, "+
+				"they are regenerated for the remaining ones by make manifests", "kind", s.resource.Kind)
+		}
+
+		if err = scaffold.Remove(builders...); err != nil {
+			return fmt.Errorf("error removing API: %w", err)
+		}
+	}
+
+	if err = s.config.RemoveResource(s.resource.GVK); err != nil {
+		return fmt.Errorf("error removing resource: %w", err)
+	}
+
+	return nil
+}
+
+// apiPackageDir returns the directory of the group version package of the resource.
+func apiPackageDir(multiGroup bool, res resource.Resource) string {
+	if multiGroup && res.Group != "" {
+		return filepath.Join("api", res.Group, res.Version)
+	}
+	return filepath.Join("api", res.Version)
+}
+
+// removeDeepCopy removes the generated deepcopy file of the group version package of the resource.
+func removeDeepCopy(fs machinery.Filesystem, multiGroup bool, res resource.Resource) error {
+	path := filepath.Join(apiPackageDir(multiGroup, res), deepCopyFileName)
+	exists, err := afero.Exists(fs.FS, path)
+	if err != nil {
+		return fmt.Errorf("error checking %s: %w", path, err)
+	}
+	if !exists {
+		return nil
+	}
+	if err = fs.FS.Remove(path); err != nil {
+		return fmt.Errorf("error removing %s: %w", path, err)
+	}
+	return nil
+}
+
+// removeApplyConfigurations removes the apply configurations generated for the group version package
+// of the resource, if any.
+func removeApplyConfigurations(fs machinery.Filesystem, multiGroup bool, res resource.Resource) error {
+	dir := filepath.Join(apiPackageDir(multiGroup, res), applyConfigurationDir)
+	if err := fs.FS.RemoveAll(dir); err != nil {
+		return fmt.Errorf("error removing %s: %w", dir, err)
+	}
+	return nil
+}
+
+// retainedResources returns every resource of the project but the one with the provided GVK.
+func retainedResources(cfg config.Config, gvk resource.GVK) ([]resource.Resource, error) {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources: %w", err)
+	}
+
+	retained := make([]resource.Resource, 0, len(resources))
+	for _, res := range resources {
+		if !res.IsEqualTo(gvk) {
+			retained = append(retained, res)
+		}
+	}
+	return retained, nil
+}
+
+// hasAPIInPackage returns true if any of the resources has an API in the same group version package as res.
+func hasAPIInPackage(resources []resource.Resource, res resource.Resource) bool {
+	for _, r := range resources {
+		if r.HasAPI() && r.Group == res.Group && r.Version == res.Version {
+			return true
+		}
+	}
+	return false
+}
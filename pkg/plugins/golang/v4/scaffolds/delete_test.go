@@ -0,0 +1,173 @@
+//go:build !integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+const deleteTestMain = `package main
+
+import (
+	"os"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	// +kubebuilder:scaffold:imports
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	// +kubebuilder:scaffold:scheme
+}
+
+func main() {
+	// +kubebuilder:scaffold:builder
+	if err := mgr.AddHealthzCheck("healthz", nil); err != nil {
+		os.Exit(1)
+	}
+}
+`
+
+var _ = Describe("Delete scaffolding", func() {
+	var (
+		fs      machinery.Filesystem
+		cfg     config.Config
+		captain resource.Resource
+		sailor  resource.Resource
+	)
+
+	readFile := func(path string) string {
+		content, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	scaffoldAPI := func(res resource.Resource) {
+		res.Path = "sigs.k8s.io/kubebuilder/test/api/v1"
+		res.Controller = true
+		scaffolder := NewAPIScaffolder(cfg, res, false)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	}
+
+	storedResource := func(res resource.Resource) resource.Resource {
+		stored, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		return stored
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(fs.FS, "cmd/main.go", []byte(deleteTestMain), 0o644)).To(Succeed())
+
+		cfg = newSSATestConfig()
+		captain = ssaTestResource("Captain", false)
+		sailor = ssaTestResource("Sailor", false)
+		scaffoldAPI(captain)
+		scaffoldAPI(sailor)
+	})
+
+	It("should remove an API and keep the code shared with the other APIs", func() {
+		scaffolder := NewDeleteAPIScaffolder(cfg, storedResource(captain))
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		for _, path := range []string{
+			"api/v1/captain_types.go",
+			"internal/controller/captain_controller.go",
+			"internal/controller/captain_controller_test.go",
+		} {
+			Expect(afero.Exists(fs.FS, path)).To(BeFalse(), path)
+		}
+		Expect(afero.Exists(fs.FS, "api/v1/groupversion_info.go")).To(BeTrue())
+
+		main := readFile("cmd/main.go")
+		Expect(main).NotTo(ContainSubstring("CaptainReconciler"))
+		Expect(main).To(ContainSubstring("SailorReconciler"))
+		Expect(main).To(ContainSubstring(`crewv1 "sigs.k8s.io/kubebuilder/test/api/v1"`))
+		Expect(main).To(ContainSubstring("utilruntime.Must(crewv1.AddToScheme(scheme))"))
+
+		Expect(readFile("internal/controller/suite_test.go")).To(ContainSubstring("crewv1.AddToScheme"))
+		Expect(cfg.HasResource(captain.GVK)).To(BeFalse())
+		Expect(cfg.HasResource(sailor.GVK)).To(BeTrue())
+	})
+
+	It("should remove the group version package with its last API", func() {
+		Expect(afero.WriteFile(fs.FS, "api/v1/zz_generated.deepcopy.go", []byte("package v1\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs.FS, "api/v1/applyconfiguration/api/v1/captain.go",
+			[]byte("package v1\n"), 0o644)).To(Succeed())
+
+		for _, res := range []resource.Resource{captain, sailor} {
+			scaffolder := NewDeleteAPIScaffolder(cfg, storedResource(res))
+			scaffolder.InjectFS(fs)
+			Expect(scaffolder.Scaffold()).To(Succeed())
+		}
+
+		Expect(afero.Exists(fs.FS, "api/v1/groupversion_info.go")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "api/v1/zz_generated.deepcopy.go")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "api/v1/applyconfiguration")).To(BeFalse())
+
+		main := readFile("cmd/main.go")
+		Expect(main).NotTo(ContainSubstring("crewv1"))
+		Expect(main).To(ContainSubstring("// +kubebuilder:scaffold:builder"))
+		Expect(readFile("internal/controller/suite_test.go")).NotTo(ContainSubstring("crewv1"))
+	})
+
+	It("should remove the webhooks of a resource and keep it in the project configuration", func() {
+		Expect(afero.WriteFile(fs.FS, "test/e2e/e2e_test.go", []byte("package e2e\n"), 0o644)).To(Succeed())
+		for _, res := range []resource.Resource{captain, sailor} {
+			res = storedResource(res)
+			res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+			scaffolder := NewWebhookScaffolder(cfg, res, false)
+			scaffolder.InjectFS(fs)
+			Expect(scaffolder.Scaffold()).To(Succeed())
+		}
+
+		scaffolder := NewDeleteWebhookScaffolder(cfg, storedResource(captain))
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		Expect(afero.Exists(fs.FS, "internal/webhook/v1/captain_webhook.go")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "internal/webhook/v1/captain_webhook_test.go")).To(BeFalse())
+
+		main := readFile("cmd/main.go")
+		Expect(main).NotTo(ContainSubstring("SetupCaptainWebhookWithManager"))
+		Expect(main).To(ContainSubstring("SetupSailorWebhookWithManager"))
+		Expect(main).To(ContainSubstring(`webhookv1 "sigs.k8s.io/kubebuilder/test/internal/webhook/v1"`))
+
+		suite := readFile("internal/webhook/v1/webhook_suite_test.go")
+		Expect(suite).NotTo(ContainSubstring("SetupCaptainWebhookWithManager"))
+		Expect(suite).To(ContainSubstring("SetupSailorWebhookWithManager"))
+
+		resources, err := cfg.GetResources()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(HaveLen(2))
+		Expect(resources[0].Kind).To(Equal("Captain"))
+		Expect(resources[0].Webhooks).To(BeNil())
+		Expect(resources[0].HasAPI()).To(BeTrue())
+		Expect(resources[1].HasDefaultingWebhook()).To(BeTrue())
+	})
+})
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"slices"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/test/e2e"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/webhooks"
+)
+
+var _ plugins.Scaffolder = &deleteWebhookScaffolder{}
+
+// deleteWebhookScaffolder contains configuration for removing the scaffolded webhooks of a resource.
+type deleteWebhookScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewDeleteWebhookScaffolder returns a new Scaffolder for webhook deletion operations
+func NewDeleteWebhookScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &deleteWebhookScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) Scaffold() error {
+	log.Info("Removing scaffold of the webhooks...")
+
+	others, err := retainedResources(s.config, s.resource.GVK)
+	if err != nil {
+		return err
+	}
+	retained, retainedInPackage := retainedWebhookResources(others, s.resource)
+	retainedMixin := machinery.RetainedResourcesMixin{RetainedResources: retained}
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	builders := []machinery.Builder{
+		&webhooks.Webhook{},
+		&webhooks.WebhookTest{},
+		&webhooks.WebhookSuite{
+			RetainedResourcesMixin: machinery.RetainedResourcesMixin{RetainedResources: retainedInPackage},
+		},
+		&cmd.MainUpdater{RetainedResourcesMixin: retainedMixin, WireWebhook: true},
+		&e2e.WebhookTestUpdater{
+			RetainedResourcesMixin: retainedMixin,
+			WireWebhook:            s.resource.HasDefaultingWebhook() || s.resource.HasValidationWebhook(),
+		},
+	}
+
+	if s.resource.HasConversionWebhook() {
+		builders = append(builders, &api.Hub{})
+		for _, spoke := range s.resource.Webhooks.Spoke {
+			builders = append(builders, &api.Spoke{SpokeVersion: spoke})
+		}
+		log.Warn("The storage version marker was kept in the types file of the hub version",
+			"kind", s.resource.Kind, "version", s.resource.Version)
+	}
+
+	if err = scaffold.Remove(builders...); err != nil {
+		return fmt.Errorf("error removing webhooks: %w", err)
+	}
+
+	if len(retained) == 0 {
+		log.Warn("No webhooks remain in the project: review the webhook sections of " +
+			"config/default/kustomization.yaml and the webhook readiness checks of test/e2e/e2e_test.go")
+	}
+
+	return s.updateResource()
+}
+
+// updateResource drops the webhooks of the resource from the project configuration,
+// removing the resource once nothing else was scaffolded for it.
+func (s *deleteWebhookScaffolder) updateResource() error {
+	res, err := s.config.GetResource(s.resource.GVK)
+	if err != nil {
+		return fmt.Errorf("error getting resource: %w", err)
+	}
+
+	res.Webhooks = nil
+	if !res.HasAPI() && !res.HasController() {
+		return replaceResource(s.config, s.resource.GVK, nil)
+	}
+	return replaceResource(s.config, s.resource.GVK, &res)
+}
+
+// retainedWebhookResources returns the resources that still have webhooks, which share code fragments
+// with the webhooks of res, and the ones among them in the same group version package as res, which share
+// the webhook test suite.
+func retainedWebhookResources(others []resource.Resource, res resource.Resource) (
+	retained, retainedInPackage []resource.Resource,
+) {
+	for _, other := range others {
+		if other.Webhooks != nil && !other.Webhooks.IsEmpty() {
+			retained = append(retained, other)
+			if other.Group == res.Group && other.Version == res.Version {
+				retainedInPackage = append(retainedInPackage, other)
+			}
+		}
+	}
+	return retained, retainedInPackage
+}
+
+// replaceResource replaces the resource with the provided GVK in the project configuration, or drops it
+// if res is nil. Resources are updated by merging them, which cannot drop fields, so the resource and
+// the ones that follow it are removed and added back to keep their order in the PROJECT file.
+func replaceResource(cfg config.Config, gvk resource.GVK, res *resource.Resource) error {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	index := slices.IndexFunc(resources, func(r resource.Resource) bool { return r.IsEqualTo(gvk) })
+	if index < 0 {
+		return config.ResourceNotFoundError{GVK: gvk}
+	}
+	for _, r := range resources[index:] {
+		if err = cfg.RemoveResource(r.GVK); err != nil {
+			return fmt.Errorf("error removing resource: %w", err)
+		}
+	}
+
+	if res == nil {
+		index++
+	} else {
+		resources[index] = *res
+	}
+	for _, r := range resources[index:] {
+		if err = cfg.AddResource(r); err != nil {
+			return fmt.Errorf("error adding resource: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+)
+
+// mainFilePath is the file where the controllers and webhooks of the project are registered.
+const mainFilePath = "cmd/main.go"
+
+// Checks reported by Diagnose.
+const (
+	checkAPITypes               = "api-types"
+	checkMainFile               = "main-file"
+	checkControllerRegistration = "controller-registration"
+	checkWebhookRegistration    = "webhook-registration"
+)
+
+// Diagnose checks that the Go types of the APIs tracked in the project configuration exist and that
+// their controllers and webhooks are registered in cmd/main.go. Registrations are fixed with the same
+// updater used by "create api" and "create webhook", which only inserts the code that is missing.
+func Diagnose(cfg config.Config, fs machinery.Filesystem) ([]plugin.Diagnostic, error) {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources: %w", err)
+	}
+
+	content, err := afero.ReadFile(fs.FS, mainFilePath)
+	hasMain := err == nil
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error reading %s: %w", mainFilePath, err)
+	}
+
+	var diagnostics []plugin.Diagnostic
+	missingMain := false
+	for _, res := range resources {
+		typesDiagnostic, typesErr := diagnoseTypes(cfg, fs, res)
+		if typesErr != nil {
+			return nil, typesErr
+		}
+		if typesDiagnostic != nil {
+			diagnostics = append(diagnostics, *typesDiagnostic)
+		}
+
+		registrations := mainRegistrations(cfg.IsMultiGroup(), res)
+		if len(registrations) == 0 {
+			continue
+		}
+		if !hasMain {
+			missingMain = true
+			continue
+		}
+		for _, registration := range registrations {
+			if !strings.Contains(string(content), registration.code) {
+				diagnostics = append(diagnostics, registration.diagnostic(cfg, res))
+			}
+		}
+	}
+
+	if missingMain {
+		diagnostics = append(diagnostics, plugin.Diagnostic{
+			Check:   checkMainFile,
+			Message: fmt.Sprintf("%s was not found, so the controllers and webhooks are not registered", mainFilePath),
+			Hint:    fmt.Sprintf("restore %s from version control", mainFilePath),
+		})
+	}
+
+	return diagnostics, nil
+}
+
+// diagnoseTypes checks that the file defining the Go types of an API that lives in the project exists.
+func diagnoseTypes(cfg config.Config, fs machinery.Filesystem, res resource.Resource) (*plugin.Diagnostic, error) {
+	if !res.HasAPI() ||
+		res.Path != resource.APIPackagePath(cfg.GetRepository(), res.Group, res.Version, cfg.IsMultiGroup()) {
+		return nil, nil
+	}
+
+	path := filepath.Join("api", "%[version]", "%[kind]_types.go")
+	if cfg.IsMultiGroup() && res.Group != "" {
+		path = filepath.Join("api", "%[group]", "%[version]", "%[kind]_types.go")
+	}
+	path = res.Replacer().Replace(path)
+
+	exists, err := afero.Exists(fs.FS, path)
+	if err != nil {
+		return nil, fmt.Errorf("error checking %s: %w", path, err)
+	}
+	if exists {
+		return nil, nil
+	}
+
+	return &plugin.Diagnostic{
+		Check:   checkAPITypes,
+		Message: fmt.Sprintf("the types of %s are not defined in %s", describeResource(res), path),
+		Hint: fmt.Sprintf("restore %s from version control, or remove the API with \"delete api\" "+
+			"if it is no longer used", path),
+	}, nil
+}
+
+// mainRegistration is the code that registers a controller or a webhook of a resource in cmd/main.go.
+type mainRegistration struct {
+	// check identifies the diagnostic reported when the registration is missing.
+	check string
+	// what describes what is registered.
+	what string
+	// code is the code that is expected to be found in cmd/main.go.
+	code string
+	// updater inserts the registration in cmd/main.go.
+	updater cmd.MainUpdater
+}
+
+// mainRegistrations returns the registrations in cmd/main.go expected for a resource.
+func mainRegistrations(multiGroup bool, res resource.Resource) []mainRegistration {
+	var registrations []mainRegistration
+	for _, name := range res.GetControllerNames() {
+		reconciler := resource.NormalizeReconcilerName(name, res.Kind)
+		registrations = append(registrations, mainRegistration{
+			check:   checkControllerRegistration,
+			what:    fmt.Sprintf("the reconciler %s", reconciler),
+			code:    fmt.Sprintf("controller.%s{", reconciler),
+			updater: cmd.MainUpdater{WireController: true, ControllerName: name},
+		})
+	}
+
+	if res.Webhooks != nil && !res.Webhooks.IsEmpty() {
+		alias := "webhook" + res.Version
+		if multiGroup && res.Group != "" {
+			alias = "webhook" + res.ImportAlias()
+		}
+		registrations = append(registrations, mainRegistration{
+			check:   checkWebhookRegistration,
+			what:    "the webhook setup",
+			code:    fmt.Sprintf("%s.Setup%sWebhookWithManager(mgr)", alias, res.Kind),
+			updater: cmd.MainUpdater{WireWebhook: true},
+		})
+	}
+
+	return registrations
+}
+
+// diagnostic returns the problem reported when the registration is missing, which is fixed by inserting it.
+func (r mainRegistration) diagnostic(cfg config.Config, res resource.Resource) plugin.Diagnostic {
+	return plugin.Diagnostic{
+		Check:   r.check,
+		Message: fmt.Sprintf("%s of %s is not registered in %s", r.what, describeResource(res), mainFilePath),
+		Hint:    fmt.Sprintf("insert the registration before the health checks in the main function of %s", mainFilePath),
+		Fix: func(fs machinery.Filesystem) error {
+			updater := r.updater
+			scaffold := machinery.NewScaffold(fs,
+				machinery.WithConfig(cfg),
+				machinery.WithResource(&res),
+			)
+			if err := scaffold.Execute(&updater); err != nil {
+				return fmt.Errorf("error updating %s: %w", mainFilePath, err)
+			}
+			return nil
+		},
+	}
+}
+
+// describeResource returns a description of a resource for the diagnostics.
+func describeResource(res resource.Resource) string {
+	return fmt.Sprintf("%s/%s, Kind %s", res.QualifiedGroup(), res.Version, res.Kind)
+}
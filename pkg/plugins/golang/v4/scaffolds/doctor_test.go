@@ -0,0 +1,139 @@
+//go:build !integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+var _ = Describe("Diagnose", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+	)
+
+	readFile := func(path string) string {
+		content, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	// removeBlock removes the block of cmd/main.go that starts with the line containing start.
+	removeBlock := func(start string, lines int) {
+		mainLines := strings.Split(readFile("cmd/main.go"), "\n")
+		for i, line := range mainLines {
+			if strings.Contains(line, start) {
+				mainLines = append(mainLines[:i], mainLines[i+lines:]...)
+				break
+			}
+		}
+		Expect(afero.WriteFile(fs.FS, "cmd/main.go", []byte(strings.Join(mainLines, "\n")), 0o644)).To(Succeed())
+	}
+
+	diagnose := func() []plugin.Diagnostic {
+		diagnostics, err := Diagnose(cfg, fs)
+		Expect(err).NotTo(HaveOccurred())
+		return diagnostics
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(fs.FS, "cmd/main.go", []byte(deleteTestMain), 0o644)).To(Succeed())
+
+		cfg = newSSATestConfig()
+		for _, kind := range []string{"Captain", "Sailor"} {
+			res := ssaTestResource(kind, false)
+			res.Path = "sigs.k8s.io/kubebuilder/test/api/v1"
+			res.Controller = true
+			scaffolder := NewAPIScaffolder(cfg, res, false)
+			scaffolder.InjectFS(fs)
+			Expect(scaffolder.Scaffold()).To(Succeed())
+		}
+	})
+
+	It("should not report problems for a project in sync with its configuration", func() {
+		Expect(diagnose()).To(BeEmpty())
+	})
+
+	It("should report the missing types files, which cannot be fixed", func() {
+		Expect(fs.FS.Remove("api/v1/captain_types.go")).To(Succeed())
+
+		diagnostics := diagnose()
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Check).To(Equal(checkAPITypes))
+		Expect(diagnostics[0].Message).To(ContainSubstring("api/v1/captain_types.go"))
+		Expect(diagnostics[0].Fix).To(BeNil())
+	})
+
+	It("should report and fix the reconcilers that are not registered", func() {
+		removeBlock("(&controller.SailorReconciler{", 7)
+		Expect(readFile("cmd/main.go")).NotTo(ContainSubstring("SailorReconciler"))
+
+		diagnostics := diagnose()
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Check).To(Equal(checkControllerRegistration))
+		Expect(diagnostics[0].Message).To(ContainSubstring("SailorReconciler"))
+		Expect(diagnostics[0].Fix).NotTo(BeNil())
+
+		Expect(diagnostics[0].Fix(fs)).To(Succeed())
+		Expect(readFile("cmd/main.go")).To(ContainSubstring("(&controller.SailorReconciler{"))
+		Expect(strings.Count(readFile("cmd/main.go"), "(&controller.CaptainReconciler{")).To(Equal(1))
+		Expect(diagnose()).To(BeEmpty())
+	})
+
+	It("should report and fix the webhooks that are not registered", func() {
+		Expect(afero.WriteFile(fs.FS, "test/e2e/e2e_test.go", []byte("package e2e\n"), 0o644)).To(Succeed())
+		captain, err := cfg.GetResource(ssaTestResource("Captain", false).GVK)
+		Expect(err).NotTo(HaveOccurred())
+		captain.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+		scaffolder := NewWebhookScaffolder(cfg, captain, false)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+		Expect(diagnose()).To(BeEmpty())
+
+		removeBlock("// nolint:goconst", 7)
+		Expect(readFile("cmd/main.go")).NotTo(ContainSubstring("SetupCaptainWebhookWithManager"))
+
+		diagnostics := diagnose()
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Check).To(Equal(checkWebhookRegistration))
+
+		Expect(diagnostics[0].Fix(fs)).To(Succeed())
+		Expect(readFile("cmd/main.go")).To(ContainSubstring("webhookv1.SetupCaptainWebhookWithManager(mgr)"))
+		Expect(diagnose()).To(BeEmpty())
+	})
+
+	It("should report a missing cmd/main.go once", func() {
+		Expect(fs.FS.Remove("cmd/main.go")).To(Succeed())
+
+		diagnostics := diagnose()
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Check).To(Equal(checkMainFile))
+		Expect(diagnostics[0].Fix).To(BeNil())
+	})
+})
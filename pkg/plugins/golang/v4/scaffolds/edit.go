@@ -25,6 +25,7 @@ import (
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
 	kustomizecommonv2 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
@@ -32,6 +33,11 @@ import (
 
 var _ plugins.Scaffolder = &editScaffolder{}
 
+// namespaceScopedGuideStep points to the guide about switching between cluster and namespace scope
+var namespaceScopedGuideStep = util.NextStep{
+	Description: "see https://book.kubebuilder.io/migration/namespace-scoped.html",
+}
+
 type editScaffolder struct {
 	config      config.Config
 	multigroup  bool
@@ -85,6 +91,19 @@ func (s *editScaffolder) Scaffold() error {
 	// Track if we're toggling namespaced mode
 	wasNamespaced := s.config.IsNamespaced()
 
+	// Move the existing code before updating the layout, since the previous one is needed to find it
+	var nextSteps []util.NextStep
+	if s.multigroup != s.config.IsMultiGroup() {
+		if layoutErr := s.moveToLayout(); layoutErr != nil {
+			return fmt.Errorf("failed to move the project to the new layout: %w", layoutErr)
+		}
+
+		nextSteps = append(nextSteps, util.NextStep{
+			Description: "regenerate the code and manifests that depend on the layout",
+			Command:     "make manifests generate",
+		})
+	}
+
 	// Update config flags
 	if s.multigroup {
 		_ = s.config.SetMultiGroup()
@@ -106,8 +125,9 @@ func (s *editScaffolder) Scaffold() error {
 		}
 
 		if !s.force {
-			fmt.Println()
-			fmt.Println("Run with --force to update config/manager/manager.yaml with WATCH_NAMESPACE")
+			nextSteps = append(nextSteps, util.NextStep{
+				Description: "run again with --force to update config/manager/manager.yaml with WATCH_NAMESPACE",
+			})
 		}
 
 		// Check if project has webhooks and warn about scope mismatch
@@ -116,21 +136,18 @@ func (s *editScaffolder) Scaffold() error {
 				"You will need to manually configure namespaceSelector or objectSelector")
 		}
 
-		// Print next steps
-		fmt.Println()
-		fmt.Println("Next steps:")
-		fmt.Println("1. Update cmd/main.go to configure namespace-scoped cache")
-		fmt.Println("2. Add namespace= to RBAC markers in existing controllers:")
-		fmt.Printf("   // +kubebuilder:rbac:groups=mygroup,resources=myresources,verbs=get;list,"+
-			"namespace=%s-system\n", s.config.GetProjectName())
-		fmt.Println("3. Run: make manifests")
+		// Scope the RBAC markers and the cache of the manager to the watched namespaces
+		if codeErr := s.scaffoldNamespacedCode(); codeErr != nil {
+			return fmt.Errorf("failed to update the code for namespace-scoped mode: %w", codeErr)
+		}
 
+		nextSteps = append(nextSteps, util.NextStep{Description: "regenerate the manifests", Command: "make manifests"})
 		if s.hasWebhooks() {
-			fmt.Println("4. Configure namespaceSelector or objectSelector for webhooks")
+			nextSteps = append(nextSteps, util.NextStep{
+				Description: "configure namespaceSelector or objectSelector for webhooks",
+			})
 		}
-
-		fmt.Println()
-		fmt.Println("See: https://book.kubebuilder.io/migration/namespace-scoped.html")
+		nextSteps = append(nextSteps, namespaceScopedGuideStep)
 	} else if !s.namespaced && wasNamespaced {
 		// Switching to cluster-scoped layout: scaffold ClusterRole/ClusterRoleBinding
 		if rbacErr := s.scaffoldClusterRBAC(s.force); rbacErr != nil {
@@ -138,21 +155,22 @@ func (s *editScaffolder) Scaffold() error {
 		}
 
 		if !s.force {
-			fmt.Println()
-			fmt.Println("Run with --force to update config/manager/manager.yaml (remove WATCH_NAMESPACE)")
+			nextSteps = append(nextSteps, util.NextStep{
+				Description: "run again with --force to update config/manager/manager.yaml (remove WATCH_NAMESPACE)",
+			})
+		}
+
+		// Remove the namespace from the RBAC markers and the cache of the manager
+		if codeErr := s.scaffoldNamespacedCode(); codeErr != nil {
+			return fmt.Errorf("failed to update the code for cluster-scoped mode: %w", codeErr)
 		}
 
-		// Print next steps
-		fmt.Println()
-		fmt.Println("Next steps:")
-		fmt.Println("1. Update cmd/main.go:")
-		fmt.Println("   - Remove getWatchNamespace() and setupCacheNamespaces() functions")
-		fmt.Println("   - Remove watchNamespace retrieval and cache configuration")
-		fmt.Println("2. Remove namespace= from RBAC markers in existing controllers")
-		fmt.Println("3. Run: make manifests")
-		fmt.Println()
-		fmt.Println("See: https://book.kubebuilder.io/migration/namespace-scoped.html")
+		nextSteps = append(nextSteps,
+			util.NextStep{Description: "regenerate the manifests", Command: "make manifests"},
+			namespaceScopedGuideStep,
+		)
 	}
+	util.PrintNextSteps(nextSteps...)
 
 	// Check if the str is not empty, because when the file is already in desired format it will return empty string
 	// because there is nothing to replace.
@@ -186,6 +204,31 @@ func (s *editScaffolder) scaffoldClusterRBAC(force bool) error {
 	return nil
 }
 
+// scaffoldNamespacedCode updates the RBAC markers of the controllers and cmd/main.go for the namespaced flag.
+func (s *editScaffolder) scaffoldNamespacedCode() error {
+	scaffolder := newNamespacedScaffolder(s.config, s.namespaced)
+	scaffolder.InjectFS(s.fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("error updating the code: %w", err)
+	}
+	return nil
+}
+
+// moveToLayout moves the kustomize manifests and the Go packages of the project to the multi-group
+// or single-group layout.
+func (s *editScaffolder) moveToLayout() error {
+	for _, scaffolder := range []plugins.Scaffolder{
+		kustomizecommonv2.NewEditMultiGroupScaffolder(s.config, s.multigroup),
+		newMultiGroupScaffolder(s.config, s.multigroup),
+	} {
+		scaffolder.InjectFS(s.fs)
+		if err := scaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("error moving the project: %w", err)
+		}
+	}
+	return nil
+}
+
 // hasWebhooks checks if any resources in the project have webhooks configured
 func (s *editScaffolder) hasWebhooks() bool {
 	resources, err := s.config.GetResources()
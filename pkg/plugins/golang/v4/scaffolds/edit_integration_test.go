@@ -174,6 +174,17 @@ var _ = Describe("Edit Scaffolding Integration Test", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(string(content)).NotTo(ContainSubstring("WATCH_NAMESPACE"))
 
+		By("verifying the namespace was removed from the RBAC markers and cmd/main.go")
+		content, err = os.ReadFile(controllerFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).NotTo(ContainSubstring("namespace=" + expectedNamespace))
+
+		mainFile := filepath.Join(kbc.Dir, "cmd", "main.go")
+		content, err = os.ReadFile(mainFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).NotTo(ContainSubstring("getWatchNamespace"))
+		Expect(string(content)).NotTo(ContainSubstring("setupCacheNamespaces"))
+
 		By("verifying CRD roles were updated to cluster-scoped")
 		content, err = os.ReadFile(adminRoleFile)
 		Expect(err).NotTo(HaveOccurred())
@@ -213,5 +224,14 @@ var _ = Describe("Edit Scaffolding Integration Test", func() {
 		content, err = os.ReadFile(managerFile)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(string(content)).To(ContainSubstring("WATCH_NAMESPACE"))
+
+		By("verifying the namespace was added back to the RBAC markers and cmd/main.go")
+		content, err = os.ReadFile(controllerFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("namespace=" + expectedNamespace))
+
+		content, err = os.ReadFile(mainFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("mgrOptions.Cache = setupCacheNamespaces(watchNamespace)"))
 	})
 })
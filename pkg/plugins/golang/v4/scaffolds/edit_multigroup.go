@@ -0,0 +1,810 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	log "log/slog"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/webhooks"
+)
+
+// applyConfigurationDir is the directory where controller-gen generates the apply configurations of an API
+// package. Its content depends on the layout, so it is removed and regenerated by make manifests, which is
+// run by the edit subcommand once the APIs are moved.
+const applyConfigurationDir = "applyconfiguration"
+
+// relativePathRegexp matches the paths relative to the project root used by the test suites,
+// such as filepath.Join("..", "..", "config", "crd", "bases").
+var relativePathRegexp = regexp.MustCompile(`filepath\.Join\(((?:"\.\.",\s*)+)`)
+
+var _ plugins.Scaffolder = &multiGroupScaffolder{}
+
+// multiGroupScaffolder moves the Go packages scaffolded for the resources of a project between the
+// single-group and the multi-group layouts, and rewrites the imports that refer to them, so that the
+// project is the same as if it had been scaffolded with the new layout.
+type multiGroupScaffolder struct {
+	config     config.Config
+	multiGroup bool
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// newMultiGroupScaffolder returns a new Scaffolder that moves the project to the provided layout
+func newMultiGroupScaffolder(cfg config.Config, multiGroup bool) plugins.Scaffolder {
+	return &multiGroupScaffolder{
+		config:     cfg,
+		multiGroup: multiGroup,
+	}
+}
+
+// goPackage is a Go package scaffolded for the resources of a project.
+type goPackage struct {
+	// dir is the directory of the package, relative to the project root
+	dir string
+	// importPath is the path used to import the package
+	importPath string
+	// name is the name of the package clause
+	name string
+	// alias is the name under which the scaffolded code imports the package
+	alias string
+}
+
+// packageMove moves a Go package, or the files scaffolded for some of its resources, to another directory.
+type packageMove struct {
+	from goPackage
+	to   goPackage
+
+	// resources are the resources whose files are moved
+	resources []resource.Resource
+	// files maps the paths of the files scaffolded for the resources to their new paths
+	files map[string]string
+	// whole is true when every file of the package is moved, along with its subdirectories if recursive is set
+	whole     bool
+	recursive bool
+	// vacated is true when no resource is left in the previous package
+	vacated bool
+
+	// suite returns the template of the test suite of the package, which is scaffolded again when the
+	// package is not moved as a whole. It is nil for packages without test suite.
+	suite func() machinery.Template
+
+	// names are the top-level names declared in the moved files
+	names map[string]bool
+}
+
+// packageLayout returns the package of a resource in the provided layout, together with the templates
+// of the files scaffolded for it in that package. It returns false if the resource has no such package.
+type packageLayout func(res resource.Resource, multiGroup bool) (goPackage, []machinery.Template, bool)
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *multiGroupScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *multiGroupScaffolder) Scaffold() error {
+	wasMultiGroup := s.config.IsMultiGroup()
+	if wasMultiGroup == s.multiGroup {
+		return nil
+	}
+
+	log.Info("Moving the APIs, controllers and webhooks to the new layout...")
+
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	moves, err := s.apiMoves(resources, wasMultiGroup)
+	if err != nil {
+		return err
+	}
+	for _, layout := range []struct {
+		packageLayout
+		suite func() machinery.Template
+	}{
+		{s.controllerPackage, func() machinery.Template { return &controllers.SuiteTest{} }},
+		{s.webhookPackage, func() machinery.Template { return &webhooks.WebhookSuite{} }},
+	} {
+		resourceMoves, moveErr := s.resourceMoves(resources, wasMultiGroup, layout.packageLayout, layout.suite)
+		if moveErr != nil {
+			return moveErr
+		}
+		moves = append(moves, resourceMoves...)
+	}
+
+	cleaned := map[string]bool{}
+	for _, move := range moves {
+		if err = s.move(move, wasMultiGroup); err != nil {
+			return err
+		}
+	}
+	for _, move := range moves {
+		if move.whole || cleaned[move.from.dir] {
+			continue
+		}
+		cleaned[move.from.dir] = true
+		if err = s.cleanPackage(move); err != nil {
+			return err
+		}
+	}
+
+	// The layout is updated before scaffolding the test suites, so that they use the new one
+	if s.multiGroup {
+		err = s.config.SetMultiGroup()
+	} else {
+		err = s.config.ClearMultiGroup()
+	}
+	if err != nil {
+		return fmt.Errorf("error updating the layout: %w", err)
+	}
+
+	if err = s.scaffoldSuites(moves); err != nil {
+		return err
+	}
+
+	if err = s.updateImports(moves); err != nil {
+		return err
+	}
+
+	return s.updateResourcePaths(resources, wasMultiGroup)
+}
+
+// apiMoves returns the moves of the API packages that were scaffolded in the project.
+func (s *multiGroupScaffolder) apiMoves(resources []resource.Resource, wasMultiGroup bool) ([]*packageMove, error) {
+	var moves []*packageMove
+	for _, res := range resources {
+		if !res.HasAPI() || res.IsExternal() ||
+			res.Path != resource.APIPackagePath(s.config.GetRepository(), res.Group, res.Version, wasMultiGroup) {
+			continue
+		}
+
+		from, err := s.apiPackage(res, wasMultiGroup)
+		if err != nil {
+			return nil, err
+		}
+		to, err := s.apiPackage(res, s.multiGroup)
+		if err != nil {
+			return nil, err
+		}
+		if from.dir == to.dir || slices.ContainsFunc(moves, func(move *packageMove) bool {
+			return move.from.dir == from.dir
+		}) {
+			continue
+		}
+
+		moves = append(moves, &packageMove{
+			from:      from,
+			to:        to,
+			resources: []resource.Resource{res},
+			whole:     true,
+			recursive: true,
+			vacated:   true,
+			names:     map[string]bool{},
+		})
+	}
+	return moves, nil
+}
+
+// resourceMoves returns the moves of the packages of the provided layout. A package is moved as a whole
+// when all its resources are moved to the same package and no other package is moved there. Otherwise,
+// only the files scaffolded for the resources are moved, and the test suites are scaffolded again.
+func (s *multiGroupScaffolder) resourceMoves(
+	resources []resource.Resource,
+	wasMultiGroup bool,
+	layout packageLayout,
+	suite func() machinery.Template,
+) ([]*packageMove, error) {
+	var moves []*packageMove
+	kept := map[string]bool{}
+	for _, res := range resources {
+		from, fromTemplates, ok := layout(res, wasMultiGroup)
+		if !ok {
+			continue
+		}
+		to, toTemplates, _ := layout(res, s.multiGroup)
+		if from.dir == to.dir {
+			kept[from.dir] = true
+			continue
+		}
+
+		fromPaths, err := templatePaths(res, wasMultiGroup, fromTemplates...)
+		if err != nil {
+			return nil, err
+		}
+		toPaths, err := templatePaths(res, s.multiGroup, toTemplates...)
+		if err != nil {
+			return nil, err
+		}
+
+		index := slices.IndexFunc(moves, func(move *packageMove) bool {
+			return move.from.dir == from.dir && move.to.dir == to.dir
+		})
+		if index == -1 {
+			index = len(moves)
+			moves = append(moves, &packageMove{
+				from:  from,
+				to:    to,
+				files: map[string]string{},
+				suite: suite,
+				names: map[string]bool{},
+			})
+		}
+		move := moves[index]
+		move.resources = append(move.resources, res)
+		for i, fromPath := range fromPaths {
+			move.files[fromPath] = toPaths[i]
+		}
+	}
+
+	for _, move := range moves {
+		move.vacated = !kept[move.from.dir]
+		move.whole = move.vacated &&
+			!slices.ContainsFunc(moves, func(other *packageMove) bool {
+				return other != move && (other.from.dir == move.from.dir || other.to.dir == move.to.dir)
+			})
+	}
+	return moves, nil
+}
+
+// apiPackage returns the API package of the resource in the provided layout.
+func (s *multiGroupScaffolder) apiPackage(res resource.Resource, multiGroup bool) (goPackage, error) {
+	paths, err := templatePaths(res, multiGroup, &api.Group{})
+	if err != nil {
+		return goPackage{}, err
+	}
+	return s.goPackage(filepath.Dir(paths[0]), res.Version, res.ImportAlias()), nil
+}
+
+// controllerPackage implements packageLayout for the controllers.
+func (s *multiGroupScaffolder) controllerPackage(
+	res resource.Resource,
+	multiGroup bool,
+) (goPackage, []machinery.Template, bool) {
+	if !res.HasController() {
+		return goPackage{}, nil, false
+	}
+
+	// The test suite is the only file of the package that does not depend on the controller names
+	suite := &controllers.SuiteTest{}
+	paths, err := templatePaths(res, multiGroup, suite)
+	if err != nil {
+		return goPackage{}, nil, false
+	}
+
+	pkg := s.goPackage(filepath.Dir(paths[0]), "controller", "")
+	if multiGroup && res.Group != "" {
+		pkg = s.goPackage(filepath.Dir(paths[0]), res.PackageName(), res.PackageName()+"controller")
+	}
+
+	templates := make([]machinery.Template, 0, len(res.GetControllerNames())+1)
+	for _, name := range res.GetControllerNames() {
+		templates = append(templates, &controllers.Controller{ControllerName: name})
+	}
+	return pkg, append(templates, &controllers.ControllerTest{}), true
+}
+
+// webhookPackage implements packageLayout for the webhooks.
+func (s *multiGroupScaffolder) webhookPackage(
+	res resource.Resource,
+	multiGroup bool,
+) (goPackage, []machinery.Template, bool) {
+	if res.Webhooks == nil || res.Webhooks.IsEmpty() {
+		return goPackage{}, nil, false
+	}
+
+	suite := &webhooks.WebhookSuite{}
+	paths, err := templatePaths(res, multiGroup, suite)
+	if err != nil {
+		return goPackage{}, nil, false
+	}
+
+	pkg := s.goPackage(filepath.Dir(paths[0]), res.Version, "webhook"+res.Version)
+	if multiGroup && res.Group != "" {
+		pkg.alias = "webhook" + res.ImportAlias()
+	}
+
+	return pkg, []machinery.Template{&webhooks.Webhook{}, &webhooks.WebhookTest{}}, true
+}
+
+// goPackage returns the package of the project at the provided directory.
+func (s *multiGroupScaffolder) goPackage(dir, name, alias string) goPackage {
+	dir = filepath.ToSlash(dir)
+	return goPackage{
+		dir:        dir,
+		importPath: path.Join(s.config.GetRepository(), dir),
+		name:       name,
+		alias:      alias,
+	}
+}
+
+// move moves the files of the package, rewriting their package clause, the paths relative to the project
+// root and the names of the controllers, which depend on the layout.
+func (s *multiGroupScaffolder) move(move *packageMove, wasMultiGroup bool) error {
+	files := move.files
+	if move.whole {
+		var err error
+		if files, err = s.packageFiles(move); err != nil {
+			return err
+		}
+	}
+
+	fromPaths := slices.Sorted(maps.Keys(files))
+	for _, fromPath := range fromPaths {
+		exists, err := afero.Exists(s.fs.FS, files[fromPath])
+		if err != nil {
+			return fmt.Errorf("error checking %s: %w", files[fromPath], err)
+		}
+		if exists {
+			return fmt.Errorf("unable to move %s: file %s already exists", fromPath, files[fromPath])
+		}
+	}
+
+	// The names of the controllers are prefixed with the group in the multi-group layout
+	controllerNames := make(map[string]string)
+	for _, res := range move.resources {
+		for _, name := range res.GetControllerNames() {
+			controllerNames[fmt.Sprintf("Named(%q)", resource.GetControllerName(name, res.Kind, res.Group, wasMultiGroup))] =
+				fmt.Sprintf("Named(%q)", resource.GetControllerName(name, res.Kind, res.Group, s.multiGroup))
+		}
+	}
+	depth := strings.Count(move.to.dir, "/") - strings.Count(move.from.dir, "/")
+
+	for _, fromPath := range fromPaths {
+		toPath := files[fromPath]
+
+		content, err := afero.ReadFile(s.fs.FS, fromPath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				log.Warn("skipping missing file", "file", fromPath)
+				continue
+			}
+			return fmt.Errorf("error reading %s: %w", fromPath, err)
+		}
+
+		var rewrite func([]byte) ([]byte, error)
+		if filepath.Ext(fromPath) == ".go" {
+			inPackage := filepath.ToSlash(filepath.Dir(fromPath)) == move.from.dir
+			rewrite = func(content []byte) ([]byte, error) {
+				content, rewriteErr := move.rewrite(content, inPackage)
+				if rewriteErr != nil {
+					return nil, rewriteErr
+				}
+				content = []byte(relativePathRegexp.ReplaceAllStringFunc(string(content), func(match string) string {
+					return "filepath.Join(" + strings.Repeat(`"..", `, strings.Count(match, `".."`)+depth)
+				}))
+				for from, to := range controllerNames {
+					content = bytes.ReplaceAll(content, []byte(from), []byte(to))
+				}
+				return content, nil
+			}
+			if content, err = rewrite(content); err != nil {
+				return fmt.Errorf("error rewriting %s: %w", fromPath, err)
+			}
+		}
+
+		if err = s.fs.FS.MkdirAll(filepath.Dir(toPath), 0o755); err != nil {
+			return fmt.Errorf("error creating directory for %s: %w", toPath, err)
+		}
+		if err = afero.WriteFile(s.fs.FS, toPath, content, 0o644); err != nil {
+			return fmt.Errorf("error writing %s: %w", toPath, err)
+		}
+		if err = s.fs.FS.Remove(fromPath); err != nil {
+			return fmt.Errorf("error removing %s: %w", fromPath, err)
+		}
+		if err = machinery.MovePristineFile(s.fs.FS, fromPath, toPath, rewrite); err != nil {
+			return fmt.Errorf("error moving the pristine output of %s: %w", fromPath, err)
+		}
+	}
+
+	if move.recursive {
+		// The apply configurations are generated for the previous layout
+		if err := s.fs.FS.RemoveAll(path.Join(move.from.dir, applyConfigurationDir)); err != nil {
+			return fmt.Errorf("error removing the apply configurations of %s: %w", move.from.dir, err)
+		}
+	}
+	if err := removeEmptyDirs(s.fs.FS, move.from.dir); err != nil {
+		return err
+	}
+	return removeEmptyDirs(s.fs.FS, filepath.Join(machinery.PristineDir, move.from.dir))
+}
+
+// packageFiles returns the paths of all the files of the package, mapped to their new paths.
+func (s *multiGroupScaffolder) packageFiles(move *packageMove) (map[string]string, error) {
+	files := make(map[string]string)
+	err := afero.Walk(s.fs.FS, move.from.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != move.from.dir && (!move.recursive || info.Name() == applyConfigurationDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(move.from.dir, path)
+		if err != nil {
+			return fmt.Errorf("error getting the relative path of %s: %w", path, err)
+		}
+		files[path] = filepath.Join(move.to.dir, rel)
+		return nil
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error listing the files of %s: %w", move.from.dir, err)
+	}
+	return files, nil
+}
+
+// cleanPackage removes the test suite of a package whose resources were all moved to other packages,
+// as it is scaffolded again in those packages, and the package itself once it is empty.
+func (s *multiGroupScaffolder) cleanPackage(move *packageMove) error {
+	if move.suite == nil {
+		return nil
+	}
+
+	paths, err := templatePaths(move.resources[0], !s.multiGroup, move.suite())
+	if err != nil {
+		return err
+	}
+	if !move.vacated {
+		log.Warn("Remove the code for the moved resources from the test suite", "file", paths[0])
+		return nil
+	}
+	if err = s.fs.FS.Remove(paths[0]); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing %s: %w", paths[0], err)
+	}
+	if err = machinery.RemovePristineFile(s.fs.FS, paths[0]); err != nil {
+		return fmt.Errorf("error removing the pristine output of %s: %w", paths[0], err)
+	}
+
+	remaining, err := afero.Glob(s.fs.FS, path.Join(move.from.dir, "*.go"))
+	if err != nil {
+		return fmt.Errorf("error listing the files of %s: %w", move.from.dir, err)
+	}
+	if len(remaining) != 0 {
+		log.Warn("Files that were not scaffolded for a resource are kept in the previous package, "+
+			"move them to the new packages", "files", remaining)
+	}
+	if err := removeEmptyDirs(s.fs.FS, move.from.dir); err != nil {
+		return err
+	}
+	return removeEmptyDirs(s.fs.FS, filepath.Join(machinery.PristineDir, move.from.dir))
+}
+
+// scaffoldSuites scaffolds the test suites of the packages that were not moved as a whole.
+func (s *multiGroupScaffolder) scaffoldSuites(moves []*packageMove) error {
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed to load boilerplate: %w", err)
+		}
+		boilerplate = []byte("")
+	}
+
+	for _, move := range moves {
+		if move.whole || move.suite == nil {
+			continue
+		}
+		for _, res := range move.resources {
+			scaffold := machinery.NewScaffold(s.fs,
+				machinery.WithConfig(s.config),
+				machinery.WithBoilerplate(string(boilerplate)),
+				machinery.WithResource(&res),
+			)
+			if err = scaffold.Execute(move.suite()); err != nil {
+				return fmt.Errorf("error scaffolding the test suite of %s: %w", move.to.dir, err)
+			}
+		}
+	}
+	return nil
+}
+
+// updateImports rewrites the imports of the moved packages in the Go files of the project, and in their
+// pristine outputs so that the scaffold changes are still merged into them.
+func (s *multiGroupScaffolder) updateImports(moves []*packageMove) error {
+	if err := s.updateImportsIn(".", moves); err != nil {
+		return err
+	}
+	return s.updateImportsIn(machinery.PristineDir, moves)
+}
+
+// updateImportsIn rewrites the imports of the moved packages in the Go files under root.
+func (s *multiGroupScaffolder) updateImportsIn(root string, moves []*packageMove) error {
+	err := afero.Walk(s.fs.FS, root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != root && (strings.HasPrefix(info.Name(), ".") || info.Name() == "bin" || info.Name() == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		content, err := afero.ReadFile(s.fs.FS, path)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", path, err)
+		}
+		if !slices.ContainsFunc(moves, func(move *packageMove) bool {
+			return bytes.Contains(content, []byte(`"`+move.from.importPath))
+		}) {
+			return nil
+		}
+
+		rewritten, changed, err := rewriteImports(content, moves)
+		if err != nil {
+			log.Warn("unable to update the imports of the moved packages", "file", path, "error", err)
+			return nil
+		}
+		if !changed {
+			return nil
+		}
+		if err = afero.WriteFile(s.fs.FS, path, rewritten, info.Mode()); err != nil {
+			return fmt.Errorf("error updating %s: %w", path, err)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error updating the imports under %s: %w", root, err)
+	}
+	return nil
+}
+
+// updateResourcePaths updates the path of the APIs that were moved in the project configuration.
+// Updating a resource cannot change its path, so all of them are removed and added back to keep
+// their order in the PROJECT file.
+func (s *multiGroupScaffolder) updateResourcePaths(resources []resource.Resource, wasMultiGroup bool) error {
+	repo := s.config.GetRepository()
+	for _, res := range resources {
+		if err := s.config.RemoveResource(res.GVK); err != nil {
+			return fmt.Errorf("error removing resource %s: %w", res.Kind, err)
+		}
+	}
+
+	for _, res := range resources {
+		if res.HasAPI() && !res.IsExternal() &&
+			res.Path == resource.APIPackagePath(repo, res.Group, res.Version, wasMultiGroup) {
+			res.Path = resource.APIPackagePath(repo, res.Group, res.Version, s.multiGroup)
+		}
+		if err := s.config.AddResource(res); err != nil {
+			return fmt.Errorf("error adding resource %s: %w", res.Kind, err)
+		}
+	}
+	return nil
+}
+
+// rewrite rewrites the package clause of a moved Go file, and records its top-level declarations
+// so that the references to them can be updated. Files of subpackages are only recorded when inPackage
+// is false.
+func (move *packageMove) rewrite(content []byte, inPackage bool) ([]byte, error) {
+	f, err := machinery.ParseGoFile("", content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Go file: %w", err)
+	}
+	file := f.File
+	if !inPackage {
+		return content, nil
+	}
+
+	for name := range declarations(file) {
+		move.names[name] = true
+	}
+
+	if file.Name.Name != move.from.name || move.from.name == move.to.name {
+		return content, nil
+	}
+	rewritten, err := f.Edit([]machinery.GoEdit{{
+		Start: f.Offset(file.Name.Pos()),
+		End:   f.Offset(file.Name.End()),
+		Text:  move.to.name,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("error rewriting Go file: %w", err)
+	}
+	return rewritten, nil
+}
+
+// rewriteImports rewrites the imports of the moved packages in a Go file, and the references to the moved
+// declarations. It returns false if the file does not import any of them.
+func rewriteImports(content []byte, moves []*packageMove) ([]byte, bool, error) {
+	f, err := machinery.ParseGoFile("", content)
+	if err != nil {
+		return nil, false, fmt.Errorf("error parsing Go file: %w", err)
+	}
+	file, offset := f.File, f.Offset
+
+	var edits []machinery.GoEdit
+	for _, spec := range file.Imports {
+		importPath, unquoteErr := strconv.Unquote(spec.Path.Value)
+		if unquoteErr != nil {
+			continue
+		}
+
+		var candidates []*packageMove
+		for _, move := range moves {
+			switch {
+			case importPath == move.from.importPath:
+				candidates = append(candidates, move)
+			case move.recursive && strings.HasPrefix(importPath, move.from.importPath+"/"):
+				// Subpackages keep their name, only their path changes
+				edits = append(edits, machinery.GoEdit{
+					Start: offset(spec.Path.Pos()),
+					End:   offset(spec.Path.End()),
+					Text:  strconv.Quote(move.to.importPath + strings.TrimPrefix(importPath, move.from.importPath)),
+				})
+			}
+		}
+		if len(candidates) != 0 {
+			edits = append(edits, rewriteImport(file, spec, candidates, offset)...)
+		}
+	}
+	if len(edits) == 0 {
+		return content, false, nil
+	}
+
+	rewritten, err := f.Edit(edits)
+	if err != nil {
+		return nil, false, fmt.Errorf("error rewriting Go file: %w", err)
+	}
+	return rewritten, true, nil
+}
+
+// rewriteImport rewrites an import of a package that was moved, in whole or in part, to other packages.
+// The references to the moved declarations use the name under which the new package is imported by the
+// scaffolded code, unless a custom name was used for a package moved as a whole. The previous import is
+// kept if other declarations are still used from it.
+func rewriteImport(
+	file *ast.File,
+	spec *ast.ImportSpec,
+	candidates []*packageMove,
+	offset func(token.Pos) int,
+) []machinery.GoEdit {
+	local := path.Base(candidates[0].from.importPath)
+	if spec.Name != nil {
+		local = spec.Name.Name
+	}
+	var whole *packageMove
+	for _, move := range candidates {
+		if move.whole {
+			whole = move
+		}
+	}
+
+	nameFor := func(move *packageMove) string {
+		scaffolded := cmp.Or(move.from.alias, move.from.name)
+		if move.whole && local != scaffolded {
+			return local
+		}
+		return cmp.Or(move.to.alias, move.to.name)
+	}
+
+	var edits []machinery.GoEdit
+	var used []*packageMove
+	kept := false
+	if local != "_" && local != "." {
+		ast.Inspect(file, func(n ast.Node) bool {
+			selector, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			x, ok := selector.X.(*ast.Ident)
+			if !ok || x.Name != local {
+				return true
+			}
+
+			target := whole
+			for _, move := range candidates {
+				if move.names[selector.Sel.Name] {
+					target = move
+					break
+				}
+			}
+			if target == nil {
+				kept = true
+				return true
+			}
+
+			if !slices.Contains(used, target) {
+				used = append(used, target)
+			}
+			if name := nameFor(target); name != local {
+				edits = append(edits, machinery.GoEdit{Start: offset(x.Pos()), End: offset(x.End()), Text: name})
+			}
+			return true
+		})
+	}
+	if len(used) == 0 && whole != nil {
+		used = append(used, whole)
+	}
+	if len(used) == 0 {
+		return edits
+	}
+
+	imports := make([]string, 0, len(used))
+	for _, move := range used {
+		imports = append(imports, importSpec(nameFor(move), move.to.importPath))
+	}
+	if kept {
+		return append(edits, machinery.GoEdit{
+			Start: offset(spec.End()),
+			End:   offset(spec.End()),
+			Text:  "\n" + strings.Join(imports, "\n"),
+		})
+	}
+	return append(edits, machinery.GoEdit{
+		Start: offset(spec.Pos()),
+		End:   offset(spec.End()),
+		Text:  strings.Join(imports, "\n"),
+	})
+}
+
+// importSpec returns an import of the path under the provided name, which is omitted when it is the
+// last element of the path.
+func importSpec(name, importPath string) string {
+	if name == path.Base(importPath) {
+		return strconv.Quote(importPath)
+	}
+	return name + " " + strconv.Quote(importPath)
+}
+
+// removeEmptyDirs removes the directory if it is empty, and then its parents until one of them is not.
+func removeEmptyDirs(fs afero.Fs, dir string) error {
+	for ; dir != "." && dir != "/" && dir != ""; dir = filepath.Dir(dir) {
+		entries, err := afero.ReadDir(fs, dir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("error reading %s: %w", dir, err)
+		}
+		if len(entries) != 0 {
+			return nil
+		}
+		if err = fs.Remove(dir); err != nil {
+			return fmt.Errorf("error removing %s: %w", dir, err)
+		}
+	}
+	return nil
+}
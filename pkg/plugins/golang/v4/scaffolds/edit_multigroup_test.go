@@ -0,0 +1,144 @@
+//go:build !integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Edit multigroup scaffolding", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+	)
+
+	readFile := func(path string) string {
+		content, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	moveTo := func(multiGroup bool) {
+		scaffolder := newMultiGroupScaffolder(cfg, multiGroup)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+		Expect(cfg.IsMultiGroup()).To(Equal(multiGroup))
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(fs.FS, "cmd/main.go", []byte(deleteTestMain), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs.FS, "internal/fleet/fleet.go",
+			[]byte(editResourceTestReference), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs.FS, "test/e2e/e2e_test.go", []byte("package e2e\n"), 0o644)).To(Succeed())
+
+		cfg = newSSATestConfig()
+		for _, kind := range []string{"Captain", "Sailor"} {
+			res := ssaTestResource(kind, false)
+			res.Path = "sigs.k8s.io/kubebuilder/test/api/v1"
+			res.Controller = true
+			scaffolder := NewAPIScaffolder(cfg, res, false)
+			scaffolder.InjectFS(fs)
+			Expect(scaffolder.Scaffold()).To(Succeed())
+		}
+
+		res, err := cfg.GetResource(ssaTestResource("Captain", false).GVK)
+		Expect(err).NotTo(HaveOccurred())
+		res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+		scaffolder := NewWebhookScaffolder(cfg, res, false)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	})
+
+	It("should move the APIs, controllers and webhooks to the multi-group layout", func() {
+		moveTo(true)
+
+		Expect(afero.Exists(fs.FS, "api/v1")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "internal/webhook/v1")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "internal/controller/captain_controller.go")).To(BeFalse())
+		Expect(readFile("api/crew/v1/captain_types.go")).To(ContainSubstring("package v1"))
+
+		controller := readFile("internal/controller/crew/captain_controller.go")
+		Expect(controller).To(ContainSubstring("package crew"))
+		Expect(controller).To(ContainSubstring(`crewv1 "sigs.k8s.io/kubebuilder/test/api/crew/v1"`))
+		Expect(controller).To(ContainSubstring(`Named("crew-captain")`))
+		Expect(readFile("internal/controller/crew/suite_test.go")).
+			To(ContainSubstring(`filepath.Join("..", "..", "..", "config", "crd", "bases")`))
+		Expect(readFile("internal/webhook/crew/v1/webhook_suite_test.go")).
+			To(ContainSubstring(`filepath.Join("..", "..", "..", "..", "config", "webhook")`))
+
+		main := readFile("cmd/main.go")
+		Expect(main).To(ContainSubstring(`crewcontroller "sigs.k8s.io/kubebuilder/test/internal/controller/crew"`))
+		Expect(main).To(ContainSubstring(`webhookcrewv1 "sigs.k8s.io/kubebuilder/test/internal/webhook/crew/v1"`))
+		Expect(main).To(ContainSubstring("crewcontroller.CaptainReconciler"))
+		Expect(main).To(ContainSubstring("webhookcrewv1.SetupCaptainWebhookWithManager"))
+		Expect(main).NotTo(ContainSubstring("(&controller."))
+
+		Expect(readFile("internal/fleet/fleet.go")).
+			To(ContainSubstring(`crewv1 "sigs.k8s.io/kubebuilder/test/api/crew/v1"`))
+
+		Expect(afero.Exists(fs.FS, filepath.Join(machinery.PristineDir, "api/v1"))).To(BeFalse())
+		Expect(afero.Exists(fs.FS,
+			filepath.Join(machinery.PristineDir, "internal/controller/suite_test.go"))).To(BeFalse())
+		Expect(readFile(filepath.Join(machinery.PristineDir, "internal/controller/crew/captain_controller.go"))).
+			To(ContainSubstring(`crewv1 "sigs.k8s.io/kubebuilder/test/api/crew/v1"`))
+		Expect(afero.Exists(fs.FS, filepath.Join(machinery.PristineDir, "api/crew/v1/captain_types.go"))).To(BeTrue())
+
+		res, err := cfg.GetResource(ssaTestResource("Sailor", false).GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Path).To(Equal("sigs.k8s.io/kubebuilder/test/api/crew/v1"))
+	})
+
+	It("should restore the single-group layout", func() {
+		files := []string{
+			"cmd/main.go",
+			"internal/fleet/fleet.go",
+			"internal/controller/captain_controller.go",
+			"internal/controller/suite_test.go",
+			"internal/webhook/v1/captain_webhook.go",
+			"internal/webhook/v1/webhook_suite_test.go",
+			"api/v1/groupversion_info.go",
+		}
+		contents := make(map[string]string, len(files))
+		for _, path := range files {
+			contents[path] = readFile(path)
+		}
+
+		moveTo(true)
+		moveTo(false)
+
+		for _, path := range files {
+			Expect(readFile(path)).To(Equal(contents[path]), path)
+		}
+		Expect(afero.Exists(fs.FS, "api/crew")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "internal/controller/crew")).To(BeFalse())
+
+		res, err := cfg.GetResource(ssaTestResource("Captain", false).GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Path).To(Equal("sigs.k8s.io/kubebuilder/test/api/v1"))
+	})
+})
@@ -0,0 +1,407 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	log "log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"golang.org/x/tools/imports"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
+)
+
+const (
+	rbacMarkerPrefix = "// +kubebuilder:rbac:"
+
+	cacheImportPath = "sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// watchNamespaceFuncs are the functions of cmd/main.go that configure the cache of the manager for the
+// namespaces of the WATCH_NAMESPACE environment variable.
+const watchNamespaceFuncs = `// getWatchNamespace returns the namespace(s) the manager should watch for changes.
+// It reads the value from the WATCH_NAMESPACE environment variable.
+// - If WATCH_NAMESPACE is not set, an error is returned
+// - If WATCH_NAMESPACE contains a single namespace, the manager watches that namespace
+// - If WATCH_NAMESPACE contains comma-separated namespaces, the manager watches those namespaces
+func getWatchNamespace() (string, error) {
+	watchNamespaceEnvVar := "WATCH_NAMESPACE"
+	ns, found := os.LookupEnv(watchNamespaceEnvVar)
+	if !found {
+		return "", fmt.Errorf("%s must be set", watchNamespaceEnvVar)
+	}
+	return ns, nil
+}
+
+// setupCacheNamespaces configures the cache to watch specific namespace(s).
+// It supports both single namespace ("ns1") and multi-namespace ("ns1,ns2,ns3") formats.
+func setupCacheNamespaces(namespaces string) cache.Options {
+	defaultNamespaces := make(map[string]cache.Config)
+	for ns := range strings.SplitSeq(namespaces, ",") {
+		defaultNamespaces[strings.TrimSpace(ns)] = cache.Config{}
+	}
+	return cache.Options{
+		DefaultNamespaces: defaultNamespaces,
+	}
+}
+
+`
+
+// watchNamespaceManager creates the manager of cmd/main.go with a cache restricted to the namespaces of
+// the WATCH_NAMESPACE environment variable. It is formatted with the options of the manager.
+const watchNamespaceManager = `
+	// Get the namespace(s) for namespace-scoped mode from WATCH_NAMESPACE environment variable.
+	// The manager will only watch and manage resources in the specified namespace(s).
+	watchNamespace, err := getWatchNamespace()
+	if err != nil {
+		setupLog.Error(err, "Unable to get WATCH_NAMESPACE, "+
+			"the manager will watch and manage resources in all namespaces")
+		os.Exit(1)
+	}
+
+	// Configure manager options for namespace-scoped mode
+	mgrOptions := %s
+
+	// Configure cache to watch namespace(s) specified in WATCH_NAMESPACE
+	mgrOptions.Cache = setupCacheNamespaces(watchNamespace)
+	setupLog.Info("Watching namespace(s)", "namespaces", watchNamespace)
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOptions)`
+
+var _ plugins.Scaffolder = &namespacedScaffolder{}
+
+// namespacedScaffolder updates the code of a project when it is switched between the cluster-scoped
+// and the namespace-scoped modes: the RBAC markers of the controllers are scoped to the namespace of the
+// manager, and the manager of cmd/main.go only watches the namespaces of WATCH_NAMESPACE. Files that do not
+// match the scaffolded code are reported and left unchanged.
+type namespacedScaffolder struct {
+	config     config.Config
+	namespaced bool
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// newNamespacedScaffolder returns a new Scaffolder that updates the code of the project for the provided mode
+func newNamespacedScaffolder(cfg config.Config, namespaced bool) plugins.Scaffolder {
+	return &namespacedScaffolder{
+		config:     cfg,
+		namespaced: namespaced,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *namespacedScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *namespacedScaffolder) Scaffold() error {
+	log.Info("Updating the RBAC markers of the controllers and cmd/main.go...")
+
+	paths, err := s.controllerPaths()
+	if err != nil {
+		return err
+	}
+	namespace := s.config.GetProjectName() + "-system"
+	for _, path := range paths {
+		if err = s.rewrite(path, func(content []byte) ([]byte, error) {
+			return rewriteRBACMarkers(content, namespace, s.namespaced)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return s.rewrite((&cmd.MainUpdater{}).GetPath(), func(content []byte) ([]byte, error) {
+		if s.namespaced {
+			return addWatchNamespace(content)
+		}
+		return removeWatchNamespace(content)
+	})
+}
+
+// controllerPaths returns the paths of the controllers scaffolded for the resources of the project.
+func (s *namespacedScaffolder) controllerPaths() ([]string, error) {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources: %w", err)
+	}
+
+	var paths []string
+	for _, res := range resources {
+		for _, name := range res.GetControllerNames() {
+			resPaths, err := templatePaths(res, s.config.IsMultiGroup(), &controllers.Controller{ControllerName: name})
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(paths, resPaths[0]) {
+				paths = append(paths, resPaths[0])
+			}
+		}
+	}
+	return paths, nil
+}
+
+// rewrite rewrites the Go file at path. Missing files and files that cannot be rewritten are reported.
+func (s *namespacedScaffolder) rewrite(path string, rewrite func([]byte) ([]byte, error)) error {
+	content, err := afero.ReadFile(s.fs.FS, path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("skipping missing file", "file", path)
+			return nil
+		}
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	rewritten, err := rewrite(content)
+	if err != nil {
+		log.Warn("Unable to update the file, apply the changes for the new mode manually", "file", path, "error", err)
+		return nil
+	}
+	if bytes.Equal(rewritten, content) {
+		return nil
+	}
+	if err = afero.WriteFile(s.fs.FS, path, rewritten, 0o644); err != nil {
+		return fmt.Errorf("error updating %s: %w", path, err)
+	}
+	return nil
+}
+
+// rewriteRBACMarkers adds the namespace argument to the RBAC markers of a Go file, after the groups, or
+// removes it. Markers for non-resource URLs cannot be namespaced, so they are not changed.
+func rewriteRBACMarkers(content []byte, namespace string, namespaced bool) ([]byte, error) {
+	f, err := machinery.ParseGoFile("", content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Go file: %w", err)
+	}
+
+	var edits []machinery.GoEdit
+	for _, group := range f.File.Comments {
+		for _, comment := range group.List {
+			marker, found := strings.CutPrefix(comment.Text, rbacMarkerPrefix)
+			if !found {
+				continue
+			}
+
+			args := strings.Split(marker, ",")
+			hasNamespace := slices.ContainsFunc(args, func(arg string) bool {
+				return strings.HasPrefix(arg, "namespace=")
+			})
+			switch {
+			case namespaced && !hasNamespace && !slices.ContainsFunc(args, func(arg string) bool {
+				return strings.HasPrefix(arg, "urls=")
+			}):
+				index := slices.IndexFunc(args, func(arg string) bool { return strings.HasPrefix(arg, "groups=") })
+				args = slices.Insert(args, index+1, "namespace="+namespace)
+			case !namespaced && hasNamespace:
+				args = slices.DeleteFunc(args, func(arg string) bool { return strings.HasPrefix(arg, "namespace=") })
+			default:
+				continue
+			}
+
+			edits = append(edits, machinery.GoEdit{
+				Start: f.Offset(comment.Pos()),
+				End:   f.Offset(comment.End()),
+				Text:  rbacMarkerPrefix + strings.Join(args, ","),
+			})
+		}
+	}
+
+	rewritten, err := f.Edit(edits)
+	if err != nil {
+		return nil, fmt.Errorf("error rewriting Go file: %w", err)
+	}
+	return rewritten, nil
+}
+
+// addWatchNamespace restricts the cache of the manager created in cmd/main.go to the namespaces of the
+// WATCH_NAMESPACE environment variable. The manager must be created with the options inlined, as scaffolded.
+func addWatchNamespace(content []byte) ([]byte, error) {
+	f, err := machinery.ParseGoFile("", content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Go file: %w", err)
+	}
+
+	if f.FindFunc("getWatchNamespace") != nil {
+		return content, nil
+	}
+	main := f.FindFunc("main")
+	if main == nil || main.Body == nil {
+		return nil, errors.New("no main function found")
+	}
+
+	var manager *ast.AssignStmt
+	var options *ast.CompositeLit
+	for _, stmt := range main.Body.List {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || len(assign.Rhs) != 1 || !isCall(assign.Rhs[0], "ctrl", "NewManager") {
+			continue
+		}
+		call := assign.Rhs[0].(*ast.CallExpr)
+		if len(call.Args) == 2 {
+			if literal, ok := call.Args[1].(*ast.CompositeLit); ok {
+				manager, options = assign, literal
+			}
+		}
+	}
+	if manager == nil {
+		return nil, errors.New("the manager is not created with ctrl.NewManager and inlined ctrl.Options")
+	}
+
+	anchors := []machinery.Anchor{machinery.ImportsAnchor(), machinery.BeforeFuncAnchor("main")}
+	edits, err := f.InsertCodeFragments(anchors, machinery.AnchoredCodeFragmentsMap{
+		machinery.ImportsAnchor():          {`"fmt"`, `"strings"`, strconv.Quote(cacheImportPath)},
+		machinery.BeforeFuncAnchor("main"): {watchNamespaceFuncs},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding the functions for WATCH_NAMESPACE: %w", err)
+	}
+	edits = append(edits, machinery.GoEdit{
+		Start: f.Offset(manager.Pos()),
+		End:   f.Offset(manager.End()),
+		Text:  fmt.Sprintf(watchNamespaceManager, content[f.Offset(options.Pos()):f.Offset(options.End())]),
+	})
+
+	rewritten, err := f.Edit(edits)
+	if err != nil {
+		return nil, fmt.Errorf("error rewriting Go file: %w", err)
+	}
+	return rewritten, nil
+}
+
+// removeWatchNamespace removes the restriction of the cache of the manager created in cmd/main.go to the
+// namespaces of the WATCH_NAMESPACE environment variable, inlining the options of the manager back.
+func removeWatchNamespace(content []byte) ([]byte, error) {
+	f, err := machinery.ParseGoFile("", content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Go file: %w", err)
+	}
+	offset := f.Offset
+
+	main := f.FindFunc("main")
+	if main == nil || main.Body == nil {
+		return nil, errors.New("no main function found")
+	}
+
+	var edits []machinery.GoEdit
+	for _, name := range []string{"getWatchNamespace", "setupCacheNamespaces"} {
+		if decl := f.FindFunc(name); decl != nil {
+			edits = append(edits, machinery.GoEdit{Start: f.NodeStart(decl), End: offset(decl.End())})
+		}
+	}
+
+	var options *ast.AssignStmt
+	var manager *ast.CallExpr
+	optionsUses := 0
+	for i, stmt := range main.Body.List {
+		switch {
+		case usesIdent(stmt, "getWatchNamespace"):
+			edits = append(edits, machinery.GoEdit{Start: f.NodeStart(stmt), End: offset(stmt.End())})
+			// The error returned when WATCH_NAMESPACE is not set is checked right after
+			if i+1 < len(main.Body.List) {
+				if check, ok := main.Body.List[i+1].(*ast.IfStmt); ok && usesIdent(check.Cond, "err") {
+					edits = append(edits, machinery.GoEdit{Start: offset(check.Pos()), End: offset(check.End())})
+				}
+			}
+		case usesIdent(stmt, "watchNamespace") || usesIdent(stmt, "setupCacheNamespaces"):
+			edits = append(edits, machinery.GoEdit{Start: f.NodeStart(stmt), End: offset(stmt.End())})
+		default:
+			if assign, ok := stmt.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && isIdent(assign.Lhs[0], "mgrOptions") &&
+				assign.Tok == token.DEFINE {
+				options = assign
+				continue
+			}
+			if assign, ok := stmt.(*ast.AssignStmt); ok && len(assign.Rhs) == 1 && isCall(assign.Rhs[0], "ctrl", "NewManager") {
+				call := assign.Rhs[0].(*ast.CallExpr)
+				if len(call.Args) == 2 && isIdent(call.Args[1], "mgrOptions") {
+					manager = call
+					continue
+				}
+			}
+			if usesIdent(stmt, "mgrOptions") {
+				optionsUses++
+			}
+		}
+	}
+	if len(edits) == 0 {
+		return content, nil
+	}
+
+	// The options are inlined back only when they are not used by other statements
+	if options != nil && manager != nil && optionsUses == 0 {
+		edits = append(edits,
+			machinery.GoEdit{Start: f.NodeStart(options), End: offset(options.End())},
+			machinery.GoEdit{
+				Start: offset(manager.Args[1].Pos()),
+				End:   offset(manager.Args[1].End()),
+				Text:  string(content[offset(options.Rhs[0].Pos()):offset(options.Rhs[0].End())]),
+			},
+		)
+	}
+
+	rewritten, err := f.Edit(edits)
+	if err != nil {
+		return nil, fmt.Errorf("error rewriting Go file: %w", err)
+	}
+	// The imports that were only used to read WATCH_NAMESPACE are removed
+	rewritten, err = imports.Process("", rewritten, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error removing unused imports: %w", err)
+	}
+	return rewritten, nil
+}
+
+// isIdent returns true if the expression is the identifier with the provided name.
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// isCall returns true if the expression calls the function of the package with the provided name.
+func isCall(expr ast.Expr, pkg, name string) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	return ok && isIdent(selector.X, pkg) && selector.Sel.Name == name
+}
+
+// usesIdent returns true if the identifier with the provided name appears in the node.
+func usesIdent(node ast.Node, name string) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
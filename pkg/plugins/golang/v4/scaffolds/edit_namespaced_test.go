@@ -0,0 +1,101 @@
+//go:build !integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+)
+
+const namespacedTestController = `package controller
+
+// +kubebuilder:rbac:groups=crew.test.io,resources=captains,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get
+// +kubebuilder:rbac:urls=/metrics,verbs=get
+
+// CaptainReconciler reconciles a Captain object
+type CaptainReconciler struct{}
+`
+
+var _ = Describe("Edit namespaced scaffolding", func() {
+	scaffoldMain := func(namespaced bool) []byte {
+		cfg := newSSATestConfig()
+		if namespaced {
+			Expect(cfg.SetNamespaced()).To(Succeed())
+		}
+		fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+		scaffold := machinery.NewScaffold(fs, machinery.WithConfig(cfg))
+		Expect(scaffold.Execute(&cmd.Main{ControllerRuntimeVersion: ControllerRuntimeVersion})).To(Succeed())
+
+		content, err := afero.ReadFile(fs.FS, "cmd/main.go")
+		Expect(err).NotTo(HaveOccurred())
+		return content
+	}
+
+	Context("rewriteRBACMarkers", func() {
+		It("should add the namespace after the groups of the resource markers", func() {
+			content, err := rewriteRBACMarkers([]byte(namespacedTestController), "test-system", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(
+				"+kubebuilder:rbac:groups=crew.test.io,namespace=test-system,resources=captains,verbs=get;list;watch"))
+			Expect(string(content)).To(ContainSubstring(
+				"+kubebuilder:rbac:groups=core,namespace=test-system,resources=pods,verbs=get"))
+			Expect(string(content)).To(ContainSubstring("+kubebuilder:rbac:urls=/metrics,verbs=get\n"))
+		})
+
+		It("should remove the namespace from the markers", func() {
+			namespaced, err := rewriteRBACMarkers([]byte(namespacedTestController), "test-system", true)
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := rewriteRBACMarkers(namespaced, "test-system", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(namespacedTestController))
+		})
+	})
+
+	Context("cmd/main.go", func() {
+		It("should configure the manager as scaffolded for namespace-scoped projects", func() {
+			content, err := addWatchNamespace(scaffoldMain(false))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(string(scaffoldMain(true))))
+		})
+
+		It("should configure the manager as scaffolded for cluster-scoped projects", func() {
+			content, err := removeWatchNamespace(scaffoldMain(true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(string(scaffoldMain(false))))
+		})
+
+		It("should not change a main.go that already watches the namespaces", func() {
+			namespaced := scaffoldMain(true)
+			content, err := addWatchNamespace(namespaced)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(Equal(namespaced))
+		})
+
+		It("should report a manager that is not created as scaffolded", func() {
+			_, err := addWatchNamespace([]byte("package main\n\nfunc main() {\n\tmgr, err := newManager()\n}\n"))
+			Expect(err).To(MatchError(ContainSubstring("ctrl.NewManager")))
+		})
+	})
+})
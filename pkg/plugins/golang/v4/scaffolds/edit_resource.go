@@ -0,0 +1,399 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/test/e2e"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/webhooks"
+)
+
+var _ plugins.Scaffolder = &editResourceScaffolder{}
+
+// editResourceScaffolder contains configuration for renaming or moving the scaffold of an API
+// to another group, version or kind.
+type editResourceScaffolder struct {
+	config config.Config
+	from   resource.Resource
+	to     resource.Resource
+
+	// runMake indicates whether make generate runs after the rename, regenerating the deepcopy functions
+	runMake bool
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewEditResourceScaffolder returns a new Scaffolder for resource rename operations
+func NewEditResourceScaffolder(cfg config.Config, from, to resource.Resource, runMake bool) plugins.Scaffolder {
+	return &editResourceScaffolder{
+		config:  cfg,
+		from:    from,
+		to:      to,
+		runMake: runMake,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *editResourceScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *editResourceScaffolder) Scaffold() error {
+	log.Info("Renaming scaffold of the API...")
+
+	// Load the boilerplate
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("error renaming API: failed to load boilerplate: %w", err)
+		}
+		boilerplate = []byte("")
+	}
+
+	retained, err := retainedResources(s.config, s.from.GVK)
+	if err != nil {
+		return err
+	}
+
+	fromScaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.from),
+	)
+	toScaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithBoilerplate(string(boilerplate)),
+		machinery.WithResource(&s.to),
+	)
+
+	renamer := newGoRenamer(s.from, s.to)
+
+	moved, err := s.moveFiles(renamer)
+	if err != nil {
+		return err
+	}
+
+	if err = s.updateCodeFragments(fromScaffold, toScaffold, retained); err != nil {
+		return err
+	}
+
+	if s.from.HasAPI() {
+		if err = s.updateAPIPackages(fromScaffold, toScaffold, retained); err != nil {
+			return err
+		}
+	}
+
+	if err = s.updateReferences(renamer, moved); err != nil {
+		return err
+	}
+	s.warnCustomWebhookPaths()
+
+	return replaceResource(s.config, s.from.GVK, &s.to)
+}
+
+// warnCustomWebhookPaths warns about the custom webhook paths of the resource, which are kept as they are.
+func (s *editResourceScaffolder) warnCustomWebhookPaths() {
+	if s.from.Webhooks == nil || s.to.Webhooks == nil {
+		return
+	}
+	for _, paths := range [][2]string{
+		{s.from.Webhooks.DefaultingPath, s.to.Webhooks.DefaultingPath},
+		{s.from.Webhooks.ValidationPath, s.to.Webhooks.ValidationPath},
+	} {
+		if paths[0] != "" && paths[0] == paths[1] {
+			log.Warn("The custom webhook path is kept, update it in the PROJECT file and in the webhook marker "+
+				"if it refers to the previous kind", "path", paths[0])
+		}
+	}
+}
+
+// moveFiles moves the files scaffolded for the resource to their new path, rewriting their content,
+// and returns the new paths.
+func (s *editResourceScaffolder) moveFiles(renamer goRenamer) (map[string]bool, error) {
+	fromPaths, err := s.ownedFiles(s.from)
+	if err != nil {
+		return nil, err
+	}
+	toPaths, err := s.ownedFiles(s.to)
+	if err != nil {
+		return nil, err
+	}
+
+	moved := make(map[string]bool, len(toPaths))
+	for i, fromPath := range fromPaths {
+		toPath := toPaths[i]
+
+		content, err := afero.ReadFile(s.fs.FS, fromPath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				log.Warn("skipping missing file", "file", fromPath)
+				continue
+			}
+			return nil, fmt.Errorf("error reading %s: %w", fromPath, err)
+		}
+
+		if toPath != fromPath {
+			exists, existsErr := afero.Exists(s.fs.FS, toPath)
+			if existsErr != nil {
+				return nil, fmt.Errorf("error checking %s: %w", toPath, existsErr)
+			}
+			if exists {
+				return nil, fmt.Errorf("unable to move %s: file %s already exists", fromPath, toPath)
+			}
+		}
+
+		// The Go type file is the first one, when the resource has an API
+		if i == 0 && s.from.HasAPI() {
+			if err = renamer.declare(content); err != nil {
+				return nil, fmt.Errorf("error reading the declarations of %s: %w", fromPath, err)
+			}
+		}
+
+		rewritten, err := renamer.rewriteOwned(content)
+		if err != nil {
+			return nil, fmt.Errorf("error rewriting %s: %w", fromPath, err)
+		}
+
+		if err = s.fs.FS.MkdirAll(filepath.Dir(toPath), 0o755); err != nil {
+			return nil, fmt.Errorf("error creating directory for %s: %w", toPath, err)
+		}
+		if err = afero.WriteFile(s.fs.FS, toPath, rewritten, 0o644); err != nil {
+			return nil, fmt.Errorf("error writing %s: %w", toPath, err)
+		}
+		if toPath != fromPath {
+			if err = s.fs.FS.Remove(fromPath); err != nil {
+				return nil, fmt.Errorf("error removing %s: %w", fromPath, err)
+			}
+		}
+		if err = machinery.MovePristineFile(s.fs.FS, fromPath, toPath, renamer.rewriteOwned); err != nil {
+			return nil, fmt.Errorf("error moving the pristine output of %s: %w", fromPath, err)
+		}
+
+		moved[toPath] = true
+	}
+
+	return moved, nil
+}
+
+// ownedFiles returns the paths of the files scaffolded for the resource, which belong to it alone.
+// The paths of a resource and its renamed copy are returned in the same order.
+func (s *editResourceScaffolder) ownedFiles(res resource.Resource) ([]string, error) {
+	var templates []machinery.Template
+	if res.HasAPI() {
+		templates = append(templates, &api.Types{})
+	}
+	for _, name := range res.GetControllerNames() {
+		templates = append(templates, &controllers.Controller{ControllerName: name})
+	}
+	if res.HasController() {
+		templates = append(templates, &controllers.ControllerTest{})
+	}
+	if res.HasDefaultingWebhook() || res.HasValidationWebhook() {
+		templates = append(templates, &webhooks.Webhook{}, &webhooks.WebhookTest{})
+	}
+
+	return templatePaths(res, s.config.IsMultiGroup(), templates...)
+}
+
+// templatePaths returns the paths of the files scaffolded by the templates for the resource in the
+// provided layout.
+func templatePaths(res resource.Resource, multiGroup bool, templates ...machinery.Template) ([]string, error) {
+	paths := make([]string, 0, len(templates))
+	for _, template := range templates {
+		if builder, ok := template.(machinery.HasMultiGroup); ok {
+			builder.InjectMultiGroup(multiGroup)
+		}
+		if builder, ok := template.(machinery.HasResource); ok {
+			builder.InjectResource(&res)
+		}
+		if err := template.SetTemplateDefaults(); err != nil {
+			return nil, fmt.Errorf("error getting the path of %T: %w", template, err)
+		}
+		paths = append(paths, template.GetPath())
+	}
+	return paths, nil
+}
+
+// updateCodeFragments replaces the code inserted for the resource in shared files, such as cmd/main.go
+// and the test suites, with the code for the renamed resource. Fragments that were modified are kept.
+func (s *editResourceScaffolder) updateCodeFragments(
+	fromScaffold, toScaffold *machinery.Scaffold,
+	retained []resource.Resource,
+) error {
+	retainedMixin := machinery.RetainedResourcesMixin{RetainedResources: retained}
+	retainedWebhooks, retainedInPackage := retainedWebhookResources(retained, s.from)
+	retainedWebhooksMixin := machinery.RetainedResourcesMixin{RetainedResources: retainedWebhooks}
+
+	var removed, added []machinery.Builder
+	if s.from.HasAPI() {
+		removed = append(removed, &cmd.MainUpdater{RetainedResourcesMixin: retainedMixin, WireResource: true})
+		added = append(added, &cmd.MainUpdater{WireResource: true})
+	}
+	if s.from.HasController() {
+		removed = append(removed, &controllers.SuiteTest{RetainedResourcesMixin: retainedMixin})
+		added = append(added, &controllers.SuiteTest{})
+	}
+	fromNames, toNames := s.from.GetControllerNames(), s.to.GetControllerNames()
+	for i := range fromNames {
+		removed = append(removed, &cmd.MainUpdater{
+			RetainedResourcesMixin: retainedMixin,
+			WireController:         true,
+			ControllerName:         fromNames[i],
+		})
+		added = append(added, &cmd.MainUpdater{WireController: true, ControllerName: toNames[i]})
+	}
+	if s.from.HasDefaultingWebhook() || s.from.HasValidationWebhook() {
+		removed = append(removed,
+			&cmd.MainUpdater{RetainedResourcesMixin: retainedWebhooksMixin, WireWebhook: true},
+			&webhooks.WebhookSuite{
+				RetainedResourcesMixin: machinery.RetainedResourcesMixin{RetainedResources: retainedInPackage},
+			},
+			&e2e.WebhookTestUpdater{RetainedResourcesMixin: retainedWebhooksMixin, WireWebhook: true},
+		)
+		added = append(added,
+			&cmd.MainUpdater{WireWebhook: true},
+			&webhooks.WebhookSuite{},
+			&e2e.WebhookTestUpdater{WireWebhook: true},
+		)
+	}
+
+	if err := fromScaffold.Remove(removed...); err != nil {
+		return fmt.Errorf("error removing the code inserted for %s: %w", s.from.Kind, err)
+	}
+	if err := toScaffold.Execute(added...); err != nil {
+		return fmt.Errorf("error inserting the code for %s: %w", s.to.Kind, err)
+	}
+	return nil
+}
+
+// updateAPIPackages scaffolds the group version package of the renamed resource if needed, and removes
+// the previous one once it does not contain any other API. The generated deepcopy functions of the
+// previous package refer to the renamed types, so they are removed along with the package or when
+// make generate regenerates them, and kept otherwise.
+func (s *editResourceScaffolder) updateAPIPackages(
+	fromScaffold, toScaffold *machinery.Scaffold,
+	retained []resource.Resource,
+) error {
+	samePackage := s.from.Group == s.to.Group && s.from.Version == s.to.Version
+	removePackage := !samePackage && !hasAPIInPackage(retained, s.from)
+
+	if s.runMake || removePackage {
+		if err := removeDeepCopy(s.fs, s.config.IsMultiGroup(), s.from); err != nil {
+			return err
+		}
+	} else {
+		log.Warn("The generated deepcopy functions still refer to the previous kind, "+
+			"run make generate to regenerate them", "kind", s.from.Kind)
+	}
+	if s.from.API.SSA {
+		log.Warn("Remove the apply configurations generated for the previous kind, "+
+			"they are regenerated for the renamed one by make generate", "kind", s.from.Kind)
+	}
+
+	if samePackage {
+		return nil
+	}
+
+	if removePackage {
+		if err := fromScaffold.Remove(&api.Group{}); err != nil {
+			return fmt.Errorf("error removing API package: %w", err)
+		}
+	}
+	if err := toScaffold.Execute(&api.Group{}); err != nil {
+		return fmt.Errorf("error scaffolding API package: %w", err)
+	}
+	return nil
+}
+
+// updateReferences rewrites the references to the types of the resource in the other Go files of the project,
+// and in their pristine outputs so that the scaffold changes are still merged into them.
+func (s *editResourceScaffolder) updateReferences(renamer goRenamer, moved map[string]bool) error {
+	if err := s.updateReferencesIn(".", renamer, moved); err != nil {
+		return err
+	}
+	return s.updateReferencesIn(machinery.PristineDir, renamer, moved)
+}
+
+// updateReferencesIn rewrites the references to the types of the resource in the Go files under root.
+func (s *editResourceScaffolder) updateReferencesIn(root string, renamer goRenamer, moved map[string]bool) error {
+	importPath := []byte(strconv.Quote(s.from.Path))
+
+	err := afero.Walk(s.fs.FS, root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != root && (strings.HasPrefix(info.Name(), ".") || info.Name() == "bin" || info.Name() == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return fmt.Errorf("error getting the relative path of %s: %w", path, err)
+		}
+		if filepath.Ext(path) != ".go" || moved[rel] {
+			return nil
+		}
+
+		content, err := afero.ReadFile(s.fs.FS, path)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", path, err)
+		}
+		if !bytes.Contains(content, importPath) {
+			return nil
+		}
+
+		rewritten, changed, err := renamer.rewriteReferences(content)
+		if err != nil {
+			log.Warn("unable to update the references to the resource", "file", path, "error", err)
+			return nil
+		}
+		if !changed {
+			return nil
+		}
+
+		log.Info("Updating references to the resource", "file", path)
+		if err = afero.WriteFile(s.fs.FS, path, rewritten, info.Mode()); err != nil {
+			return fmt.Errorf("error updating %s: %w", path, err)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error updating the references under %s: %w", root, err)
+	}
+	return nil
+}
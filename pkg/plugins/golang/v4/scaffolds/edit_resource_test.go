@@ -0,0 +1,211 @@
+//go:build !integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+)
+
+const editResourceTestReference = `package fleet
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	crewv1 "sigs.k8s.io/kubebuilder/test/api/v1"
+)
+
+func newCaptain(spec corev1.PodSpec) *crewv1.Captain {
+	_ = crewv1.Sailor{}
+	return &crewv1.Captain{}
+}
+`
+
+var _ = Describe("Edit resource scaffolding", func() {
+	var (
+		fs      machinery.Filesystem
+		cfg     config.Config
+		captain resource.Resource
+	)
+
+	readFile := func(path string) string {
+		content, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	rename := func(res resource.Resource, to resource.GVK, runMake bool) resource.Resource {
+		from, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		renamed := util.RenameResource(cfg, from, to)
+
+		scaffolder := NewEditResourceScaffolder(cfg, from, renamed, runMake)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+		return renamed
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(fs.FS, "cmd/main.go", []byte(deleteTestMain), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs.FS, "internal/fleet/fleet.go",
+			[]byte(editResourceTestReference), 0o644)).To(Succeed())
+
+		cfg = newSSATestConfig()
+		for _, kind := range []string{"Captain", "Sailor"} {
+			res := ssaTestResource(kind, false)
+			res.Path = "sigs.k8s.io/kubebuilder/test/api/v1"
+			res.Controller = true
+			scaffolder := NewAPIScaffolder(cfg, res, false)
+			scaffolder.InjectFS(fs)
+			Expect(scaffolder.Scaffold()).To(Succeed())
+		}
+		captain = ssaTestResource("Captain", false)
+	})
+
+	It("should rename the kind of an API", func() {
+		rename(captain, resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Frigate"}, true)
+
+		Expect(afero.Exists(fs.FS, "api/v1/captain_types.go")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "internal/controller/captain_controller.go")).To(BeFalse())
+
+		types := readFile("api/v1/frigate_types.go")
+		Expect(types).To(ContainSubstring("type Frigate struct"))
+		Expect(types).To(ContainSubstring("type FrigateSpec struct"))
+		Expect(types).NotTo(ContainSubstring("Captain"))
+
+		controller := readFile("internal/controller/frigate_controller.go")
+		Expect(controller).To(ContainSubstring("type FrigateReconciler struct"))
+		Expect(controller).To(ContainSubstring("resources=frigates,"))
+
+		main := readFile("cmd/main.go")
+		Expect(main).NotTo(ContainSubstring("CaptainReconciler"))
+		Expect(main).To(ContainSubstring("FrigateReconciler"))
+		Expect(main).To(ContainSubstring("SailorReconciler"))
+
+		fleet := readFile("internal/fleet/fleet.go")
+		Expect(fleet).To(ContainSubstring("*crewv1.Frigate"))
+		Expect(fleet).To(ContainSubstring("crewv1.Sailor{}"))
+		Expect(fleet).To(ContainSubstring("corev1.PodSpec"))
+
+		pristineTypes := filepath.Join(machinery.PristineDir, "api/v1/frigate_types.go")
+		Expect(afero.Exists(fs.FS, filepath.Join(machinery.PristineDir, "api/v1/captain_types.go"))).To(BeFalse())
+		Expect(readFile(pristineTypes)).To(ContainSubstring("type Frigate struct"))
+		Expect(readFile(pristineTypes)).NotTo(ContainSubstring("Captain"))
+		Expect(afero.Exists(fs.FS,
+			filepath.Join(machinery.PristineDir, "internal/controller/frigate_controller.go"))).To(BeTrue())
+
+		Expect(cfg.HasResource(captain.GVK)).To(BeFalse())
+		renamed, err := cfg.GetResource(resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Frigate"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renamed.Plural).To(Equal("frigates"))
+	})
+
+	It("should remove the generated deepcopy functions only when make generate regenerates them", func() {
+		const deepCopyPath = "api/v1/zz_generated.deepcopy.go"
+		Expect(afero.WriteFile(fs.FS, deepCopyPath, []byte("package v1\n"), 0o644)).To(Succeed())
+
+		rename(captain, resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Frigate"}, false)
+		Expect(afero.Exists(fs.FS, deepCopyPath)).To(BeTrue())
+
+		frigate := resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Frigate"}
+		rename(resource.Resource{GVK: frigate}, captain.GVK, true)
+		Expect(afero.Exists(fs.FS, deepCopyPath)).To(BeFalse())
+	})
+
+	It("should move an API to another version and keep the package of the remaining ones", func() {
+		rename(captain, resource.GVK{Group: "crew", Domain: "test.io", Version: "v2", Kind: "Captain"}, true)
+
+		Expect(afero.Exists(fs.FS, "api/v1/captain_types.go")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, "api/v1/groupversion_info.go")).To(BeTrue())
+		Expect(readFile("api/v2/captain_types.go")).To(ContainSubstring("package v2"))
+		Expect(readFile("api/v2/groupversion_info.go")).To(ContainSubstring(`Version: "v2"`))
+
+		Expect(readFile("internal/controller/captain_controller.go")).
+			To(ContainSubstring(`crewv2 "sigs.k8s.io/kubebuilder/test/api/v2"`))
+		Expect(readFile(filepath.Join(machinery.PristineDir, "internal/controller/captain_controller.go"))).
+			To(ContainSubstring(`crewv2 "sigs.k8s.io/kubebuilder/test/api/v2"`))
+		Expect(readFile(filepath.Join(machinery.PristineDir, "api/v2/captain_types.go"))).To(ContainSubstring("package v2"))
+
+		main := readFile("cmd/main.go")
+		Expect(main).To(ContainSubstring(`crewv1 "sigs.k8s.io/kubebuilder/test/api/v1"`))
+		Expect(main).To(ContainSubstring(`crewv2 "sigs.k8s.io/kubebuilder/test/api/v2"`))
+		Expect(main).To(ContainSubstring("crewv2.AddToScheme(scheme)"))
+
+		fleet := readFile("internal/fleet/fleet.go")
+		Expect(fleet).To(ContainSubstring(`crewv1 "sigs.k8s.io/kubebuilder/test/api/v1"`))
+		Expect(fleet).To(ContainSubstring(`crewv2 "sigs.k8s.io/kubebuilder/test/api/v2"`))
+		Expect(fleet).To(ContainSubstring("*crewv2.Captain"))
+		Expect(fleet).To(ContainSubstring("crewv1.Sailor{}"))
+	})
+})
+
+var _ = Describe("goRenamer", func() {
+	var renamer goRenamer
+
+	BeforeEach(func() {
+		from := ssaTestResource("Captain", false)
+		from.Path = "sigs.k8s.io/kubebuilder/test/api/v1"
+		to := ssaTestResourceGV("Frigate", "fleet", "v1beta1", false)
+		to.Path = "sigs.k8s.io/kubebuilder/test/api/v1beta1"
+		renamer = newGoRenamer(from, to)
+	})
+
+	It("should rewrite the package, the import and the identifiers of an owned file", func() {
+		content, err := renamer.rewriteOwned([]byte(`package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	crewv1 "sigs.k8s.io/kubebuilder/test/api/v1"
+)
+
+// CaptainReconciler reconciles a Captain object
+type CaptainReconciler struct {
+	Spec corev1.PodSpec
+	Item crewv1.Captain
+}
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("package v1beta1"))
+		Expect(string(content)).To(ContainSubstring(`fleetv1beta1 "sigs.k8s.io/kubebuilder/test/api/v1beta1"`))
+		Expect(string(content)).To(ContainSubstring("// FrigateReconciler reconciles a Frigate object"))
+		Expect(string(content)).To(ContainSubstring("Item fleetv1beta1.Frigate"))
+		Expect(string(content)).To(ContainSubstring("Spec corev1.PodSpec"))
+	})
+
+	It("should leave the files that do not reference the resource untouched", func() {
+		_, changed, err := renamer.rewriteReferences([]byte(`package fleet
+
+import crewv1 "sigs.k8s.io/kubebuilder/test/api/v1"
+
+var _ = crewv1.Sailor{}
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+})
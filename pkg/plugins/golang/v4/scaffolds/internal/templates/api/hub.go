@@ -33,6 +33,7 @@ type Hub struct {
 	machinery.MultiGroupMixin
 	machinery.BoilerplateMixin
 	machinery.ResourceMixin
+	machinery.MergeableMixin
 
 	Force bool
 }
@@ -53,7 +54,7 @@ func (f *Hub) SetTemplateDefaults() error {
 	f.TemplateBody = hubTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
@@ -31,6 +31,7 @@ type Spoke struct {
 	machinery.MultiGroupMixin
 	machinery.BoilerplateMixin
 	machinery.ResourceMixin
+	machinery.MergeableMixin
 
 	Force        bool
 	SpokeVersion string
@@ -54,7 +55,7 @@ func (f *Spoke) SetTemplateDefaults() error {
 	f.TemplateBody = spokeTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
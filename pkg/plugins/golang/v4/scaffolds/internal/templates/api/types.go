@@ -33,6 +33,7 @@ type Types struct {
 	machinery.MultiGroupMixin
 	machinery.BoilerplateMixin
 	machinery.ResourceMixin
+	machinery.MergeableMixin
 
 	Force bool
 
@@ -58,7 +59,7 @@ func (f *Types) SetTemplateDefaults() error {
 	f.TemplateBody = typesTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.Error
 	}
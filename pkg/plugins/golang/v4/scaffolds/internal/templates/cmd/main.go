@@ -54,13 +54,17 @@ func (f *Main) SetTemplateDefaults() error {
 	return nil
 }
 
-var _ machinery.Inserter = &MainUpdater{}
+var (
+	_ machinery.GoInserter                       = &MainUpdater{}
+	_ machinery.HasRetainedAnchoredCodeFragments = &MainUpdater{}
+)
 
 // MainUpdater updates cmd/main.go to run Controllers
 type MainUpdater struct {
 	machinery.RepositoryMixin
 	machinery.MultiGroupMixin
 	machinery.ResourceMixin
+	machinery.RetainedResourcesMixin
 
 	// Flags to indicate which parts need to be included when updating the file
 	WireResource, WireController, WireWebhook bool
@@ -91,13 +95,20 @@ const (
 	setupMarker     = "builder"
 )
 
-// GetMarkers implements file.Inserter
-func (f *MainUpdater) GetMarkers() []machinery.Marker {
-	return []machinery.Marker{
-		machinery.NewMarkerFor(defaultMainPath, importMarker),
-		machinery.NewMarkerFor(defaultMainPath, addSchemeMarker),
-		machinery.NewMarkerFor(defaultMainPath, setupMarker),
-	}
+// The code fragments are located with the syntax tree of cmd/main.go: the schemes are added after the ones
+// registered in init, and the controllers and webhooks are set up in main before mgr.Start. The markers of the
+// template are used when they are found there, or when the user moved the calls the anchors rely on.
+var (
+	importAnchor    = machinery.ImportsAnchor()
+	addSchemeAnchor = machinery.AfterCallAnchor("init", "utilruntime.Must").
+			WithMarker(machinery.NewMarkerFor(defaultMainPath, addSchemeMarker))
+	setupAnchor = machinery.BeforeCallAnchor("main", "mgr.Start").
+			WithMarker(machinery.NewMarkerFor(defaultMainPath, setupMarker))
+)
+
+// GetAnchors implements machinery.GoInserter
+func (f *MainUpdater) GetAnchors() []machinery.Anchor {
+	return []machinery.Anchor{importAnchor, addSchemeAnchor, setupAnchor}
 }
 
 const (
@@ -139,9 +150,9 @@ const (
 `
 )
 
-// GetCodeFragments implements file.Inserter
-func (f *MainUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
-	fragments := make(machinery.CodeFragmentsMap, 3)
+// GetAnchoredCodeFragments implements machinery.GoInserter
+func (f *MainUpdater) GetAnchoredCodeFragments() machinery.AnchoredCodeFragmentsMap {
+	fragments := make(machinery.AnchoredCodeFragmentsMap, 3)
 
 	// If resource is not being provided we are creating the file, not updating it
 	if f.Resource == nil {
@@ -205,18 +216,31 @@ func (f *MainUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 
 	// Only store code fragments in the map if the slices are non-empty
 	if len(imports) != 0 {
-		fragments[machinery.NewMarkerFor(defaultMainPath, importMarker)] = imports
+		fragments[importAnchor] = imports
 	}
 	if len(addScheme) != 0 {
-		fragments[machinery.NewMarkerFor(defaultMainPath, addSchemeMarker)] = addScheme
+		fragments[addSchemeAnchor] = addScheme
 	}
 	if len(setup) != 0 {
-		fragments[machinery.NewMarkerFor(defaultMainPath, setupMarker)] = setup
+		fragments[setupAnchor] = setup
 	}
 
 	return fragments
 }
 
+// GetRetainedAnchoredCodeFragments implements machinery.HasRetainedAnchoredCodeFragments
+func (f *MainUpdater) GetRetainedAnchoredCodeFragments() machinery.AnchoredCodeFragmentsMap {
+	return f.RetainedAnchoredCodeFragments(func(res *resource.Resource) machinery.AnchoredCodeFragmentsMap {
+		updater := *f
+		updater.Resource = res
+		updater.WireResource = res.HasAPI()
+		updater.WireController = res.HasController()
+		updater.WireWebhook = res.Webhooks != nil && !res.Webhooks.IsEmpty()
+		updater.ControllerName = ""
+		return updater.GetAnchoredCodeFragments()
+	})
+}
+
 //nolint:lll
 var mainTemplate = `{{ .Boilerplate }}
 
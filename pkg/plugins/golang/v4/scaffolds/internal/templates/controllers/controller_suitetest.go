@@ -22,11 +22,13 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
 var (
-	_ machinery.Template = &SuiteTest{}
-	_ machinery.Inserter = &SuiteTest{}
+	_ machinery.Template                 = &SuiteTest{}
+	_ machinery.Inserter                 = &SuiteTest{}
+	_ machinery.HasRetainedCodeFragments = &SuiteTest{}
 )
 
 // SuiteTest scaffolds the file that sets up the controller tests
@@ -37,6 +39,8 @@ type SuiteTest struct {
 	machinery.MultiGroupMixin
 	machinery.BoilerplateMixin
 	machinery.ResourceMixin
+	machinery.RetainedResourcesMixin
+	machinery.MergeableMixin
 
 	// CRDDirectoryRelativePath define the Path for the CRD
 	CRDDirectoryRelativePath string
@@ -70,7 +74,7 @@ func (f *SuiteTest) SetTemplateDefaults() error {
 	}
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	}
 
 	return nil
@@ -125,6 +129,15 @@ func (f *SuiteTest) GetCodeFragments() machinery.CodeFragmentsMap {
 	return fragments
 }
 
+// GetRetainedCodeFragments implements machinery.HasRetainedCodeFragments
+func (f *SuiteTest) GetRetainedCodeFragments() machinery.CodeFragmentsMap {
+	return f.RetainedCodeFragments(func(res *resource.Resource) machinery.CodeFragmentsMap {
+		suite := *f
+		suite.Resource = res
+		return suite.GetCodeFragments()
+	})
+}
+
 const controllerSuiteTestTemplate = `{{ .Boilerplate }}
 
 {{if and .MultiGroup .Resource.Group }}
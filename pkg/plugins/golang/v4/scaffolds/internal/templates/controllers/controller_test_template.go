@@ -33,6 +33,7 @@ type ControllerTest struct {
 	machinery.MultiGroupMixin
 	machinery.BoilerplateMixin
 	machinery.ResourceMixin
+	machinery.MergeableMixin
 
 	Force bool
 
@@ -55,7 +56,7 @@ func (f *ControllerTest) SetTemplateDefaults() error {
 	f.TemplateBody = controllerTestTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	}
 
 	return nil
@@ -25,11 +25,13 @@ import (
 	"strings"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
 var (
-	_ machinery.Template = &Test{}
-	_ machinery.Inserter = &WebhookTestUpdater{}
+	_ machinery.Template                 = &Test{}
+	_ machinery.Inserter                 = &WebhookTestUpdater{}
+	_ machinery.HasRetainedCodeFragments = &WebhookTestUpdater{}
 )
 
 const (
@@ -62,6 +64,7 @@ type WebhookTestUpdater struct {
 	machinery.RepositoryMixin
 	machinery.ProjectNameMixin
 	machinery.ResourceMixin
+	machinery.RetainedResourcesMixin
 	WireWebhook bool
 }
 
@@ -185,6 +188,16 @@ func (f *WebhookTestUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 	return codeFragments
 }
 
+// GetRetainedCodeFragments implements machinery.HasRetainedCodeFragments
+func (f *WebhookTestUpdater) GetRetainedCodeFragments() machinery.CodeFragmentsMap {
+	return f.RetainedCodeFragments(func(res *resource.Resource) machinery.CodeFragmentsMap {
+		updater := *f
+		updater.Resource = res
+		updater.WireWebhook = res.HasDefaultingWebhook() || res.HasValidationWebhook()
+		return updater.GetCodeFragments()
+	})
+}
+
 const webhookChecksFragment = `It("should provisioned cert-manager", func() {
 	By("validating that cert-manager has the certificate Secret")
 	verifyCertManager := func(g Gomega) {
@@ -22,11 +22,13 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
 var (
-	_ machinery.Template = &WebhookSuite{}
-	_ machinery.Inserter = &WebhookSuite{}
+	_ machinery.Template                 = &WebhookSuite{}
+	_ machinery.Inserter                 = &WebhookSuite{}
+	_ machinery.HasRetainedCodeFragments = &WebhookSuite{}
 )
 
 // WebhookSuite scaffolds the file that sets up the webhook tests
@@ -35,6 +37,7 @@ type WebhookSuite struct {
 	machinery.MultiGroupMixin
 	machinery.BoilerplateMixin
 	machinery.ResourceMixin
+	machinery.RetainedResourcesMixin
 
 	// todo: currently is not possible to know if an API was or not scaffolded. We can fix it when #1826 be addressed
 	WireResource bool
@@ -142,6 +145,15 @@ func (f *WebhookSuite) GetCodeFragments() machinery.CodeFragmentsMap {
 	return fragments
 }
 
+// GetRetainedCodeFragments implements machinery.HasRetainedCodeFragments
+func (f *WebhookSuite) GetRetainedCodeFragments() machinery.CodeFragmentsMap {
+	return f.RetainedCodeFragments(func(res *resource.Resource) machinery.CodeFragmentsMap {
+		suite := *f
+		suite.Resource = res
+		return suite.GetCodeFragments()
+	})
+}
+
 const webhookTestSuiteTemplate = `{{ .Boilerplate }}
 
 package {{ .Resource.Version }}
@@ -34,6 +34,7 @@ type WebhookTest struct {
 	machinery.BoilerplateMixin
 	machinery.ResourceMixin
 	machinery.IfNotExistsActionMixin
+	machinery.MergeableMixin
 
 	Force bool
 }
@@ -66,7 +67,7 @@ func (f *WebhookTest) SetTemplateDefaults() error {
 	f.TemplateBody = fmt.Sprintf(webhookTestTemplate, strings.Join(templates, "\n"))
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	}
 	f.IfNotExistsAction = machinery.IgnoreFile
 
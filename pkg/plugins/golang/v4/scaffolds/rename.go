@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+)
+
+// goRenamer rewrites Go source files for a resource that is renamed or moved to another group,
+// version or kind. Files are edited at the positions of the nodes that change, so the code that
+// does not reference the resource is kept as it was.
+type goRenamer struct {
+	from resource.Resource
+	to   resource.Resource
+	text util.ResourceRenamer
+
+	// declared are the top-level names declared in the Go type file of the resource, which are moved
+	// to the new API package even when they are not based on the kind.
+	declared map[string]bool
+}
+
+func newGoRenamer(from, to resource.Resource) goRenamer {
+	return goRenamer{
+		from:     from,
+		to:       to,
+		text:     util.NewResourceRenamer(from, to),
+		declared: map[string]bool{},
+	}
+}
+
+// declare records the top-level names declared in the Go type file of the resource.
+func (r goRenamer) declare(content []byte) error {
+	file, err := parser.ParseFile(token.NewFileSet(), "", content, parser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("error parsing Go file: %w", err)
+	}
+
+	for name := range declarations(file) {
+		r.declared[name] = true
+	}
+	return nil
+}
+
+// declarations returns the top-level names declared in a Go file.
+func declarations(file *ast.File) map[string]bool {
+	names := make(map[string]bool)
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				names[decl.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names[spec.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// rewriteOwned rewrites a file scaffolded for the resource: the package clause when it is named after
+// the group or version, the import of the API, the identifiers based on the kind, comments and strings.
+// Selectors of other packages are kept, so corev1.PodSpec is not renamed for a Pod kind.
+func (r goRenamer) rewriteOwned(content []byte) ([]byte, error) {
+	f, err := machinery.ParseGoFile("", content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Go file: %w", err)
+	}
+	file, offset := f.File, f.Offset
+
+	var edits []machinery.GoEdit
+	switch file.Name.Name {
+	case r.from.Version:
+		edits = append(edits, machinery.GoEdit{
+			Start: offset(file.Name.Pos()),
+			End:   offset(file.Name.End()),
+			Text:  r.to.Version,
+		})
+	case r.from.PackageName():
+		edits = append(edits, machinery.GoEdit{
+			Start: offset(file.Name.Pos()),
+			End:   offset(file.Name.End()),
+			Text:  r.to.PackageName(),
+		})
+	}
+
+	fromName, toName, imported := r.importNames(file)
+	packages := map[string]bool{}
+	for _, spec := range file.Imports {
+		if spec.Name != nil {
+			packages[spec.Name.Name] = true
+		} else if importPath, unquoteErr := strconv.Unquote(spec.Path.Value); unquoteErr == nil {
+			packages[path.Base(importPath)] = true
+		}
+	}
+	delete(packages, fromName)
+
+	foreign := map[*ast.Ident]bool{file.Name: true}
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := node.X.(*ast.Ident); ok && packages[x.Name] {
+				foreign[node.Sel] = true
+			}
+		case *ast.ImportSpec:
+			// Imports are handled as a whole, so the aliases of other packages are not renamed
+			if imported && node.Path.Value == strconv.Quote(r.from.Path) {
+				edits = append(edits, machinery.GoEdit{
+					Start: offset(node.Path.Pos()),
+					End:   offset(node.Path.End()),
+					Text:  strconv.Quote(r.to.Path),
+				})
+				if node.Name != nil {
+					edits = append(edits, machinery.GoEdit{
+						Start: offset(node.Name.Pos()),
+						End:   offset(node.Name.End()),
+						Text:  toName,
+					})
+				}
+			}
+			return false
+		case *ast.BasicLit:
+			if node.Kind == token.STRING {
+				if value := r.text.Text(node.Value); value != node.Value {
+					edits = append(edits, machinery.GoEdit{
+						Start: offset(node.Pos()),
+						End:   offset(node.End()),
+						Text:  value,
+					})
+				}
+			}
+		case *ast.Ident:
+			if foreign[node] {
+				return true
+			}
+			name := node.Name
+			if imported && name == fromName {
+				name = toName
+			} else {
+				name = r.identifier(name)
+			}
+			if name != node.Name {
+				edits = append(edits, machinery.GoEdit{Start: offset(node.Pos()), End: offset(node.End()), Text: name})
+			}
+		}
+		return true
+	})
+
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if text := r.text.Text(comment.Text); text != comment.Text {
+				edits = append(edits, machinery.GoEdit{
+					Start: offset(comment.Pos()),
+					End:   offset(comment.End()),
+					Text:  text,
+				})
+			}
+		}
+	}
+
+	rewritten, err := f.Edit(edits)
+	if err != nil {
+		return nil, fmt.Errorf("error rewriting Go file: %w", err)
+	}
+	return rewritten, nil
+}
+
+// rewriteReferences rewrites the references to the types of the resource in a Go file that imports
+// its API package. The import is moved to the new package when nothing else is used from the old one,
+// and added otherwise. It returns false if the file does not reference the resource.
+func (r goRenamer) rewriteReferences(content []byte) ([]byte, bool, error) {
+	f, err := machinery.ParseGoFile("", content)
+	if err != nil {
+		return nil, false, fmt.Errorf("error parsing Go file: %w", err)
+	}
+	file, offset := f.File, f.Offset
+
+	fromName, toName, imported := r.importNames(file)
+	if !imported {
+		return content, false, nil
+	}
+
+	var edits []machinery.GoEdit
+	keepImport := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		selector, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		x, ok := selector.X.(*ast.Ident)
+		if !ok || x.Name != fromName {
+			return true
+		}
+		name := r.identifier(selector.Sel.Name)
+		if name == selector.Sel.Name && !r.declared[name] {
+			keepImport = true
+			return true
+		}
+		edits = append(edits,
+			machinery.GoEdit{Start: offset(x.Pos()), End: offset(x.End()), Text: toName},
+			machinery.GoEdit{Start: offset(selector.Sel.Pos()), End: offset(selector.Sel.End()), Text: name},
+		)
+		return true
+	})
+	if len(edits) == 0 {
+		return content, false, nil
+	}
+
+	if r.from.Path != r.to.Path {
+		spec := file.Imports[slices.IndexFunc(file.Imports, func(spec *ast.ImportSpec) bool {
+			return spec.Path.Value == strconv.Quote(r.from.Path)
+		})]
+		newImport := fmt.Sprintf("%s %s", toName, strconv.Quote(r.to.Path))
+		if keepImport {
+			edits = append(edits, machinery.GoEdit{
+				Start: offset(spec.End()),
+				End:   offset(spec.End()),
+				Text:  "\n" + newImport,
+			})
+		} else {
+			edits = append(edits, machinery.GoEdit{Start: offset(spec.Pos()), End: offset(spec.End()), Text: newImport})
+		}
+	}
+
+	rewritten, err := f.Edit(edits)
+	if err != nil {
+		return nil, false, fmt.Errorf("error rewriting Go file: %w", err)
+	}
+	return rewritten, true, nil
+}
+
+// importNames returns the names under which the API package of the resource is imported by the file
+// and will be imported after the rename, and whether the file imports it at all.
+func (r goRenamer) importNames(file *ast.File) (string, string, bool) {
+	for _, spec := range file.Imports {
+		if spec.Path.Value != strconv.Quote(r.from.Path) {
+			continue
+		}
+		if spec.Name == nil {
+			return path.Base(r.from.Path), path.Base(r.to.Path), true
+		}
+		if spec.Name.Name == r.from.ImportAlias() {
+			return spec.Name.Name, r.to.ImportAlias(), true
+		}
+		return spec.Name.Name, spec.Name.Name, true
+	}
+	return "", "", false
+}
+
+// identifier renames an identifier based on the kind, such as CaptainReconciler or captainlog.
+func (r goRenamer) identifier(name string) string {
+	if name == strings.ToLower(r.from.Kind)+"log" {
+		return strings.ToLower(r.to.Kind) + "log"
+	}
+	return r.text.Text(name)
+}
@@ -170,13 +170,14 @@ You need to implement the conversion.Hub and conversion.Convertible interfaces f
 		}
 	}
 
-	if hasInternalController, err := pluginutil.HasFileContentWith("Dockerfile", "internal/controller"); err != nil {
+	editor := pluginutil.NewFileEditor(s.fs)
+	if hasInternalController, err := editor.HasFileContentWith("Dockerfile", "internal/controller"); err != nil {
 		log.Error("failed to read Dockerfile to check if webhook(s) will be properly copied", "error", err)
 	} else if hasInternalController {
 		log.Warn("Dockerfile is copying internal/controller; to allow copying webhooks, " +
 			"it will be edited, and `internal/controller` will be replaced by `internal/`")
 
-		if err = pluginutil.ReplaceInFile("Dockerfile", "internal/controller", "internal/"); err != nil {
+		if err = editor.ReplaceInFile("Dockerfile", "internal/controller", "internal/"); err != nil {
 			log.Error("failed to replace \"internal/controller\" with \"internal/\" in the Dockerfile", "error", err)
 		}
 	}
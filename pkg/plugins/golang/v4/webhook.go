@@ -122,7 +122,7 @@ func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
 		"External API module with optional version (e.g., github.com/cert-manager/cert-manager@v1.18.2)")
 
 	fs.BoolVar(&p.force, "force", false,
-		"If set, attempt to create resource even if it already exists")
+		"If set, attempt to create resource even if it already exists, merging manual edits of its files")
 }
 
 func (p *createWebhookSubcommand) InjectConfig(c config.Config) error {
@@ -236,7 +236,10 @@ func (p *createWebhookSubcommand) PostScaffold() error {
 		}
 	}
 
-	fmt.Print("Next: implement your new Webhook and generate the manifests with:\n$ make manifests\n")
+	pluginutil.PrintNextSteps(pluginutil.NextStep{
+		Description: "implement your new Webhook and generate the manifests",
+		Command:     "make manifests",
+	})
 
 	return nil
 }
@@ -18,6 +18,8 @@ package v1alpha
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/spf13/pflag"
 
@@ -31,6 +33,9 @@ var _ plugin.EditSubcommand = &editSubcommand{}
 
 type editSubcommand struct {
 	config config.Config
+
+	// forge is the service that hosts the repository and runs the automation
+	forge string
 }
 
 func (p *editSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
@@ -38,10 +43,18 @@ func (p *editSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *
 
 	subcmdMeta.Examples = fmt.Sprintf(`  # Edit a common project with this plugin
   %[1]s edit --plugins=%[2]s
+
+  # Scaffold a GitLab CI/CD pipeline instead of a GitHub Actions workflow
+  %[1]s edit --plugins=%[2]s --forge gitlab
 `, cliMeta.CommandName, plugin.KeyFor(Plugin{}))
 }
 
-func (p *editSubcommand) BindFlags(_ *pflag.FlagSet) {}
+func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.forge, "forge", "",
+		"Forge that hosts the repository and runs the automation: github (GitHub Actions), "+
+			"gitlab (GitLab CI/CD) or gitea (Gitea Actions). "+
+			"Defaults to the forge stored in the PROJECT file, or github if unset")
+}
 
 func (p *editSubcommand) InjectConfig(c config.Config) error {
 	p.config = c
@@ -49,6 +62,10 @@ func (p *editSubcommand) InjectConfig(c config.Config) error {
 }
 
 func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
+	if p.forge != "" && !slices.Contains(scaffolds.Forges(), p.forge) {
+		return fmt.Errorf("unsupported forge %q: supported forges are %s",
+			p.forge, strings.Join(scaffolds.Forges(), ", "))
+	}
 	if len(p.config.GetCliVersion()) == 0 {
 		return fmt.Errorf(
 			"you must manually upgrade your project to a version that records the CLI version in PROJECT (`cliVersion`) " +
@@ -60,11 +77,16 @@ func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
 }
 
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
-	if err := insertPluginMetaToConfig(p.config, PluginConfig{}); err != nil {
+	cfg, err := insertPluginMetaToConfig(p.config, PluginConfig{Forge: p.forge})
+	if err != nil {
 		return fmt.Errorf("error inserting project plugin meta to configuration: %w", err)
 	}
 
-	scaffolder := scaffolds.NewInitScaffolder()
+	forge := cfg.Forge
+	if forge == "" {
+		forge = scaffolds.ForgeGitHub
+	}
+	scaffolder := scaffolds.NewInitScaffolder(forge)
 	scaffolder.InjectFS(fs)
 	if err := scaffolder.Scaffold(); err != nil {
 		return fmt.Errorf("error scaffolding edit subcommand: %w", err)
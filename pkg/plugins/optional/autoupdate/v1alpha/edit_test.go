@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
 
 var _ = Describe("editSubcommand", func() {
@@ -55,4 +56,71 @@ var _ = Describe("editSubcommand", func() {
 
 		Expect(err).NotTo(HaveOccurred())
 	})
+
+	It("should reject an unsupported forge", func() {
+		Expect(cfg.SetCliVersion("v4.0.0")).To(Succeed())
+		subCmd.forge = "bitbucket"
+
+		err := subCmd.PreScaffold(fs)
+
+		Expect(err).To(MatchError(ContainSubstring(`unsupported forge "bitbucket"`)))
+	})
+
+	Context("Scaffold", func() {
+		It("should scaffold the GitHub Actions workflow by default", func() {
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			Expect(afero.Exists(fs.FS, ".github/workflows/auto_update.yml")).To(BeTrue())
+			Expect(afero.Exists(fs.FS, ".gitlab-ci.yml")).To(BeFalse())
+
+			stored := PluginConfig{}
+			Expect(cfg.DecodePluginConfig(plugin.KeyFor(Plugin{}), &stored)).To(Succeed())
+			Expect(stored.Forge).To(BeEmpty())
+		})
+
+		It("should scaffold the GitLab CI/CD pipeline and store the forge", func() {
+			subCmd.forge = "gitlab"
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			pipeline, err := afero.ReadFile(fs.FS, ".gitlab/ci/auto_update.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(pipeline)).To(ContainSubstring("--forge gitlab"))
+			Expect(string(pipeline)).To(ContainSubstring(`$CI_PIPELINE_SOURCE == "schedule"`))
+			ciConfig, err := afero.ReadFile(fs.FS, ".gitlab-ci.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(ciConfig)).To(ContainSubstring("- local: .gitlab/ci/auto_update.yml"))
+			Expect(afero.Exists(fs.FS, ".github/workflows/auto_update.yml")).To(BeFalse())
+
+			stored := PluginConfig{}
+			Expect(cfg.DecodePluginConfig(plugin.KeyFor(Plugin{}), &stored)).To(Succeed())
+			Expect(stored.Forge).To(Equal("gitlab"))
+		})
+
+		It("should keep an existing GitLab CI/CD configuration", func() {
+			const existing = "stages:\n  - test\n"
+			Expect(afero.WriteFile(fs.FS, ".gitlab-ci.yml", []byte(existing), 0o644)).To(Succeed())
+			subCmd.forge = "gitlab"
+
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			ciConfig, err := afero.ReadFile(fs.FS, ".gitlab-ci.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(ciConfig)).To(Equal(existing))
+			Expect(afero.Exists(fs.FS, ".gitlab/ci/auto_update.yml")).To(BeTrue())
+		})
+
+		It("should reuse the stored forge when the flag is not set", func() {
+			subCmd.forge = "gitea"
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			subCmd.forge = ""
+			Expect(fs.FS.Remove(".gitea/workflows/auto_update.yml")).To(Succeed())
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			workflow, err := afero.ReadFile(fs.FS, ".gitea/workflows/auto_update.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(workflow)).To(ContainSubstring("--forge gitea"))
+			Expect(string(workflow)).To(ContainSubstring("GITEA_TOKEN: ${{ secrets.GITEA_TOKEN }}"))
+		})
+	})
 })
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/autoupdate/v1alpha/scaffolds"
 )
 
 //nolint:lll
@@ -39,7 +40,11 @@ Under the hood, the workflow runs 'kubebuilder alpha update' using a **3-way mer
 3) **Permissions required** (via the built-in 'GITHUB_TOKEN'):
    - **contents: write** — needed to create and push the update branch.
    - **issues: write** — needed to create the tracking Issue with the PR link.
-4) **Protect your branches**: Enable **branch protection rules** so automated changes **cannot** be pushed directly. All updates must go through a Pull Request for review.`
+4) **Protect your branches**: Enable **branch protection rules** so automated changes **cannot** be pushed directly. All updates must go through a Pull Request for review.
+
+### GitLab and Gitea
+
+Use '--forge gitlab' to scaffold a scheduled GitLab CI/CD pipeline in '.gitlab/ci/auto_update.yml', included from '.gitlab-ci.yml', or '--forge gitea' to scaffold a Gitea Actions workflow in '.gitea/workflows/auto_update.yml'. The forge is stored in the PROJECT file. The GitLab pipeline needs a project access token in the 'KUBEBUILDER_UPDATE_TOKEN' CI/CD variable and a pipeline schedule; see the comments in the scaffolded file.`
 
 const pluginName = "autoupdate." + plugins.DefaultNameQualifier
 
@@ -56,7 +61,11 @@ type Plugin struct {
 var _ plugin.Edit = Plugin{}
 
 // PluginConfig defines the structure that will be used to track the data
-type PluginConfig struct{}
+type PluginConfig struct {
+	// Forge is the service that hosts the repository and runs the automation. It is omitted for
+	// GitHub, the default.
+	Forge string `json:"forge,omitempty"`
+}
 
 // Name returns the name of the plugin
 func (Plugin) Name() string { return pluginName }
@@ -72,7 +81,7 @@ func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcom
 
 // Description returns a short description of the plugin
 func (Plugin) Description() string {
-	return "Proposes Kubebuilder scaffold updates via GitHub Actions"
+	return "Proposes Kubebuilder scaffold updates via GitHub Actions, GitLab CI/CD or Gitea Actions"
 }
 
 // DeprecationWarning define the deprecation message or return empty when plugin is not deprecated
@@ -80,34 +89,44 @@ func (p Plugin) DeprecationWarning() string {
 	return ""
 }
 
-// insertPluginMetaToConfig will insert the metadata to the plugin configuration
-func insertPluginMetaToConfig(target config.Config, cfg PluginConfig) error {
+// insertPluginMetaToConfig will insert the metadata to the plugin configuration. The fields that are not
+// set in cfg keep the value stored in the configuration, and the resulting metadata is returned.
+func insertPluginMetaToConfig(target config.Config, cfg PluginConfig) (PluginConfig, error) {
 	key := plugin.GetPluginKeyForConfig(target.GetPluginChain(), Plugin{})
 	canonicalKey := plugin.KeyFor(Plugin{})
 
-	if err := target.DecodePluginConfig(key, &cfg); err != nil {
+	stored := PluginConfig{}
+	if err := target.DecodePluginConfig(key, &stored); err != nil {
 		switch {
 		case errors.As(err, &config.UnsupportedFieldError{}):
-			return nil
+			return cfg, nil
 		case errors.As(err, &config.PluginKeyNotFoundError{}):
 			if key != canonicalKey {
-				if err2 := target.DecodePluginConfig(canonicalKey, &cfg); err2 != nil {
+				if err2 := target.DecodePluginConfig(canonicalKey, &stored); err2 != nil {
 					if errors.As(err2, &config.UnsupportedFieldError{}) {
-						return nil
+						return cfg, nil
 					}
 					if !errors.As(err2, &config.PluginKeyNotFoundError{}) {
-						return fmt.Errorf("error decoding plugin configuration: %w", err2)
+						return cfg, fmt.Errorf("error decoding plugin configuration: %w", err2)
 					}
 				}
 			}
 		default:
-			return fmt.Errorf("error decoding plugin configuration: %w", err)
+			return cfg, fmt.Errorf("error decoding plugin configuration: %w", err)
 		}
 	}
 
+	if cfg.Forge == "" {
+		cfg.Forge = stored.Forge
+	}
+	// GitHub is the default, so it is not stored
+	if cfg.Forge == scaffolds.ForgeGitHub {
+		cfg.Forge = ""
+	}
+
 	if err := target.EncodePluginConfig(key, cfg); err != nil {
-		return fmt.Errorf("error encoding plugin configuration: %w", err)
+		return cfg, fmt.Errorf("error encoding plugin configuration: %w", err)
 	}
 
-	return nil
+	return cfg, nil
 }
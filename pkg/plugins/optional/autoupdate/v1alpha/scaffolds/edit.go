@@ -19,25 +19,47 @@ package scaffolds
 import (
 	"fmt"
 	log "log/slog"
+	"strings"
+
+	"github.com/spf13/afero"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/autoupdate/v1alpha/scaffolds/internal/gitea"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/autoupdate/v1alpha/scaffolds/internal/github"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/autoupdate/v1alpha/scaffolds/internal/gitlab"
+)
+
+const (
+	// ForgeGitHub scaffolds a GitHub Actions workflow
+	ForgeGitHub = "github"
+	// ForgeGitLab scaffolds a GitLab CI/CD pipeline
+	ForgeGitLab = "gitlab"
+	// ForgeGitea scaffolds a Gitea Actions workflow
+	ForgeGitea = "gitea"
 )
 
+// Forges returns the forges that the automation can be scaffolded for
+func Forges() []string {
+	return []string{ForgeGitHub, ForgeGitLab, ForgeGitea}
+}
+
 var _ plugins.Scaffolder = &editScaffolder{}
 
 type editScaffolder struct {
 	config config.Config
 
+	// forge is the service that hosts the repository and runs the automation
+	forge string
+
 	// fs is the filesystem that will be used by the scaffolder
 	fs machinery.Filesystem
 }
 
 // NewInitScaffolder returns a new Scaffolder for project initialization operations
-func NewInitScaffolder() plugins.Scaffolder {
-	return &editScaffolder{}
+func NewInitScaffolder(forge string) plugins.Scaffolder {
+	return &editScaffolder{forge: forge}
 }
 
 // InjectFS implements cmdutil.Scaffolder
@@ -53,12 +75,34 @@ func (s *editScaffolder) Scaffold() error {
 		machinery.WithConfig(s.config),
 	)
 
-	err := scaffold.Execute(
-		&github.AutoUpdate{},
-	)
+	var templates []machinery.Builder
+	switch s.forge {
+	case ForgeGitLab:
+		s.warnMissingGitLabInclude()
+		templates = []machinery.Builder{&gitlab.AutoUpdate{}, &gitlab.CIConfig{}}
+	case ForgeGitea:
+		templates = []machinery.Builder{&gitea.AutoUpdate{}}
+	default:
+		templates = []machinery.Builder{&github.AutoUpdate{}}
+	}
+
+	err := scaffold.Execute(templates...)
 	if err != nil {
 		return fmt.Errorf("failed to execute init scaffold: %w", err)
 	}
 
 	return nil
 }
+
+// warnMissingGitLabInclude warns when the project already has a GitLab CI/CD configuration, which is
+// not overwritten, that does not include the auto update pipeline.
+func (s *editScaffolder) warnMissingGitLabInclude() {
+	content, err := afero.ReadFile(s.fs.FS, gitlab.CIConfigPath)
+	if err != nil {
+		return
+	}
+	if !strings.Contains(string(content), gitlab.AutoUpdatePath) {
+		log.Warn("The GitLab CI/CD configuration already exists and is not overwritten; "+
+			"add the auto update pipeline to its includes", "file", gitlab.CIConfigPath, "include", gitlab.AutoUpdatePath)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &AutoUpdate{}
+
+// AutoUpdate scaffolds the Gitea Actions workflow to propose scaffold updates
+type AutoUpdate struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *AutoUpdate) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(".gitea", "workflows", "auto_update.yml")
+	}
+
+	f.TemplateBody = autoUpdateTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const autoUpdateTemplate = `name: Auto Update
+
+# The 'kubebuilder alpha update' command requires write access to the repository to create a branch
+# with the update files and allow you to open a pull request using the link provided in the issue.
+# The branch created will be named in the format kubebuilder-update-from-<from-version>-to-<to-version> by default.
+# The automatic token of Gitea Actions must be allowed to write the repository contents and issues.
+# To protect your codebase, please ensure that you have branch protection rules configured for your
+# main branches. This will guarantee that no one can bypass a review and push directly to a branch like 'main'.
+
+on:
+  workflow_dispatch:
+  schedule:
+    - cron: "0 0 * * 2" # Every Tuesday at 00:00 UTC
+
+jobs:
+  auto-update:
+    runs-on: ubuntu-latest
+    env:
+      GITEA_TOKEN: {{ "${{ secrets.GITEA_TOKEN }}" }}
+      GITEA_SERVER_URL: {{ "${{ gitea.server_url }}" }}
+      GITEA_REPOSITORY: {{ "${{ gitea.repository }}" }}
+
+    # Checkout the repository.
+    steps:
+    - name: Checkout repository
+      uses: actions/checkout@de0fac2e4500dabe0009e67214ff5f5447ce83dd # v6.0.2
+      with:
+        token: {{ "${{ secrets.GITEA_TOKEN }}" }}
+        fetch-depth: 0
+
+    # Configure Git to create commits with the Gitea Actions bot.
+    - name: Configure Git
+      run: |
+        git config --global user.name "gitea-actions[bot]"
+        git config --global user.email "gitea-actions[bot]@noreply.localhost"
+
+    # Set up Go environment.
+    - name: Set up Go
+      uses: actions/setup-go@4b73464bb391d4059bd26b0524d20df3927bd417 # v6.3.0
+      with:
+        go-version: stable
+
+    # Install Kubebuilder outside of the repository, since the runner may not provide sudo.
+    - name: Install Kubebuilder
+      run: |
+        mkdir -p "$HOME/.local/bin"
+        curl -L -o "$HOME/.local/bin/kubebuilder" "https://go.kubebuilder.io/dl/latest/$(go env GOOS)/$(go env GOARCH)"
+        chmod +x "$HOME/.local/bin/kubebuilder"
+        echo "$HOME/.local/bin" >> "$GITHUB_PATH"
+        "$HOME/.local/bin/kubebuilder" version
+
+    # Run the Kubebuilder alpha update command.
+    # More info: https://kubebuilder.io/reference/commands/alpha_update
+    - name: Run kubebuilder alpha update
+      # Executes the update command with specified flags.
+      # --force: Completes the merge even if conflicts occur, leaving conflict markers.
+      # --push: Automatically pushes the resulting output branch to the 'origin' remote.
+      # --restore-path: Preserves specified paths (e.g., CI workflow files) when squashing.
+      # --forge gitea --open-issue: Creates a Gitea Issue with a link for opening a PR for review.
+      run: |
+        kubebuilder alpha update \
+          --force \
+          --push \
+          --restore-path .gitea/workflows \
+          --forge gitea \
+          --open-issue
+`
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// AutoUpdatePath is the path of the GitLab CI/CD pipeline that runs the update
+const AutoUpdatePath = ".gitlab/ci/auto_update.yml"
+
+var _ machinery.Template = &AutoUpdate{}
+
+// AutoUpdate scaffolds the GitLab CI/CD pipeline to propose scaffold updates
+type AutoUpdate struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *AutoUpdate) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = AutoUpdatePath
+	}
+
+	f.TemplateBody = autoUpdateTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+//nolint:lll
+const autoUpdateTemplate = `# Auto Update
+#
+# The 'kubebuilder alpha update' command requires write access to the repository to create a branch
+# with the update files and allow you to open a merge request using the link provided in the issue.
+# The branch created will be named in the format kubebuilder-update-from-<from-version>-to-<to-version> by default.
+# To protect your codebase, please ensure that you have protected branches configured for your
+# main branches. This will guarantee that no one can bypass a review and push directly to a branch like 'main'.
+#
+# Setup:
+#   1. Create a project access token with the Developer role and the 'api' and 'write_repository' scopes
+#      (Settings > Access tokens), and store it in the masked CI/CD variable KUBEBUILDER_UPDATE_TOKEN
+#      (Settings > CI/CD > Variables).
+#   2. Create a pipeline schedule for the default branch (Build > Pipeline schedules), e.g. every Tuesday
+#      at 00:00 UTC ("0 0 * * 2"), with the variable KUBEBUILDER_AUTO_UPDATE set to "true".
+kubebuilder-auto-update:
+  image: golang:latest
+  rules:
+    - if: $CI_PIPELINE_SOURCE == "schedule" && $KUBEBUILDER_AUTO_UPDATE == "true"
+  variables:
+    GIT_DEPTH: "0"
+    GITLAB_TOKEN: $KUBEBUILDER_UPDATE_TOKEN
+  before_script:
+    # Configure Git to create commits with the automation identity.
+    - git config --global user.name "kubebuilder-auto-update"
+    - git config --global user.email "kubebuilder-auto-update@noreply.${CI_SERVER_HOST}"
+    # The CI/CD job token cannot push, so push with the project access token.
+    - git remote set-url origin "${CI_SERVER_PROTOCOL}://oauth2:${GITLAB_TOKEN}@${CI_SERVER_HOST}:${CI_SERVER_PORT}/${CI_PROJECT_PATH}.git"
+    # GitLab checks out a detached HEAD, so create the base branch locally.
+    - git checkout -B "$CI_DEFAULT_BRANCH" "origin/$CI_DEFAULT_BRANCH"
+    # Install Kubebuilder.
+    - curl -L -o kubebuilder "https://go.kubebuilder.io/dl/latest/$(go env GOOS)/$(go env GOARCH)"
+    - chmod +x kubebuilder
+    - mv kubebuilder /usr/local/bin/
+    - kubebuilder version
+  # Run the Kubebuilder alpha update command.
+  # More info: https://kubebuilder.io/reference/commands/alpha_update
+  # Executes the update command with specified flags.
+  # --force: Completes the merge even if conflicts occur, leaving conflict markers.
+  # --push: Automatically pushes the resulting output branch to the 'origin' remote.
+  # --from-branch: Uses the default branch of the project as the base branch.
+  # --restore-path: Preserves specified paths (e.g., CI configuration files) when squashing.
+  # --forge gitlab --open-issue: Creates a GitLab Issue with a link for opening a merge request for review.
+  script:
+    - >-
+      kubebuilder alpha update
+      --force
+      --push
+      --from-branch "$CI_DEFAULT_BRANCH"
+      --restore-path .gitlab-ci.yml
+      --restore-path .gitlab
+      --forge gitlab
+      --open-issue
+`
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// CIConfigPath is the path of the GitLab CI/CD configuration of the project
+const CIConfigPath = ".gitlab-ci.yml"
+
+var _ machinery.Template = &CIConfig{}
+
+// CIConfig scaffolds the GitLab CI/CD configuration that includes the auto update pipeline. An existing
+// configuration is kept as is.
+type CIConfig struct {
+	machinery.TemplateMixin
+
+	// AutoUpdatePath is the path of the included auto update pipeline
+	AutoUpdatePath string
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *CIConfig) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = CIConfigPath
+	}
+	if f.AutoUpdatePath == "" {
+		f.AutoUpdatePath = AutoUpdatePath
+	}
+
+	f.TemplateBody = ciConfigTemplate
+	f.IfExistsAction = machinery.SkipFile
+
+	return nil
+}
+
+const ciConfigTemplate = `# GitLab CI/CD configuration.
+# More info: https://docs.gitlab.com/ci/yaml/
+include:
+  # Proposes Kubebuilder scaffold updates from a pipeline schedule.
+  - local: {{ .AutoUpdatePath }}
+`
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds"
+)
+
+var _ plugin.CreateAPISubcommand = &createAPISubcommand{}
+
+// createAPISubcommand updates the per-resource dashboard with the controller of the new API. It runs after
+// the plugin chain, which adds the resource to the project configuration.
+type createAPISubcommand struct {
+	config config.Config
+}
+
+func (p *createAPISubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+// InjectResource does not keep the resource: the dashboard is generated from the project configuration,
+// which holds the resource once the plugin chain scaffolded it.
+func (p *createAPISubcommand) InjectResource(*resource.Resource) error {
+	return nil
+}
+
+func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
+	return scaffoldResourceDashboard(p.config, fs)
+}
+
+// scaffoldResourceDashboard regenerates the per-resource dashboard with the resources of the project
+func scaffoldResourceDashboard(cfg config.Config, fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewResourceDashboardScaffolder(cfg)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("error updating the Grafana per-resource dashboard: %w", err)
+	}
+	return nil
+}
@@ -20,6 +20,15 @@ package v1alpha
 const metaDataDescription = `This command will add Grafana manifests to the project:
   - A JSON file includes dashboard manifest that can be directly copied to Grafana Web UI.
 	('grafana/controller-runtime-metrics.json')
+  - A JSON file with a dashboard for each resource of the project, which is updated by 'create api' and 'create webhook'.
+	('grafana/controller-per-resource-metrics.json')
+  - A PrometheusRule with alerting and recording rules, and the same alerts as Grafana alert rules.
+	('config/prometheus/rules.yaml', 'grafana/alerts/alert-rules.yaml')
+  - A YAML file to configure the thresholds of the alerts.
+	('grafana/alerts/config.yaml')
+  - A JSON file with a panel for each custom metric, listed in 'grafana/custom-metrics/config.yaml' or declared
+    in the Go code under 'internal', 'cmd' and 'pkg' with the Prometheus client.
+	('grafana/custom-metrics/custom-metrics-dashboard.json')
 
 NOTE: This plugin requires:
 - Access to Prometheus
@@ -49,7 +49,7 @@ func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
 		return fmt.Errorf("error inserting project plugin meta to configuration: %w", err)
 	}
 
-	scaffolder := scaffolds.NewEditScaffolder()
+	scaffolder := scaffolds.NewEditScaffolder(p.config)
 	scaffolder.InjectFS(fs)
 	if err := scaffolder.Scaffold(); err != nil {
 		return fmt.Errorf("error scaffolding edit subcommand: %w", err)
@@ -34,9 +34,16 @@ var (
 // Plugin implements the plugin.Full interface
 type Plugin struct {
 	editSubcommand
+	createAPISubcommand
+	createWebhookSubcommand
 }
 
-var _ plugin.Edit = Plugin{}
+var (
+	_ plugin.Edit          = Plugin{}
+	_ plugin.CreateAPI     = Plugin{}
+	_ plugin.CreateWebhook = Plugin{}
+	_ plugin.Attached      = Plugin{}
+)
 
 // Name returns the name of the plugin
 func (Plugin) Name() string { return pluginName }
@@ -50,6 +57,21 @@ func (Plugin) SupportedProjectVersions() []config.Version { return supportedProj
 // GetEditSubcommand will return the subcommand which is responsible for adding grafana manifests
 func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcommand }
 
+// GetCreateAPISubcommand will return the subcommand which is responsible for updating the per-resource dashboard
+func (p Plugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand { return &p.createAPISubcommand }
+
+// GetCreateWebhookSubcommand will return the subcommand which is responsible for updating the per-resource
+// dashboard
+func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
+	return &p.createWebhookSubcommand
+}
+
+// RunsAttached returns true for the subcommands that add resources, so that the per-resource dashboard
+// of the projects that use this plugin is updated when they create APIs and webhooks
+func (Plugin) RunsAttached(name string) bool {
+	return name == plugin.CreateAPISubcommandName || name == plugin.CreateWebhookSubcommandName
+}
+
 type pluginConfig struct{}
 
 // Description returns a short description of the plugin
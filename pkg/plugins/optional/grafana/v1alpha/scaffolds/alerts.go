@@ -0,0 +1,324 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	log "log/slog"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds/internal/templates"
+)
+
+const (
+	alertsConfigFilePath        = "grafana/alerts/config.yaml"
+	prometheusKustomizationPath = "config/prometheus/kustomization.yaml"
+	defaultDatasourceUID        = "prometheus"
+)
+
+// promDurationRegex matches the durations accepted by Prometheus, e.g. 90s, 15m or 1h30m
+var promDurationRegex = regexp.MustCompile(`^([0-9]+(ms|s|m|h|d|w|y))+$`)
+
+// alertDefinition is an alerting rule scaffolded by the plugin, along with its default configuration
+type alertDefinition struct {
+	templates.AlertRuleItem
+
+	// expr builds the Prometheus rule expression on the recording rules, given the threshold
+	expr func(threshold string) string
+	// query builds the Grafana query on the raw metrics, given the label selector
+	query       func(selector string) string
+	summary     string
+	description string
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+// alertDefinitions returns the alerting rules scaffolded by the plugin, with their default configuration
+func alertDefinitions() []alertDefinition {
+	return []alertDefinition{
+		{
+			AlertRuleItem: templates.AlertRuleItem{
+				Alert: "ReconcileErrorRatioHigh", Threshold: float64Ptr(0.1), For: "15m", Severity: "warning",
+			},
+			expr: func(threshold string) string {
+				return "controller:controller_runtime_reconcile_errors:ratio_rate5m > " + threshold
+			},
+			query: func(selector string) string {
+				return fmt.Sprintf("sum by (controller) (rate(controller_runtime_reconcile_errors_total{%[1]s}[5m])) / "+
+					"sum by (controller) (rate(controller_runtime_reconcile_total{%[1]s}[5m]))", selector)
+			},
+			summary: "Controller {{ $labels.controller }} fails to reconcile",
+			description: "{{ $value | humanizePercentage }} of the reconciliations of the controller " +
+				"{{ $labels.controller }} ended with an error over the last 5 minutes.",
+		},
+		{
+			AlertRuleItem: templates.AlertRuleItem{
+				Alert: "ReconcileLatencyHigh", Threshold: float64Ptr(5), For: "15m", Severity: "warning",
+			},
+			expr: func(threshold string) string {
+				return "controller:controller_runtime_reconcile_time_seconds:p99_rate5m > " + threshold
+			},
+			query: func(selector string) string {
+				return fmt.Sprintf("histogram_quantile(0.99, sum by (controller, le) "+
+					"(rate(controller_runtime_reconcile_time_seconds_bucket{%s}[5m])))", selector)
+			},
+			summary: "Controller {{ $labels.controller }} reconciles slowly",
+			description: "The P99 reconciliation latency of the controller {{ $labels.controller }} " +
+				"is {{ $value | humanizeDuration }}.",
+		},
+		{
+			AlertRuleItem: templates.AlertRuleItem{
+				Alert: "WorkqueueDepthGrowing", Threshold: float64Ptr(100), For: "15m", Severity: "warning",
+			},
+			expr: func(threshold string) string {
+				return "name:workqueue_depth:sum > " + threshold + " and deriv(name:workqueue_depth:sum[15m]) > 0"
+			},
+			query: func(selector string) string {
+				return fmt.Sprintf("sum by (name) (workqueue_depth{%[1]s}) and "+
+					"deriv(sum by (name) (workqueue_depth{%[1]s})[15m:1m]) > 0", selector)
+			},
+			summary:     "Workqueue {{ $labels.name }} keeps growing",
+			description: "The workqueue {{ $labels.name }} holds {{ $value }} items and its depth keeps growing.",
+		},
+		{
+			AlertRuleItem: templates.AlertRuleItem{
+				Alert: "WebhookErrorRatioHigh", Threshold: float64Ptr(0.05), For: "10m", Severity: "critical",
+			},
+			expr: func(threshold string) string {
+				return "webhook:controller_runtime_webhook_requests:error_ratio_rate5m > " + threshold
+			},
+			query: func(selector string) string {
+				return fmt.Sprintf("sum by (webhook) (rate(controller_runtime_webhook_requests_total{%[1]s, "+
+					`code!~"2.."}[5m])) / sum by (webhook) (rate(controller_runtime_webhook_requests_total{%[1]s}[5m]))`,
+					selector)
+			},
+			summary: "Webhook {{ $labels.webhook }} fails",
+			description: "{{ $value | humanizePercentage }} of the requests to the webhook {{ $labels.webhook }} " +
+				"were answered with an error code over the last 5 minutes.",
+		},
+	}
+}
+
+// recordingRules returns the recording rules built on the controller-runtime metrics for the label selector
+func recordingRules(selector string) []templates.RecordingRule {
+	return []templates.RecordingRule{
+		{
+			Record: "controller:controller_runtime_reconcile_total:rate5m",
+			Expr:   fmt.Sprintf("sum by (controller) (rate(controller_runtime_reconcile_total{%s}[5m]))", selector),
+		},
+		{
+			Record: "controller:controller_runtime_reconcile_errors_total:rate5m",
+			Expr: fmt.Sprintf("sum by (controller) (rate(controller_runtime_reconcile_errors_total{%s}[5m]))",
+				selector),
+		},
+		{
+			Record: "controller:controller_runtime_reconcile_errors:ratio_rate5m",
+			Expr: "controller:controller_runtime_reconcile_errors_total:rate5m / " +
+				"controller:controller_runtime_reconcile_total:rate5m",
+		},
+		{
+			Record: "controller:controller_runtime_reconcile_time_seconds:p99_rate5m",
+			Expr: fmt.Sprintf("histogram_quantile(0.99, sum by (controller, le) "+
+				"(rate(controller_runtime_reconcile_time_seconds_bucket{%s}[5m])))", selector),
+		},
+		{
+			Record: "name:workqueue_depth:sum",
+			Expr:   fmt.Sprintf("sum by (name) (workqueue_depth{%s})", selector),
+		},
+		{
+			Record: "webhook:controller_runtime_webhook_requests_total:rate5m",
+			Expr: fmt.Sprintf("sum by (webhook) (rate(controller_runtime_webhook_requests_total{%s}[5m]))",
+				selector),
+		},
+		{
+			Record: "webhook:controller_runtime_webhook_requests:error_ratio_rate5m",
+			Expr: fmt.Sprintf(`sum by (webhook) (rate(controller_runtime_webhook_requests_total{%s, code!~"2.."}[5m]))`,
+				selector) + " / webhook:controller_runtime_webhook_requests_total:rate5m",
+		},
+	}
+}
+
+func loadAlertRulesConfig(configPath string) (templates.AlertRulesConfig, error) {
+	if !fileExist(configPath) {
+		return templates.AlertRulesConfig{}, nil
+	}
+
+	//nolint:gosec
+	f, err := os.Open(configPath)
+	if err != nil {
+		return templates.AlertRulesConfig{}, fmt.Errorf("error loading alerting rules config: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return alertRulesConfigReader(f)
+}
+
+func alertRulesConfigReader(reader io.Reader) (templates.AlertRulesConfig, error) {
+	config := templates.AlertRulesConfig{}
+
+	yamlFile, err := io.ReadAll(reader)
+	if err != nil {
+		return config, fmt.Errorf("error reading %s: %w", alertsConfigFilePath, err)
+	}
+
+	if err = yaml.UnmarshalStrict(yamlFile, &config); err != nil {
+		return config, fmt.Errorf("error parsing %s: %w", alertsConfigFilePath, err)
+	}
+
+	return config, nil
+}
+
+// alertRules merges the configured alerting rules with their defaults and renders their expressions
+func alertRules(projectName string, config templates.AlertRulesConfig) ([]templates.AlertRule, error) {
+	definitions := alertDefinitions()
+	names := make([]string, 0, len(definitions))
+	for _, definition := range definitions {
+		names = append(names, definition.Alert)
+	}
+
+	for _, item := range config.AlertRules {
+		i := indexOfAlert(definitions, item.Alert)
+		if i < 0 {
+			return nil, fmt.Errorf("unknown alert %q, expected one of: %s", item.Alert, strings.Join(names, ", "))
+		}
+		if item.Threshold != nil {
+			if *item.Threshold < 0 {
+				return nil, fmt.Errorf("invalid threshold %v for alert %q: must not be negative", *item.Threshold,
+					item.Alert)
+			}
+			definitions[i].Threshold = item.Threshold
+		}
+		if item.For != "" {
+			if !promDurationRegex.MatchString(item.For) {
+				return nil, fmt.Errorf("invalid duration %q for alert %q, e.g. 90s, 15m or 1h", item.For, item.Alert)
+			}
+			definitions[i].For = item.For
+		}
+		if item.Severity != "" {
+			definitions[i].Severity = item.Severity
+		}
+		definitions[i].Disabled = item.Disabled
+	}
+
+	selector := alertRulesSelector(projectName, config)
+
+	rules := make([]templates.AlertRule, 0, len(definitions))
+	for _, definition := range definitions {
+		if definition.Disabled {
+			continue
+		}
+		threshold := strconv.FormatFloat(*definition.Threshold, 'g', -1, 64)
+		rules = append(rules, templates.AlertRule{
+			Alert:       definition.Alert,
+			UID:         alertRuleUID(projectName, definition.Alert),
+			Expr:        definition.expr(threshold),
+			Query:       definition.query(selector),
+			Threshold:   threshold,
+			For:         definition.For,
+			Severity:    definition.Severity,
+			Summary:     definition.summary,
+			Description: definition.description,
+			// Grafana exposes the value of each query of the rule by its reference
+			GrafanaDescription: strings.ReplaceAll(definition.description, "$value", "$values.A.Value"),
+		})
+	}
+
+	return rules, nil
+}
+
+func indexOfAlert(definitions []alertDefinition, alert string) int {
+	for i, definition := range definitions {
+		if definition.Alert == alert {
+			return i
+		}
+	}
+	return -1
+}
+
+// alertRuleUID returns a stable identifier for the Grafana alert rule, which is limited to 40 characters
+func alertRuleUID(projectName, alert string) string {
+	sum := sha256.Sum256([]byte(projectName + "/" + alert))
+	return "kb" + hex.EncodeToString(sum[:])[:10]
+}
+
+// alertRulesSelector returns the label selector of the series used by the rules
+func alertRulesSelector(projectName string, config templates.AlertRulesConfig) string {
+	if config.Selector != "" {
+		return config.Selector
+	}
+	return fmt.Sprintf("job=%q", projectName+"-controller-manager-metrics-service")
+}
+
+// alertRulesManifests returns the templates of the alerting rules configured in the provided path, along with
+// the recording rules they are built on
+func alertRulesManifests(projectName, configPath string) ([]machinery.Builder, error) {
+	config, err := loadAlertRulesConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := alertRules(projectName, config)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring the alerting rules: %w", err)
+	}
+
+	datasourceUID := config.DatasourceUID
+	if datasourceUID == "" {
+		datasourceUID = defaultDatasourceUID
+	}
+
+	return []machinery.Builder{
+		&templates.AlertRulesConfigManifest{ConfigPath: configPath},
+		&templates.PrometheusRuleManifest{
+			RecordingRules: recordingRules(alertRulesSelector(projectName, config)),
+			AlertRules:     rules,
+		},
+		&templates.GrafanaAlertRulesManifest{
+			DatasourceUID: datasourceUID,
+			AlertRules:    rules,
+		},
+	}, nil
+}
+
+// addPrometheusRulesToKustomization adds the PrometheusRule to the resources of the config/prometheus
+// kustomization, so that it is deployed along with the ServiceMonitor
+func (s *editScaffolder) addPrometheusRulesToKustomization() {
+	editor := util.NewFileEditor(s.fs)
+	hasRules, err := editor.HasFileContentWith(prometheusKustomizationPath, "- rules.yaml")
+	if err != nil || hasRules {
+		return
+	}
+
+	if err = editor.InsertCode(prometheusKustomizationPath, "- monitor.yaml", "\n- rules.yaml"); err != nil {
+		log.Warn("Unable to add the PrometheusRule to the kustomization, add rules.yaml to its resources manually",
+			"path", prometheusKustomizationPath, "error", err)
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/yaml"
+
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds/internal/templates"
+)
+
+var _ = Describe("Alerting Rules", func() {
+	const projectName = "project"
+
+	Describe("alertRulesConfigReader", func() {
+		It("should parse the alerting rules config", func() {
+			config, err := alertRulesConfigReader(strings.NewReader(`---
+selector: job="custom"
+alertRules:
+  - alert: ReconcileErrorRatioHigh
+    threshold: 0.2
+    for: 5m
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Selector).To(Equal(`job="custom"`))
+			Expect(config.AlertRules).To(HaveLen(1))
+			Expect(*config.AlertRules[0].Threshold).To(Equal(0.2))
+			Expect(config.AlertRules[0].For).To(Equal("5m"))
+		})
+
+		It("should return error for unknown fields", func() {
+			_, err := alertRulesConfigReader(strings.NewReader(`---
+alertRules:
+  - alert: ReconcileErrorRatioHigh
+    treshold: 0.2
+`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("alertRules", func() {
+		It("should use the default configuration", func() {
+			rules, err := alertRules(projectName, templates.AlertRulesConfig{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(HaveLen(4))
+
+			Expect(rules[0].Alert).To(Equal("ReconcileErrorRatioHigh"))
+			Expect(rules[0].Expr).To(Equal("controller:controller_runtime_reconcile_errors:ratio_rate5m > 0.1"))
+			Expect(rules[0].Query).To(ContainSubstring(
+				`controller_runtime_reconcile_errors_total{job="project-controller-manager-metrics-service"}`))
+			Expect(rules[0].Threshold).To(Equal("0.1"))
+			Expect(rules[0].For).To(Equal("15m"))
+			Expect(rules[0].Severity).To(Equal("warning"))
+			Expect(rules[0].GrafanaDescription).To(ContainSubstring("{{ $values.A.Value | humanizePercentage }}"))
+		})
+
+		It("should merge the configured alerts with their defaults", func() {
+			threshold := 0.5
+			rules, err := alertRules(projectName, templates.AlertRulesConfig{
+				Selector: `namespace="ops"`,
+				AlertRules: []templates.AlertRuleItem{
+					{Alert: "ReconcileErrorRatioHigh", Threshold: &threshold, Severity: "critical"},
+					{Alert: "WebhookErrorRatioHigh", Disabled: true},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(HaveLen(3))
+
+			Expect(rules[0].Expr).To(HaveSuffix("> 0.5"))
+			Expect(rules[0].For).To(Equal("15m"))
+			Expect(rules[0].Severity).To(Equal("critical"))
+			Expect(rules[0].Query).To(ContainSubstring(`controller_runtime_reconcile_total{namespace="ops"}`))
+			for _, rule := range rules {
+				Expect(rule.Alert).NotTo(Equal("WebhookErrorRatioHigh"))
+			}
+		})
+
+		It("should return error for unknown alerts", func() {
+			_, err := alertRules(projectName, templates.AlertRulesConfig{
+				AlertRules: []templates.AlertRuleItem{{Alert: "Unknown"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`unknown alert "Unknown"`)))
+		})
+
+		It("should return error for invalid durations and thresholds", func() {
+			_, err := alertRules(projectName, templates.AlertRulesConfig{
+				AlertRules: []templates.AlertRuleItem{{Alert: "ReconcileLatencyHigh", For: "15 minutes"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`invalid duration "15 minutes"`)))
+
+			threshold := -1.0
+			_, err = alertRules(projectName, templates.AlertRulesConfig{
+				AlertRules: []templates.AlertRuleItem{{Alert: "ReconcileLatencyHigh", Threshold: &threshold}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("must not be negative")))
+		})
+
+		It("should identify the Grafana alert rules by project and alert", func() {
+			uid := alertRuleUID(projectName, "ReconcileErrorRatioHigh")
+			Expect(uid).To(Equal(alertRuleUID(projectName, "ReconcileErrorRatioHigh")))
+			Expect(uid).NotTo(Equal(alertRuleUID("other", "ReconcileErrorRatioHigh")))
+			Expect(len(uid)).To(BeNumerically("<=", 40))
+		})
+	})
+
+	Context("when scaffolding the alerting rules", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = os.MkdirTemp("", "grafana-alerts-test-*")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Chdir(tmpDir)).To(Succeed())
+
+			Expect(os.MkdirAll(filepath.Join("config", "prometheus"), 0o755)).To(Succeed())
+			Expect(os.WriteFile(prometheusKustomizationPath, []byte("resources:\n- monitor.yaml\n"), 0o600)).
+				To(Succeed())
+		})
+
+		AfterEach(func() {
+			_ = os.RemoveAll(tmpDir)
+		})
+
+		It("should scaffold the PrometheusRule and the Grafana alert rules", func() {
+			cfg := cfgv3.New()
+			Expect(cfg.SetProjectName(projectName)).To(Succeed())
+
+			scaffolder := NewEditScaffolder(cfg)
+			scaffolder.InjectFS(machinery.Filesystem{FS: afero.NewBasePathFs(afero.NewOsFs(), tmpDir)})
+			Expect(scaffolder.Scaffold()).To(Succeed())
+
+			By("verifying the PrometheusRule holds the recording and alerting rules")
+			content, err := os.ReadFile(filepath.Join("config", "prometheus", "rules.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+			var rule struct {
+				Kind string `json:"kind"`
+				Spec struct {
+					Groups []struct {
+						Name  string           `json:"name"`
+						Rules []map[string]any `json:"rules"`
+					} `json:"groups"`
+				} `json:"spec"`
+			}
+			Expect(yaml.Unmarshal(content, &rule)).To(Succeed())
+			Expect(rule.Kind).To(Equal("PrometheusRule"))
+			Expect(rule.Spec.Groups).To(HaveLen(2))
+			Expect(rule.Spec.Groups[0].Name).To(Equal("project-recording-rules"))
+			Expect(rule.Spec.Groups[0].Rules).To(HaveLen(len(recordingRules(""))))
+			Expect(rule.Spec.Groups[1].Rules).To(HaveLen(4))
+			Expect(rule.Spec.Groups[1].Rules[0]["annotations"]).To(HaveKeyWithValue(
+				"summary", "Controller {{ $labels.controller }} fails to reconcile"))
+
+			By("verifying the Grafana alert rules are valid YAML")
+			content, err = os.ReadFile(filepath.Join("grafana", "alerts", "alert-rules.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+			var provisioning map[string]any
+			Expect(yaml.Unmarshal(content, &provisioning)).To(Succeed())
+			Expect(string(content)).To(ContainSubstring("datasourceUid: prometheus"))
+
+			By("verifying the config file is created")
+			Expect(fileExist(alertsConfigFilePath)).To(BeTrue())
+
+			By("verifying the PrometheusRule is added to the kustomization once")
+			Expect(scaffolder.Scaffold()).To(Succeed())
+			content, err = os.ReadFile(prometheusKustomizationPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("resources:\n- monitor.yaml\n- rules.yaml\n"))
+		})
+
+		It("should fail with an invalid config", func() {
+			Expect(os.MkdirAll(filepath.Join("grafana", "alerts"), 0o755)).To(Succeed())
+			Expect(os.WriteFile(alertsConfigFilePath, []byte("alertRules:\n  - alert: Unknown\n"), 0o600)).
+				To(Succeed())
+
+			scaffolder := NewEditScaffolder(cfgv3.New())
+			scaffolder.InjectFS(machinery.Filesystem{FS: afero.NewBasePathFs(afero.NewOsFs(), tmpDir)})
+			Expect(scaffolder.Scaffold()).To(MatchError(ContainSubstring(`unknown alert "Unknown"`)))
+		})
+	})
+})
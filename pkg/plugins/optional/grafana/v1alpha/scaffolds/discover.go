@@ -0,0 +1,345 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds/internal/templates"
+)
+
+const (
+	prometheusImportPath = "github.com/prometheus/client_golang/prometheus"
+	promautoImportPath   = "github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// metricsSourceDirs are the directories of the project which are scanned for metrics declared in Go code
+var metricsSourceDirs = []string{"internal", "cmd", "pkg"}
+
+// collectorTypes maps the constructors of the Prometheus collectors to the type of the metrics they declare
+var collectorTypes = map[string]string{
+	"NewCounter":      "counter",
+	"NewCounterVec":   "counter",
+	"NewCounterFunc":  "counter",
+	"NewGauge":        "gauge",
+	"NewGaugeVec":     "gauge",
+	"NewGaugeFunc":    "gauge",
+	"NewHistogram":    "histogram",
+	"NewHistogramVec": "histogram",
+	"NewSummary":      "summary",
+	"NewSummaryVec":   "summary",
+}
+
+// discoverCustomMetrics statically scans the Go files of the provided directories for the declarations of
+// Prometheus collectors, such as prometheus.NewCounterVec or promauto.NewHistogram, and returns their metrics.
+// Declarations whose name cannot be resolved from string literals or constants, as well as the packages which
+// cannot be parsed, are skipped with a warning.
+func discoverCustomMetrics(dirs ...string) ([]templates.CustomMetricItem, error) {
+	var items []templates.CustomMetricItem
+	for _, dir := range dirs {
+		if !fileExist(dir) {
+			continue
+		}
+
+		err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !entry.IsDir() {
+				return nil
+			}
+			if path != dir && (strings.HasPrefix(entry.Name(), ".") || strings.HasPrefix(entry.Name(), "_") ||
+				entry.Name() == "vendor" || entry.Name() == "testdata") {
+				return filepath.SkipDir
+			}
+
+			pkgItems, err := discoverPackageMetrics(path)
+			if err != nil {
+				return err
+			}
+			items = append(items, pkgItems...)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error scanning %s for metrics: %w", dir, err)
+		}
+	}
+
+	slices.SortFunc(items, func(a, b templates.CustomMetricItem) int {
+		if c := strings.Compare(a.Metric, b.Metric); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Type, b.Type)
+	})
+	return slices.CompactFunc(items, func(a, b templates.CustomMetricItem) bool {
+		return a.Metric == b.Metric && a.Type == b.Type
+	}), nil
+}
+
+// discoverPackageMetrics returns the metrics declared in the Go files of a directory, excluding tests.
+// A package with a file which cannot be parsed, such as code being written, is skipped with a warning.
+func discoverPackageMetrics(dir string) ([]templates.CustomMetricItem, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory %s: %w", dir, err)
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			log.Warn("Skipping the metrics declared in a package which cannot be parsed", "dir", dir,
+				"error", err)
+			return nil, nil
+		}
+		files = append(files, file)
+	}
+
+	// The constants of the package can be used in the options of the collectors
+	constants := map[string]string{}
+	for _, file := range files {
+		collectStringConstants(file, constants)
+	}
+
+	var items []templates.CustomMetricItem
+	for _, file := range files {
+		prometheusName, promautoName := collectorPackageNames(file)
+		if prometheusName == "" && promautoName == "" {
+			continue
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			metricType, ok := collectorType(call, prometheusName, promautoName)
+			if !ok {
+				return true
+			}
+
+			item, err := metricFromCollector(call, metricType, constants)
+			if err != nil {
+				log.Warn("Skipping the metric declared in Go code", "position", fset.Position(call.Pos()),
+					"error", err)
+				return true
+			}
+			items = append(items, item)
+			return true
+		})
+	}
+	return items, nil
+}
+
+// collectorPackageNames returns the names under which the prometheus and promauto packages are imported
+func collectorPackageNames(file *ast.File) (prometheusName, promautoName string) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		switch path {
+		case prometheusImportPath:
+			prometheusName = name
+		case promautoImportPath:
+			promautoName = name
+		}
+	}
+	return prometheusName, promautoName
+}
+
+// collectorType returns the type of the metric declared by a call, if it is the constructor of a collector,
+// such as prometheus.NewCounter(...), promauto.NewCounter(...) or promauto.With(registry).NewCounter(...)
+func collectorType(call *ast.CallExpr, prometheusName, promautoName string) (string, bool) {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	metricType, ok := collectorTypes[selector.Sel.Name]
+	if !ok {
+		return "", false
+	}
+
+	switch x := selector.X.(type) {
+	case *ast.Ident:
+		return metricType, x.Name == prometheusName || x.Name == promautoName
+	case *ast.CallExpr:
+		with, isSelector := x.Fun.(*ast.SelectorExpr)
+		if !isSelector || with.Sel.Name != "With" {
+			return "", false
+		}
+		pkg, isIdent := with.X.(*ast.Ident)
+		return metricType, isIdent && pkg.Name == promautoName
+	}
+	return "", false
+}
+
+// metricFromCollector returns the metric declared by the options of a collector constructor
+func metricFromCollector(
+	call *ast.CallExpr, metricType string, constants map[string]string,
+) (templates.CustomMetricItem, error) {
+	if len(call.Args) == 0 {
+		return templates.CustomMetricItem{}, errors.New("missing options")
+	}
+	opts, ok := call.Args[0].(*ast.CompositeLit)
+	if !ok {
+		return templates.CustomMetricItem{}, errors.New("options are not declared inline")
+	}
+
+	fields := map[string]string{}
+	for _, elt := range opts.Elts {
+		kv, isKeyValue := elt.(*ast.KeyValueExpr)
+		if !isKeyValue {
+			continue
+		}
+		key, isIdent := kv.Key.(*ast.Ident)
+		if !isIdent {
+			continue
+		}
+		switch key.Name {
+		case "Namespace", "Subsystem", "Name", "Help":
+			value, err := stringValue(kv.Value, constants)
+			if err != nil {
+				return templates.CustomMetricItem{}, fmt.Errorf("unable to resolve %s: %w", key.Name, err)
+			}
+			fields[key.Name] = value
+		}
+	}
+
+	if fields["Name"] == "" {
+		return templates.CustomMetricItem{}, errors.New("missing name")
+	}
+
+	item := templates.CustomMetricItem{
+		Metric: buildFQName(fields["Namespace"], fields["Subsystem"], fields["Name"]),
+		Type:   metricType,
+		Help:   fields["Help"],
+	}
+	// Histograms are graphed from their buckets
+	if metricType == "histogram" {
+		item.Metric += "_bucket"
+	}
+
+	// The label names of the vectors are the second argument of their constructor
+	if strings.HasSuffix(call.Fun.(*ast.SelectorExpr).Sel.Name, "Vec") && len(call.Args) > 1 {
+		if labels, isLiteral := call.Args[1].(*ast.CompositeLit); isLiteral {
+			for _, elt := range labels.Elts {
+				label, err := stringValue(elt, constants)
+				if err != nil {
+					return templates.CustomMetricItem{}, fmt.Errorf("unable to resolve label: %w", err)
+				}
+				item.Labels = append(item.Labels, label)
+			}
+		}
+	}
+
+	return item, nil
+}
+
+// buildFQName joins the non-empty components of a metric name with underscores, as prometheus.BuildFQName
+func buildFQName(namespace, subsystem, name string) string {
+	return strings.Join(slices.DeleteFunc([]string{namespace, subsystem, name}, func(s string) bool {
+		return s == ""
+	}), "_")
+}
+
+// stringValue resolves an expression made of string literals and constants of the package
+func stringValue(expr ast.Expr, constants map[string]string) (string, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			return strconv.Unquote(e.Value)
+		}
+	case *ast.Ident:
+		if value, ok := constants[e.Name]; ok {
+			return value, nil
+		}
+	case *ast.ParenExpr:
+		return stringValue(e.X, constants)
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			x, err := stringValue(e.X, constants)
+			if err != nil {
+				return "", err
+			}
+			y, err := stringValue(e.Y, constants)
+			if err != nil {
+				return "", err
+			}
+			return x + y, nil
+		}
+	}
+	return "", errors.New("the value is not made of string literals and constants of the package")
+}
+
+// collectStringConstants adds the package-level string constants declared in the file to the map
+func collectStringConstants(file *ast.File, constants map[string]string) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valueSpec, isValueSpec := spec.(*ast.ValueSpec)
+			if !isValueSpec {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				if i >= len(valueSpec.Values) {
+					break
+				}
+				if value, err := stringValue(valueSpec.Values[i], constants); err == nil {
+					constants[name.Name] = value
+				}
+			}
+		}
+	}
+}
+
+// mergeCustomMetrics returns the metrics of the config followed by the discovered metrics which are not
+// configured yet, so that the config can override the panels of the metrics declared in Go code
+func mergeCustomMetrics(configured, discovered []templates.CustomMetricItem) []templates.CustomMetricItem {
+	merged := slices.Clone(configured)
+	for _, item := range discovered {
+		if !slices.ContainsFunc(configured, func(c templates.CustomMetricItem) bool {
+			return c.Metric == item.Metric && strings.EqualFold(c.Type, item.Type)
+		}) {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds/internal/templates"
+)
+
+const metricsSource = `package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "memcached"
+	subsystem = "operator"
+)
+
+var (
+	Reconciles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "reconciles_total",
+		Help:      "Number of reconciles, by \"result\".",
+	}, []string{"controller", "result"})
+
+	Duration = promauto.With(metrics.Registry).NewHistogram(prometheus.HistogramOpts{
+		Name: namespace + "_backup_duration_seconds",
+		Help: "Duration of the backups.",
+	})
+
+	Objectives = prometheus.NewSummary(prometheus.SummaryOpts{Name: "cache_latency_seconds"})
+
+	Replicas = promauto.NewGauge(prometheus.GaugeOpts{Name: "replicas"})
+
+	Dynamic = prometheus.NewGauge(prometheus.GaugeOpts{Name: dynamicName()})
+)
+
+func init() {
+	metrics.Registry.MustRegister(Reconciles, Objectives)
+}
+
+func dynamicName() string {
+	return "dynamic"
+}
+`
+
+var _ = Describe("Discovered metrics", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "grafana-discover-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Chdir(tmpDir)).To(Succeed())
+
+		Expect(os.MkdirAll(filepath.Join("internal", "metrics"), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join("internal", "metrics", "metrics.go"),
+			[]byte(metricsSource), 0o644)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	Describe("discoverCustomMetrics", func() {
+		It("should extract the metrics declared with the Prometheus client", func() {
+			items, err := discoverCustomMetrics(metricsSourceDirs...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(Equal([]templates.CustomMetricItem{
+				{Metric: "cache_latency_seconds", Type: "summary"},
+				{
+					Metric: "memcached_backup_duration_seconds_bucket",
+					Type:   "histogram",
+					Help:   "Duration of the backups.",
+				},
+				{
+					Metric: "memcached_operator_reconciles_total",
+					Type:   "counter",
+					Help:   `Number of reconciles, by "result".`,
+					Labels: []string{"controller", "result"},
+				},
+				{Metric: "replicas", Type: "gauge"},
+			}))
+		})
+
+		It("should skip tests, vendored code and directories which do not exist", func() {
+			for _, path := range []string{
+				filepath.Join("internal", "metrics", "metrics_test.go"),
+				filepath.Join("cmd", "vendor", "metrics", "metrics.go"),
+			} {
+				Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+				Expect(os.WriteFile(path, []byte(metricsSource), 0o644)).To(Succeed())
+			}
+
+			items, err := discoverCustomMetrics("internal", "cmd", "missing")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(HaveLen(4))
+		})
+
+		It("should skip the packages with invalid Go code", func() {
+			Expect(os.MkdirAll(filepath.Join("internal", "broken"), 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join("internal", "broken", "metrics.go"), []byte(`package metrics
+
+import "github.com/prometheus/client_golang/prometheus/promauto"
+
+var Broken = promauto.NewGauge(prometheus.GaugeOpts{Name: "broken"})
+`), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join("internal", "broken", "invalid.go"),
+				[]byte("package metrics\nvar ="), 0o644)).To(Succeed())
+
+			items, err := discoverCustomMetrics(metricsSourceDirs...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(HaveLen(4))
+			Expect(items).NotTo(ContainElement(templates.CustomMetricItem{Metric: "broken", Type: "gauge"}))
+		})
+	})
+
+	Describe("loadDiscoveredMetrics", func() {
+		It("should generate the expressions grouped by the labels of the metrics", func() {
+			items, err := loadDiscoveredMetrics()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(HaveLen(4))
+			Expect(items[2].Expr).To(Equal(`sum(rate(memcached_operator_reconciles_total{job=\"$job\", ` +
+				`namespace=\"$namespace\"}[5m])) by (instance, pod, controller, result)`))
+			Expect(items[2].Help).To(Equal(`Number of reconciles, by \"result\".`))
+			Expect(items[0].Expr).To(ContainSubstring(`cache_latency_seconds{job=\"$job\"`))
+		})
+	})
+
+	Describe("Scaffold", func() {
+		It("should add the panels of the discovered metrics to the custom metrics dashboard", func() {
+			Expect(os.MkdirAll(filepath.Dir(configFilePath), 0o755)).To(Succeed())
+			Expect(os.WriteFile(configFilePath, []byte(`---
+customMetrics:
+  - metric: replicas
+    type: gauge
+    unit: short
+`), 0o644)).To(Succeed())
+
+			scaffolder := NewEditScaffolder(nil)
+			scaffolder.InjectFS(machinery.Filesystem{FS: afero.NewBasePathFs(afero.NewOsFs(), tmpDir)})
+			Expect(scaffolder.Scaffold()).To(Succeed())
+
+			content, err := os.ReadFile(filepath.Join("grafana", "custom-metrics", "custom-metrics-dashboard.json"))
+			Expect(err).NotTo(HaveOccurred())
+			var dashboard struct {
+				Panels []struct {
+					Title       string `json:"title"`
+					Description string `json:"description"`
+				} `json:"panels"`
+			}
+			Expect(json.Unmarshal(content, &dashboard)).To(Succeed())
+			Expect(dashboard.Panels).To(HaveLen(4))
+			Expect(dashboard.Panels[0].Title).To(Equal("replicas (gauge)"))
+			Expect(dashboard.Panels[3].Title).To(Equal("memcached_operator_reconciles_total (counter)"))
+			Expect(dashboard.Panels[3].Description).To(Equal(`Number of reconciles, by "result".`))
+		})
+	})
+
+	Describe("mergeCustomMetrics", func() {
+		It("should keep the configured metrics and add the discovered ones", func() {
+			configured := []templates.CustomMetricItem{
+				{Metric: "replicas", Type: "Gauge", Unit: "short"},
+				{Metric: "other", Type: "counter"},
+			}
+			discovered := []templates.CustomMetricItem{
+				{Metric: "replicas", Type: "gauge"},
+				{Metric: "replicas", Type: "counter"},
+				{Metric: "cache_latency_seconds", Type: "summary"},
+			}
+
+			Expect(mergeCustomMetrics(configured, discovered)).To(Equal([]templates.CustomMetricItem{
+				{Metric: "replicas", Type: "Gauge", Unit: "short"},
+				{Metric: "other", Type: "counter"},
+				{Metric: "replicas", Type: "counter"},
+				{Metric: "cache_latency_seconds", Type: "summary"},
+			}))
+		})
+	})
+})
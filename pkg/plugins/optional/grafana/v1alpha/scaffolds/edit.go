@@ -17,16 +17,23 @@ limitations under the License.
 package scaffolds
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	log "log/slog"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 
 	"sigs.k8s.io/yaml"
 
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds/internal/promql"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds/internal/templates"
 )
 
@@ -35,13 +42,15 @@ var _ plugins.Scaffolder = &editScaffolder{}
 const configFilePath = "grafana/custom-metrics/config.yaml"
 
 type editScaffolder struct {
+	config config.Config
+
 	// fs is the filesystem that will be used by the scaffolder
 	fs machinery.Filesystem
 }
 
 // NewEditScaffolder returns a new Scaffolder for project edition operations
-func NewEditScaffolder() plugins.Scaffolder {
-	return &editScaffolder{}
+func NewEditScaffolder(cfg config.Config) plugins.Scaffolder {
+	return &editScaffolder{config: cfg}
 }
 
 // InjectFS implements cmdutil.Scaffolder
@@ -81,6 +90,19 @@ func loadConfig(configPath string) ([]templates.CustomMetricItem, error) {
 	return items, nil
 }
 
+// loadDiscoveredMetrics returns the validated metrics declared in the Go code of the project
+func loadDiscoveredMetrics() ([]templates.CustomMetricItem, error) {
+	discovered, err := discoverCustomMetrics(metricsSourceDirs...)
+	if err != nil {
+		return nil, err
+	}
+	items, err := validateCustomMetricItems(discovered)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metrics declared in Go code:\n%w", err)
+	}
+	return items, nil
+}
+
 func configReader(reader io.Reader) ([]templates.CustomMetricItem, error) {
 	yamlFile, err := io.ReadAll(reader)
 	if err != nil {
@@ -94,28 +116,113 @@ func configReader(reader io.Reader) ([]templates.CustomMetricItem, error) {
 		return nil, fmt.Errorf("error parsing config.yaml: %w", err)
 	}
 
-	validatedMetricItems := validateCustomMetricItems(config.CustomMetrics)
+	validatedMetricItems, err := validateCustomMetricItems(config.CustomMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom metrics in config.yaml:\n%w", err)
+	}
 
 	return validatedMetricItems, nil
 }
 
-func validateCustomMetricItems(rawItems []templates.CustomMetricItem) []templates.CustomMetricItem {
-	// 1. Filter items of missing `Metric` or `Type`
-	var filterResult []templates.CustomMetricItem
-	for _, item := range rawItems {
-		if hasFields(item) {
-			filterResult = append(filterResult, item)
+// metricTypes are the types of the custom metrics for which an expression can be generated
+var metricTypes = []string{"counter", "gauge", "histogram", "summary"}
+
+var (
+	metricNameRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRegex  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// validateCustomMetricItems validates the custom metrics and fills their missing expressions and units.
+// It returns an error for each invalid item, which identifies the item by its index in the config.
+func validateCustomMetricItems(rawItems []templates.CustomMetricItem) ([]templates.CustomMetricItem, error) {
+	var validatedItems []templates.CustomMetricItem
+	var errs []error
+	for i, item := range rawItems {
+		validated, err := validateCustomMetricItem(item)
+		if err != nil {
+			name := item.Metric
+			if name == "" {
+				name = "unnamed"
+			}
+			errs = append(errs, fmt.Errorf("customMetrics[%d] (%s): %w", i, name, err))
+			continue
 		}
+		validatedItems = append(validatedItems, fillMissingUnit(validated))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return validatedItems, nil
+}
+
+func validateCustomMetricItem(item templates.CustomMetricItem) (templates.CustomMetricItem, error) {
+	if item.Type != "" && !slices.Contains(metricTypes, strings.ToLower(item.Type)) {
+		return item, fmt.Errorf("unknown type %q, expected one of: %s", item.Type, strings.Join(metricTypes, ", "))
+	}
+	if item.Metric != "" && !metricNameRegex.MatchString(item.Metric) {
+		return item, fmt.Errorf("invalid metric name %q", item.Metric)
+	}
+	if !hasFields(item) {
+		return item, errors.New("metric and type are required when expr is not set")
+	}
+	if item.Expr != "" && item.Selector != "" {
+		return item, errors.New("selector cannot be used with expr, add the label matchers to the expression")
+	}
+
+	if item.Selector != "" {
+		selector := unescapeQuery(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(item.Selector), "{"), "}"))
+		if err := promql.CheckMatchers(selector); err != nil {
+			return item, fmt.Errorf("invalid selector %q: %w", item.Selector, err)
+		}
+		item.Selector = escapeJSONString(selector)
+	}
+	for _, label := range item.Labels {
+		if !labelNameRegex.MatchString(label) {
+			return item, fmt.Errorf("invalid label name %q", label)
+		}
+	}
+	// The help is rendered within a JSON string of the dashboard
+	item.Help = escapeJSONString(item.Help)
+
+	item = fillMissingExpr(item)
+
+	query := unescapeQuery(item.Expr)
+	warnings, err := promql.Check(query)
+	if err != nil {
+		return item, fmt.Errorf("invalid expr %q: %w", query, err)
+	}
+	for _, warning := range warnings {
+		log.Warn("The expr of the custom metric could not be fully validated", "expr", query, "warning", warning)
 	}
+	// The expression is rendered within a JSON string of the dashboard
+	item.Expr = escapeJSONString(query)
 
-	// 2. Fill Expr and Unit if missing
-	validatedItems := make([]templates.CustomMetricItem, len(filterResult))
-	for i, item := range filterResult {
-		item = fillMissingExpr(item)
-		validatedItems[i] = fillMissingUnit(item)
+	return item, nil
+}
+
+// unescapeQuery returns the query of an expression which was written as in the dashboard JSON,
+// e.g. foo{job=\"$job\"}, which is how the default expressions are generated
+func unescapeQuery(expr string) string {
+	if !strings.Contains(expr, `\"`) {
+		return expr
+	}
+	var query string
+	if err := json.Unmarshal([]byte(`"`+expr+`"`), &query); err != nil {
+		return expr
 	}
+	return query
+}
 
-	return validatedItems
+// escapeJSONString escapes a value, such as a query, to be rendered within a JSON string
+func escapeJSONString(value string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	// Encoding a string cannot fail
+	_ = encoder.Encode(value)
+	escaped := strings.TrimSuffix(buf.String(), "\n")
+	return escaped[1 : len(escaped)-1]
 }
 
 func hasFields(item templates.CustomMetricItem) bool {
@@ -125,25 +232,36 @@ func hasFields(item templates.CustomMetricItem) bool {
 	}
 
 	// If `Metric` & valid `Type` exists, return true
-	metricType := strings.ToLower(item.Type)
-	if item.Metric != "" && (metricType == "counter" || metricType == "gauge" || metricType == "histogram") {
-		return true
-	}
-
-	return false
+	return item.Metric != "" && slices.Contains(metricTypes, strings.ToLower(item.Type))
 }
 
-// TODO: Prom_ql exprs can improved to be more pratical and applicable
+// fillMissingExpr generates the expression of a metric from its type, filtered with its selector and grouped
+// by its labels if any
 func fillMissingExpr(item templates.CustomMetricItem) templates.CustomMetricItem {
-	if item.Expr == "" {
-		switch strings.ToLower(item.Type) {
-		case "counter":
-			item.Expr = "sum(rate(" + item.Metric + `{job=\"$job\", namespace=\"$namespace\"}[5m])) by (instance, pod)`
-		case "histogram":
-			//nolint:lll
-			item.Expr = "histogram_quantile(0.90, sum by(instance, le) (rate(" + item.Metric + `{job=\"$job\", namespace=\"$namespace\"}[5m])))`
-		default: // gauge
-			item.Expr = item.Metric
+	if item.Expr != "" {
+		return item
+	}
+
+	matchers := `job=\"$job\", namespace=\"$namespace\"`
+	if item.Selector != "" {
+		matchers += ", " + item.Selector
+	}
+	labels := ""
+	if len(item.Labels) > 0 {
+		labels = ", " + strings.Join(item.Labels, ", ")
+	}
+	switch strings.ToLower(item.Type) {
+	case "counter":
+		item.Expr = "sum(rate(" + item.Metric + "{" + matchers + "}[5m])) by (instance, pod" + labels + ")"
+	case "histogram":
+		item.Expr = "histogram_quantile(0.90, sum by(instance, le" + labels + ") (rate(" + item.Metric +
+			"{" + matchers + "}[5m])))"
+	case "summary":
+		item.Expr = "max by(instance" + labels + ") (" + item.Metric + "{" + matchers + `, quantile=\"0.9\"})`
+	default: // gauge
+		item.Expr = item.Metric
+		if item.Selector != "" {
+			item.Expr += "{" + item.Selector + "}"
 		}
 	}
 	return item
@@ -169,7 +287,7 @@ func (s *editScaffolder) Scaffold() error {
 	log.Info("Generating Grafana manifests to visualize controller status...")
 
 	// Initialize the machinery.Scaffold that will write the files to disk
-	scaffold := machinery.NewScaffold(s.fs)
+	scaffold := machinery.NewScaffold(s.fs, machinery.WithConfig(s.config))
 
 	configPath := configFilePath
 
@@ -179,16 +297,40 @@ func (s *editScaffolder) Scaffold() error {
 		&templates.CustomMetricsConfigManifest{ConfigPath: configPath},
 	}
 
+	if s.config != nil {
+		dashboard, err := resourceDashboard(s.config)
+		if err != nil {
+			return err
+		}
+		templatesBuilder = append(templatesBuilder, dashboard)
+
+		alerts, err := alertRulesManifests(s.config.GetProjectName(), alertsConfigFilePath)
+		if err != nil {
+			return err
+		}
+		templatesBuilder = append(templatesBuilder, alerts...)
+	}
+
 	configItems, err := loadConfig(configPath)
-	if err == nil && len(configItems) > 0 {
+	if err != nil {
+		return fmt.Errorf("error scaffolding manifest for custom metrics: %w", err)
+	}
+	discoveredItems, err := loadDiscoveredMetrics()
+	if err != nil {
+		return fmt.Errorf("error scaffolding manifest for custom metrics: %w", err)
+	}
+	configItems = mergeCustomMetrics(configItems, discoveredItems)
+	if len(configItems) > 0 {
 		templatesBuilder = append(templatesBuilder, &templates.CustomMetricsDashManifest{Items: configItems})
-	} else if err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "Error on scaffolding manifest for custom metrics:\n%v", err)
 	}
 
-	if err = scaffold.Execute(templatesBuilder...); err != nil {
+	if err := scaffold.Execute(templatesBuilder...); err != nil {
 		return fmt.Errorf("error scaffolding Grafana manifests: %w", err)
 	}
 
+	if s.config != nil {
+		s.addPrometheusRulesToKustomization()
+	}
+
 	return nil
 }
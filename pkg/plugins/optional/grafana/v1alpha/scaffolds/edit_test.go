@@ -98,7 +98,7 @@ customMetrics:
 	})
 
 	Describe("validateCustomMetricItems", func() {
-		It("should filter out items missing required fields", func() {
+		It("should report items missing required fields", func() {
 			items := []templates.CustomMetricItem{
 				{Metric: "valid_metric", Type: "counter"},
 				{Metric: "", Type: "gauge"}, // Missing metric
@@ -106,10 +106,56 @@ customMetrics:
 				{Type: "counter"}, // Missing metric
 			}
 
-			validated := validateCustomMetricItems(items)
-			Expect(validated).To(HaveLen(2))
-			Expect(validated[0].Metric).To(Equal("valid_metric"))
-			Expect(validated[1].Metric).To(Equal("another_valid"))
+			_, err := validateCustomMetricItems(items)
+			Expect(err).To(MatchError(ContainSubstring(
+				"customMetrics[1] (unnamed): metric and type are required when expr is not set")))
+			Expect(err).To(MatchError(ContainSubstring("customMetrics[3] (unnamed)")))
+			Expect(err.Error()).NotTo(ContainSubstring("valid_metric"))
+		})
+
+		It("should report unknown types and invalid metric names", func() {
+			items := []templates.CustomMetricItem{
+				{Metric: "foo_bar", Type: "countr"},
+				{Metric: "foo-bar", Type: "gauge"},
+			}
+
+			_, err := validateCustomMetricItems(items)
+			Expect(err).To(MatchError(ContainSubstring(`customMetrics[0] (foo_bar): unknown type "countr"`)))
+			Expect(err).To(MatchError(ContainSubstring(`customMetrics[1] (foo-bar): invalid metric name "foo-bar"`)))
+		})
+
+		It("should report invalid expressions", func() {
+			items := []templates.CustomMetricItem{
+				{Metric: "foo_bar", Type: "counter", Expr: "sum(rate(foo_bar[5m])"},
+				{Metric: "foo_bar", Type: "counter", Expr: "rate(foo_bar[5min])"},
+				{Metric: "foo_bar", Type: "counter", Expr: "foo_bar[5m]"},
+			}
+
+			_, err := validateCustomMetricItems(items)
+			Expect(err).To(MatchError(ContainSubstring(`customMetrics[0] (foo_bar): invalid expr "sum(rate(foo_bar[5m])"`)))
+			Expect(err).To(MatchError(ContainSubstring(`customMetrics[1] (foo_bar): invalid expr "rate(foo_bar[5min])"`)))
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to an instant vector or a scalar")))
+		})
+
+		It("should accept expressions calling unknown functions", func() {
+			items := []templates.CustomMetricItem{
+				{Metric: "foo_bar", Type: "gauge", Expr: `holt_winters(foo_bar{job=\"$job\"}[1h], 0.5, 0.5)`},
+			}
+
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(validated).To(HaveLen(1))
+		})
+
+		It("should report invalid selectors and selectors used with expr", func() {
+			items := []templates.CustomMetricItem{
+				{Metric: "foo_bar", Type: "counter", Selector: "method=GET"},
+				{Metric: "foo_bar", Type: "counter", Selector: `method="GET"`, Expr: "foo_bar"},
+			}
+
+			_, err := validateCustomMetricItems(items)
+			Expect(err).To(MatchError(ContainSubstring(`customMetrics[0] (foo_bar): invalid selector "method=GET"`)))
+			Expect(err).To(MatchError(ContainSubstring("customMetrics[1] (foo_bar): selector cannot be used with expr")))
 		})
 
 		It("should fill missing expr for counter type", func() {
@@ -117,7 +163,8 @@ customMetrics:
 				{Metric: "foo_bar", Type: "counter"},
 			}
 
-			validated := validateCustomMetricItems(items)
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(validated).To(HaveLen(1))
 			Expect(validated[0].Expr).To(ContainSubstring("sum(rate(foo_bar"))
 			Expect(validated[0].Expr).To(ContainSubstring(`{job=\"$job\", namespace=\"$namespace\"}`))
@@ -128,7 +175,8 @@ customMetrics:
 				{Metric: "foo_bar", Type: "histogram"},
 			}
 
-			validated := validateCustomMetricItems(items)
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(validated).To(HaveLen(1))
 			Expect(validated[0].Expr).To(ContainSubstring("histogram_quantile(0.90"))
 			Expect(validated[0].Expr).To(ContainSubstring("foo_bar"))
@@ -139,18 +187,59 @@ customMetrics:
 				{Metric: "foo_bar", Type: "gauge"},
 			}
 
-			validated := validateCustomMetricItems(items)
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(validated).To(HaveLen(1))
 			Expect(validated[0].Expr).To(Equal("foo_bar"))
 		})
 
+		It("should fill missing expr for summary type", func() {
+			items := []templates.CustomMetricItem{
+				{Metric: "foo_bar", Type: "summary"},
+			}
+
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(validated).To(HaveLen(1))
+			Expect(validated[0].Expr).To(Equal(
+				`max by(instance) (foo_bar{job=\"$job\", namespace=\"$namespace\", quantile=\"0.9\"})`))
+		})
+
+		It("should add the selector to the generated expr", func() {
+			items := []templates.CustomMetricItem{
+				{Metric: "foo_bar", Type: "counter", Selector: `{method="GET", code=~"5.."}`},
+				{Metric: "baz", Type: "gauge", Selector: `pod!=""`},
+			}
+
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(validated).To(HaveLen(2))
+			Expect(validated[0].Expr).To(Equal(`sum(rate(foo_bar{job=\"$job\", namespace=\"$namespace\", ` +
+				`method=\"GET\", code=~\"5..\"}[5m])) by (instance, pod)`))
+			Expect(validated[1].Expr).To(Equal(`baz{pod!=\"\"}`))
+		})
+
+		It("should escape the quotes of the provided expr", func() {
+			items := []templates.CustomMetricItem{
+				{Expr: `sum(foo{label="value"})`},
+				{Expr: `sum(foo{label=\"value\"})`},
+			}
+
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(validated).To(HaveLen(2))
+			Expect(validated[0].Expr).To(Equal(`sum(foo{label=\"value\"})`))
+			Expect(validated[1].Expr).To(Equal(`sum(foo{label=\"value\"})`))
+		})
+
 		It("should not override existing expr", func() {
 			customExpr := "my_custom_expr"
 			items := []templates.CustomMetricItem{
 				{Metric: "foo_bar", Type: "counter", Expr: customExpr},
 			}
 
-			validated := validateCustomMetricItems(items)
+			validated, err := validateCustomMetricItems(items)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(validated).To(HaveLen(1))
 			Expect(validated[0].Expr).To(Equal(customExpr))
 		})
@@ -163,7 +252,7 @@ customMetrics:
 		})
 
 		It("should return true when metric and valid type exist", func() {
-			validTypes := []string{"counter", "gauge", "histogram"}
+			validTypes := []string{"counter", "gauge", "histogram", "summary"}
 			for _, t := range validTypes {
 				item := templates.CustomMetricItem{Metric: "foo_bar", Type: t}
 				Expect(hasFields(item)).To(BeTrue(), "Expected type %s to be valid", t)
@@ -354,7 +443,7 @@ customMetrics:
 				Expect(err).NotTo(HaveOccurred())
 				contentStr := string(content)
 
-				Expect(contentStr).To(ContainSubstring(`my_custom_expression{label="value"}`))
+				Expect(contentStr).To(ContainSubstring(`my_custom_expression{label=\"value\"}`))
 				Expect(contentStr).To(ContainSubstring("custom_unit"))
 			})
 
@@ -391,7 +480,7 @@ customMetrics:
 				Expect(contentStr).To(ContainSubstring(`"unit": "percent"`)) // percent
 			})
 
-			It("should skip invalid metrics and only process valid ones", func() {
+			It("should fail with an error for each invalid metric", func() {
 				By("configuring a mix of valid and invalid metrics")
 				configContent := `---
 customMetrics:
@@ -404,6 +493,9 @@ customMetrics:
     type: gauge
   - metric: invalid_type
     type: unknown
+  - metric: invalid_expr
+    type: gauge
+    expr: sum(invalid_expr
 `
 				configPath := configFilePath
 				err := os.WriteFile(configPath, []byte(configContent), 0o644)
@@ -413,19 +505,17 @@ customMetrics:
 				scaffolder2 := &editScaffolder{}
 				scaffolder2.InjectFS(fs)
 				err = scaffolder2.Scaffold()
-				Expect(err).NotTo(HaveOccurred())
 
-				By("verifying dashboard was created with only valid metrics")
-				dashPath := filepath.Join("grafana", "custom-metrics", "custom-metrics-dashboard.json")
-				if fileExists(dashPath) {
-					content, err := os.ReadFile(dashPath)
-					Expect(err).NotTo(HaveOccurred())
-					contentStr := string(content)
+				By("verifying every invalid metric is reported")
+				Expect(err).To(MatchError(ContainSubstring("customMetrics[1] (unnamed)")))
+				Expect(err).To(MatchError(ContainSubstring("customMetrics[2] (unnamed)")))
+				Expect(err).To(MatchError(ContainSubstring(`customMetrics[4] (invalid_type): unknown type "unknown"`)))
+				Expect(err).To(MatchError(ContainSubstring(`customMetrics[5] (invalid_expr): invalid expr`)))
+				Expect(err.Error()).NotTo(ContainSubstring("valid_counter"))
 
-					Expect(contentStr).To(ContainSubstring("valid_counter"))
-					Expect(contentStr).To(ContainSubstring("another_valid"))
-					Expect(contentStr).NotTo(ContainSubstring("invalid_type"))
-				}
+				By("verifying the dashboard was not created")
+				dashPath := filepath.Join("grafana", "custom-metrics", "custom-metrics-dashboard.json")
+				Expect(fileExists(dashPath)).To(BeFalse())
 			})
 
 			It("should handle metrics ending with _info suffix specially", func() {
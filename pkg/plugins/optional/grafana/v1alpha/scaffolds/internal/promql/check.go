@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package promql checks the PromQL expressions of the Grafana plugin, so that the common mistakes are
+// reported before they are rendered in a dashboard.
+//
+// It does not implement the PromQL grammar, which is left to Prometheus. It only checks that:
+//   - the expression is not empty and only holds the characters used by PromQL;
+//   - the quoted strings are terminated;
+//   - the parentheses, braces and brackets are balanced;
+//   - the braces hold a list of label matchers, such as {job="api", pod=~"api-.*"}, whose regular
+//     expressions are valid;
+//   - the brackets hold a duration, optionally followed by a subquery step, such as [5m] or [1h:1m];
+//   - no range is applied outside of a function call, as a range vector cannot be graphed;
+//   - the functions are known. The calls to unknown functions, such as the ones added by newer Prometheus
+//     versions, are reported as warnings rather than errors.
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error is an error in a PromQL expression
+type Error struct {
+	// Pos is the position of the error in the expression, in bytes
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("parse error at position %d: %s", e.Pos+1, e.Msg)
+}
+
+func newError(pos int, format string, args ...any) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+var (
+	// grafanaVariableRegex matches the Grafana template variables, such as $job, ${job} or [[job]]
+	grafanaVariableRegex = regexp.MustCompile(`\$\{[^}]*\}|\$[a-zA-Z_][a-zA-Z0-9_]*|\[\[[^\]]*\]\]`)
+	durationRegex        = regexp.MustCompile(`^(?:[0-9]+(?:ms|s|m|h|d|w|y))+$`)
+)
+
+// Check checks a PromQL expression of a Grafana panel, which can hold template variables such as $job,
+// ${job}, [[job]] or $__rate_interval. It returns a warning for each call to an unknown function.
+func Check(expr string) ([]string, error) {
+	c := &checker{input: replaceVariables(expr)}
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+	return c.warnings, nil
+}
+
+// CheckMatchers checks a list of label matchers, such as method="GET", code=~"5..".
+func CheckMatchers(matchers string) error {
+	c := &checker{input: replaceVariables(matchers)}
+	if err := c.matchers(0); err != nil {
+		return err
+	}
+	if c.pos < len(c.input) {
+		return newError(c.pos, "unexpected %q", c.input[c.pos:c.pos+1])
+	}
+	return nil
+}
+
+// replaceVariables replaces the Grafana template variables with a $ followed by underscores, which keeps
+// the positions of the errors and the balance of the brackets.
+func replaceVariables(expr string) string {
+	return grafanaVariableRegex.ReplaceAllStringFunc(expr, func(variable string) string {
+		return "$" + strings.Repeat("_", len(variable)-1)
+	})
+}
+
+// checker scans an expression
+type checker struct {
+	input    string
+	pos      int
+	warnings []string
+}
+
+func (c *checker) check() error {
+	// open holds the positions of the open parentheses
+	var open []int
+	empty := true
+	for c.skipSpaces(); c.pos < len(c.input); c.skipSpaces() {
+		empty = false
+		start := c.pos
+		ch := c.input[c.pos]
+		switch {
+		case ch == '(':
+			open = append(open, c.pos)
+			c.pos++
+		case ch == ')':
+			if len(open) == 0 {
+				return newError(c.pos, `unexpected ")"`)
+			}
+			open = open[:len(open)-1]
+			c.pos++
+		case ch == '{':
+			c.pos++
+			if err := c.matchers('}'); err != nil {
+				return err
+			}
+			if c.pos >= len(c.input) {
+				return newError(start, `unclosed "{"`)
+			}
+			c.pos++
+		case ch == '[':
+			if len(open) == 0 {
+				return newError(c.pos, "the expression must evaluate to an instant vector or a scalar to be graphed, "+
+					"a range can only be used within a function call such as rate")
+			}
+			if err := c.rangeSelector(); err != nil {
+				return err
+			}
+		case ch == '"' || ch == '\'' || ch == '`':
+			if _, err := c.str(); err != nil {
+				return err
+			}
+		case isLetter(ch):
+			c.identifier()
+		case isDigit(ch) || ch == '.':
+			c.number()
+		case ch == '$':
+			// A Grafana variable
+			c.pos++
+			for c.pos < len(c.input) && c.input[c.pos] == '_' {
+				c.pos++
+			}
+		case strings.IndexByte("+-*/%^=!<>,@:", ch) >= 0:
+			c.pos++
+		default:
+			return newError(c.pos, "unexpected character %q", string(ch))
+		}
+	}
+
+	if len(open) > 0 {
+		return newError(open[len(open)-1], `unclosed "("`)
+	}
+	if empty {
+		return newError(0, "no expression found")
+	}
+	return nil
+}
+
+// identifier scans a metric name, a keyword or a function name, and warns about unknown functions
+func (c *checker) identifier() {
+	start := c.pos
+	for c.pos < len(c.input) && (isLetter(c.input[c.pos]) || isDigit(c.input[c.pos]) || c.input[c.pos] == ':') {
+		c.pos++
+	}
+	name := c.input[start:c.pos]
+
+	next := c.pos
+	for next < len(c.input) && isSpace(c.input[next]) {
+		next++
+	}
+	if next == len(c.input) || c.input[next] != '(' || isKnownCall(strings.ToLower(name)) {
+		return
+	}
+	c.warnings = append(c.warnings,
+		fmt.Sprintf("unknown function with name %q at position %d, its arguments are not checked", name, start+1))
+}
+
+// number scans a number, such as 1.5e-3 or 0x1F, or a duration, such as 5m
+func (c *checker) number() {
+	hex := strings.HasPrefix(strings.ToLower(c.input[c.pos:]), "0x")
+	for c.pos < len(c.input) {
+		ch := c.input[c.pos]
+		switch {
+		case isLetter(ch) || isDigit(ch) || ch == '.':
+		case (ch == '+' || ch == '-') && !hex && (c.input[c.pos-1] == 'e' || c.input[c.pos-1] == 'E'):
+		default:
+			return
+		}
+		c.pos++
+	}
+}
+
+// rangeSelector scans a range, such as [5m], or the range and step of a subquery, such as [1h:1m]
+func (c *checker) rangeSelector() error {
+	start := c.pos
+	end := strings.IndexByte(c.input[start:], ']')
+	if end < 0 {
+		return newError(start, `unclosed "["`)
+	}
+	c.pos = start + end + 1
+
+	content := c.input[start+1 : start+end]
+	rng, step, subquery := strings.Cut(content, ":")
+	if !isDuration(rng) {
+		return newError(start+1, "invalid duration %q in range", strings.TrimSpace(rng))
+	}
+	if subquery && strings.TrimSpace(step) != "" && !isDuration(step) {
+		return newError(start+1, "invalid duration %q in subquery step", strings.TrimSpace(step))
+	}
+	return nil
+}
+
+// matchers scans a list of label matchers until end, or until the end of the input if end is 0
+func (c *checker) matchers(end byte) error {
+	for {
+		c.skipSpaces()
+		if c.pos >= len(c.input) || c.input[c.pos] == end {
+			return nil
+		}
+
+		if err := c.matcher(); err != nil {
+			return err
+		}
+
+		c.skipSpaces()
+		switch {
+		case c.pos >= len(c.input) || c.input[c.pos] == end:
+			return nil
+		case c.input[c.pos] == ',':
+			c.pos++
+		case end == 0:
+			return newError(c.pos, `unexpected %q, expected ","`, c.input[c.pos:c.pos+1])
+		default:
+			return newError(c.pos, `unexpected %q, expected "," or %q`, c.input[c.pos:c.pos+1], string(end))
+		}
+	}
+}
+
+// matcher scans a label matcher, such as job="api", or a quoted metric name, such as "my.metric"
+func (c *checker) matcher() error {
+	quoted := c.pos < len(c.input) && strings.IndexByte("\"'`", c.input[c.pos]) >= 0
+	if quoted {
+		if _, err := c.str(); err != nil {
+			return err
+		}
+	} else {
+		start := c.pos
+		for c.pos < len(c.input) && (isLetter(c.input[c.pos]) || (c.pos > start && isDigit(c.input[c.pos]))) {
+			c.pos++
+		}
+		if c.pos == start {
+			return newError(c.pos, "expected label name")
+		}
+	}
+
+	c.skipSpaces()
+	var op string
+	for _, candidate := range []string{"=~", "!~", "!=", "="} {
+		if strings.HasPrefix(c.input[c.pos:], candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		if quoted {
+			// A quoted metric name
+			return nil
+		}
+		return newError(c.pos, "expected label matching operator")
+	}
+	c.pos += len(op)
+	if strings.HasPrefix(c.input[c.pos:], "=") {
+		return newError(c.pos, "expected label matching operator")
+	}
+
+	c.skipSpaces()
+	start := c.pos
+	if c.pos >= len(c.input) || strings.IndexByte("\"'`", c.input[c.pos]) < 0 {
+		return newError(c.pos, "expected string")
+	}
+	value, err := c.str()
+	if err != nil {
+		return err
+	}
+	if op == "=~" || op == "!~" {
+		if _, err = regexp.Compile("^(?:" + value + ")$"); err != nil {
+			return newError(start, "invalid regular expression %q: %v", value, err)
+		}
+	}
+	return nil
+}
+
+// str scans a quoted string and returns its value
+func (c *checker) str() (string, error) {
+	start := c.pos
+	quote := c.input[c.pos]
+	for c.pos++; c.pos < len(c.input); c.pos++ {
+		switch c.input[c.pos] {
+		case '\\':
+			if quote != '`' {
+				c.pos++
+			}
+		case quote:
+			c.pos++
+			return unquote(c.input[start:c.pos]), nil
+		}
+	}
+	return "", newError(start, "unterminated quoted string")
+}
+
+// unquote returns the value of a quoted string, or its content if it cannot be unquoted
+func unquote(quoted string) string {
+	if quoted[0] == '\'' {
+		content := strings.ReplaceAll(quoted[1:len(quoted)-1], `\'`, `'`)
+		quoted = `"` + strings.ReplaceAll(content, `"`, `\"`) + `"`
+	}
+	value, err := strconv.Unquote(quoted)
+	if err != nil {
+		return quoted[1 : len(quoted)-1]
+	}
+	return value
+}
+
+func (c *checker) skipSpaces() {
+	for c.pos < len(c.input) {
+		switch {
+		case isSpace(c.input[c.pos]):
+			c.pos++
+		case c.input[c.pos] == '#':
+			// A comment runs until the end of the line
+			for c.pos < len(c.input) && c.input[c.pos] != '\n' {
+				c.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// isDuration returns true if value is a duration, such as 1h30m, or a Grafana variable
+func isDuration(value string) bool {
+	value = strings.TrimSpace(value)
+	return durationRegex.MatchString(value) || (strings.HasPrefix(value, "$") && strings.Trim(value, "$_") == "")
+}
+
+func isSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+func isLetter(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_'
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
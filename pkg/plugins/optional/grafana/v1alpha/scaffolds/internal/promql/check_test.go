@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promql
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Check", func() {
+	DescribeTable("should accept valid expressions",
+		func(expr string) {
+			warnings, err := Check(expr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		},
+		Entry("a number", "1.5e-3 + 0x1F - Inf"),
+		Entry("a metric", "up"),
+		Entry("a recording rule name", "job:http_requests:rate5m"),
+		Entry("a selector", `up{job="api", namespace!="", pod=~"api-.*", instance!~'10\\..*',}`),
+		Entry("a selector without metric name", `{__name__=~"http_.*", job="api"}`),
+		Entry("a quoted metric name", `{"my.metric", job="api"}`),
+		Entry("a rate", `rate(http_requests_total{job="api"}[1h30m])`),
+		Entry("an aggregation with a grouping clause",
+			"sum by (instance, pod) (rate(http_requests_total[5m])) / ignoring (pod) group_left count(up)"),
+		Entry("a histogram quantile",
+			"histogram_quantile(0.9, sum by (le) (rate(request_duration_seconds_bucket[5m])))"),
+		Entry("a subquery", "max_over_time(rate(x[5m])[1h:1m]) + min_over_time(x[1h:])"),
+		Entry("offset and @ modifiers", "rate(x[5m] offset -5m @ start()) > bool 1"),
+		Entry("a comment", "up # the targets that are up"),
+		Entry("Grafana variables",
+			`sum(rate(x{job="$job", namespace="${namespace}"}[$__rate_interval])) by ([[group]]) offset $offset`),
+	)
+
+	DescribeTable("should reject invalid expressions",
+		func(expr, message string) {
+			_, err := Check(expr)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("an empty expression", " # nothing ", "no expression found"),
+		Entry("an unclosed parenthesis", "sum(rate(x[5m])", `parse error at position 4: unclosed "("`),
+		Entry("an unexpected parenthesis", "sum(x))", `unexpected ")"`),
+		Entry("an unclosed brace", `x{job="api"`, `unclosed "{"`),
+		Entry("an unclosed bracket", "rate(x[5m)", `unclosed "["`),
+		Entry("a range vector", "x[5m]", "must evaluate to an instant vector or a scalar"),
+		Entry("a subquery", "rate(x[5m])[1h:]", "must evaluate to an instant vector or a scalar"),
+		Entry("an invalid duration", "rate(x[5min])", `invalid duration "5min" in range`),
+		Entry("an invalid subquery step", "max_over_time(x[1h:x])", `invalid duration "x" in subquery step`),
+		Entry("an invalid matcher operator", `x{job=="api"}`, "expected label matching operator"),
+		Entry("a missing matcher operator", `x{job}`, "expected label matching operator"),
+		Entry("an unquoted matcher value", "x{job=api}", "expected string"),
+		Entry("an invalid regular expression", `x{job=~"("}`, "invalid regular expression"),
+		Entry("a missing comma between matchers", `x{a="1" b="2"}`, `expected "," or "}"`),
+		Entry("an unterminated string", `x{job="api}`, "unterminated quoted string"),
+		Entry("an unexpected character", "x ; y", "unexpected character"),
+	)
+
+	It("should warn about unknown functions instead of rejecting them", func() {
+		warnings, err := Check("sum(holt_winters(x[1h], 0.5, 0.5)) / 2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(`unknown function with name "holt_winters" at position 5, ` +
+			"its arguments are not checked"))
+	})
+})
+
+var _ = Describe("CheckMatchers", func() {
+	It("should accept label matchers", func() {
+		Expect(CheckMatchers(`method="GET", code=~"5..", handler!="", job="$job"`)).To(Succeed())
+	})
+
+	It("should reject invalid label matchers", func() {
+		Expect(CheckMatchers(`method=GET`)).To(MatchError(ContainSubstring("expected string")))
+		Expect(CheckMatchers(`method="GET"}`)).To(MatchError(ContainSubstring(`unexpected "}", expected ","`)))
+	})
+})
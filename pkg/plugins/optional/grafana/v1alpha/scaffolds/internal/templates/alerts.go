@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templates
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// AlertRulesConfig represents the configuration for the alerting rules
+type AlertRulesConfig struct {
+	Selector      string          `json:"selector,omitempty"`
+	DatasourceUID string          `json:"datasourceUid,omitempty"`
+	AlertRules    []AlertRuleItem `json:"alertRules"`
+}
+
+// AlertRuleItem defines the config items for the alerting rules
+type AlertRuleItem struct {
+	Alert     string   `json:"alert"`
+	Threshold *float64 `json:"threshold,omitempty"`
+	For       string   `json:"for,omitempty"`
+	Severity  string   `json:"severity,omitempty"`
+	Disabled  bool     `json:"disabled,omitempty"`
+}
+
+// RecordingRule is a Prometheus recording rule built on the controller-runtime metrics
+type RecordingRule struct {
+	Record string
+	Expr   string
+}
+
+// AlertRule is an alerting rule rendered both as a Prometheus rule and as a Grafana alert rule
+type AlertRule struct {
+	// Alert is the name of the alert
+	Alert string
+	// UID identifies the Grafana alert rule
+	UID string
+	// Expr is the Prometheus rule expression, built on the recording rules
+	Expr string
+	// Query is the Grafana query, built on the raw metrics as the recording rules may not be deployed
+	Query string
+	// Threshold is the value of the Grafana query that fires the alert
+	Threshold string
+	For       string
+	Severity  string
+
+	Summary     string
+	Description string
+	// GrafanaDescription is the description using the Grafana template variables
+	GrafanaDescription string
+}
+
+var _ machinery.Template = &AlertRulesConfigManifest{}
+
+// AlertRulesConfigManifest scaffolds a file to configure the thresholds of the alerting rules
+type AlertRulesConfigManifest struct {
+	machinery.TemplateMixin
+	ConfigPath string
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *AlertRulesConfigManifest) SetTemplateDefaults() error {
+	f.Path = f.ConfigPath
+
+	f.TemplateBody = alertRulesConfigTemplate
+
+	f.IfExistsAction = machinery.SkipFile
+
+	return nil
+}
+
+var _ machinery.Template = &PrometheusRuleManifest{}
+
+// PrometheusRuleManifest scaffolds a PrometheusRule with the recording and alerting rules
+type PrometheusRuleManifest struct {
+	machinery.TemplateMixin
+	machinery.ProjectNameMixin
+
+	RecordingRules []RecordingRule
+	AlertRules     []AlertRule
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *PrometheusRuleManifest) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "prometheus", "rules.yaml")
+	}
+
+	// The annotations hold Prometheus templates such as {{ $labels.controller }}, which collide with the
+	// default delimiter for go template parsing.
+	f.SetDelim("[[", "]]")
+	f.TemplateBody = prometheusRuleTemplate
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+var _ machinery.Template = &GrafanaAlertRulesManifest{}
+
+// GrafanaAlertRulesManifest scaffolds the alerting rules in the Grafana provisioning format
+type GrafanaAlertRulesManifest struct {
+	machinery.TemplateMixin
+	machinery.ProjectNameMixin
+
+	DatasourceUID string
+	AlertRules    []AlertRule
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *GrafanaAlertRulesManifest) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("grafana", "alerts", "alert-rules.yaml")
+	}
+
+	// The annotations hold Grafana templates such as {{ $labels.controller }}, which collide with the
+	// default delimiter for go template parsing.
+	f.SetDelim("[[", "]]")
+	f.TemplateBody = grafanaAlertRulesTemplate
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const alertRulesConfigTemplate = `---
+# Label selector of the series used by the rules (optional).
+# Defaults to the job of the Service exposing the controller manager metrics, i.e.:
+# selector: job="<project-name>-controller-manager-metrics-service"
+#
+# UID of the Prometheus data source used by the Grafana alert rules (optional, defaults to prometheus).
+# datasourceUid: prometheus
+#
+# Alerting rules, alerts that are not listed use their default values.
+alertRules:
+  # Ratio of the reconciliations that end with an error.
+  - alert: ReconcileErrorRatioHigh
+    threshold: 0.1
+    for: 15m
+    severity: warning
+  # P99 reconciliation latency, in seconds.
+  - alert: ReconcileLatencyHigh
+    threshold: 5
+    for: 15m
+    severity: warning
+  # Number of items waiting in a workqueue while its depth keeps growing.
+  - alert: WorkqueueDepthGrowing
+    threshold: 100
+    for: 15m
+    severity: warning
+  # Ratio of the webhook requests answered with an error code.
+  - alert: WebhookErrorRatioHigh
+    threshold: 0.05
+    for: 10m
+    severity: critical
+#  - alert:     # Name of one of the alerts above (required)
+#    threshold: # Value that fires the alert (optional)
+#    for:       # How long the condition must hold before the alert fires, e.g. 10m (optional)
+#    severity:  # Value of the severity label (optional)
+#    disabled:  # Set to true to drop the alert (optional)
+`
+
+const prometheusRuleTemplate = `# Prometheus Rules (Recording & Alerting)
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  labels:
+    control-plane: controller-manager
+    app.kubernetes.io/name: [[ .ProjectName ]]
+    app.kubernetes.io/managed-by: kustomize
+  name: controller-manager-rules
+  namespace: system
+spec:
+  groups:
+  - name: [[ .ProjectName ]]-recording-rules
+    rules:
+[[- range .RecordingRules ]]
+    - record: [[ .Record ]]
+      expr: |-
+        [[ .Expr ]]
+[[- end ]]
+[[- if .AlertRules ]]
+  - name: [[ .ProjectName ]]-alerting-rules
+    rules:
+[[- range .AlertRules ]]
+    - alert: [[ .Alert ]]
+      expr: |-
+        [[ .Expr ]]
+      for: [[ .For ]]
+      labels:
+        severity: [[ .Severity ]]
+      annotations:
+        summary: '[[ .Summary ]]'
+        description: '[[ .Description ]]'
+[[- end ]]
+[[- end ]]
+`
+
+//nolint:lll
+const grafanaAlertRulesTemplate = `# Grafana alert rules, see https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/
+apiVersion: 1
+groups:
+  - orgId: 1
+    name: [[ .ProjectName ]]
+    folder: [[ .ProjectName ]]
+    interval: 1m
+    rules:
+[[- range .AlertRules ]]
+      - uid: [[ .UID ]]
+        title: [[ .Alert ]]
+        condition: C
+        data:
+          - refId: A
+            relativeTimeRange:
+              from: 600
+              to: 0
+            datasourceUid: [[ $.DatasourceUID ]]
+            model:
+              refId: A
+              instant: true
+              expr: |-
+                [[ .Query ]]
+          - refId: C
+            datasourceUid: __expr__
+            model:
+              refId: C
+              type: threshold
+              expression: A
+              conditions:
+                - evaluator:
+                    type: gt
+                    params:
+                      - [[ .Threshold ]]
+        noDataState: OK
+        execErrState: Error
+        for: [[ .For ]]
+        labels:
+          severity: [[ .Severity ]]
+        annotations:
+          summary: '[[ .Summary ]]'
+          description: '[[ .GrafanaDescription ]]'
+[[- end ]]
+`
@@ -42,8 +42,9 @@ func (f *CustomMetricsConfigManifest) SetTemplateDefaults() error {
 const customMetricsConfigTemplate = `---
 customMetrics:
 #  - metric: # Raw custom metric (required)
-#    type:   # Metric type: counter/gauge/histogram (required)
+#    type:   # Metric type: counter/gauge/histogram/summary (required)
 #    expr:   # Prom_ql for the metric (optional)
+#    selector: # Label matchers which filter the generated expr, example: method="GET" (optional)
 #    unit:   # Unit of measurement, examples: s,none,bytes,percent,etc. (optional)
 #
 #
@@ -54,4 +55,7 @@ customMetrics:
 #     unit: none
 #     type: histogram
 #   	expr: histogram_quantile(0.90, sum by(instance, le) (rate(foo_bar{job=\"$job\", namespace=\"$namespace\"}[5m])))
+#   - metric: http_requests_total
+#     type: counter
+#     selector: code=~"5.."
 `
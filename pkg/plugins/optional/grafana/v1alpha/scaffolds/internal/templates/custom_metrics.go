@@ -37,6 +37,12 @@ type CustomMetricItem struct {
 	Type   string `json:"type"`
 	Expr   string `json:"expr,omitempty"`
 	Unit   string `json:"unit,omitempty"`
+	// Selector holds label matchers, such as method="GET", which filter the generated expression
+	Selector string `json:"selector,omitempty"`
+	// Labels are added to the grouping of the generated expression, so that each value gets its own series
+	Labels []string `json:"labels,omitempty"`
+	// Help is the description of the panel
+	Help string `json:"help,omitempty"`
 }
 
 var _ machinery.Template = &CustomMetricsDashManifest{}
@@ -129,7 +135,8 @@ const customMetricsDashTemplate = `{
   "liveNow": false,
   "panels": [{{ $n := len . }}{{ range $i, $e := . }}
     {
-      "datasource": "${DS_PROMETHEUS}",
+      "datasource": "${DS_PROMETHEUS}",{{ if .Help }}
+      "description": "{{ .Help }}",{{ end }}
       "fieldConfig": {
         "defaults": {
           "color": {
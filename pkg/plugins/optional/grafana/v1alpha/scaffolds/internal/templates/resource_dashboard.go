@@ -0,0 +1,463 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templates
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ machinery.Template = &ResourceDashboardManifest{}
+
+// ResourceDashboardManifest scaffolds a dashboard with a row per resource of the project, showing the
+// reconciliation metrics of its controllers and the admission metrics of its webhooks
+type ResourceDashboardManifest struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+
+	Resources []resource.Resource
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *ResourceDashboardManifest) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("grafana", "controller-per-resource-metrics.json")
+	}
+
+	defaultTemplate, err := f.createTemplate()
+	if err != nil {
+		return err
+	}
+
+	// The generated dashboard holds Grafana legends such as {{result}}, which collide with the default
+	// delimiter for go template parsing.
+	f.SetDelim("[[", "]]")
+	f.TemplateBody = defaultTemplate
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+// dashboardPanel is a panel of the per-resource dashboard, either a row or a time series
+type dashboardPanel struct {
+	ID          int
+	Row         bool
+	Title       string
+	Description string
+	Unit        string
+	Grid        gridPos
+	Targets     []dashboardTarget
+}
+
+// gridPos is the position and size of a panel in the dashboard grid, which is 24 columns wide
+type gridPos struct {
+	X, Y, W, H int
+}
+
+// dashboardTarget is a Prometheus query of a panel
+type dashboardTarget struct {
+	RefID  string
+	Expr   string
+	Legend string
+}
+
+const (
+	panelHeight = 8
+	// selector filters the metrics of the manager with the variables of the dashboard
+	selector = `job="$job", namespace="$namespace"`
+)
+
+// panels returns the panels of the dashboard: for every resource with controllers or webhooks, a row
+// followed by the reconciliation rate, error rate and latency of each controller, and the latency and
+// rejections of its defaulting and validating webhooks.
+func (f *ResourceDashboardManifest) panels() []dashboardPanel {
+	var panels []dashboardPanel
+	y := 0
+	add := func(p dashboardPanel) {
+		p.ID = len(panels) + 1
+		panels = append(panels, p)
+	}
+
+	for _, res := range f.Resources {
+		controllers := res.GetControllerNames()
+		webhookPaths, webhookNames := webhooks(res)
+		if len(controllers) == 0 && len(webhookPaths) == 0 {
+			continue
+		}
+
+		add(dashboardPanel{
+			Row:   true,
+			Title: fmt.Sprintf("%s (%s/%s)", res.Kind, res.QualifiedGroup(), res.Version),
+			Grid:  gridPos{Y: y, W: 24, H: 1},
+		})
+		y++
+
+		for _, name := range controllers {
+			controller := resource.GetControllerName(name, res.Kind, res.Group, f.MultiGroup)
+			filter := fmt.Sprintf(`%s, controller=%q`, selector, controller)
+			add(dashboardPanel{
+				Title:       fmt.Sprintf("Reconciliation Rate (%s)", controller),
+				Description: "Reconciliations per second by result",
+				Unit:        "ops",
+				Grid:        gridPos{X: 0, Y: y, W: 8, H: panelHeight},
+				Targets: []dashboardTarget{{
+					RefID:  "A",
+					Expr:   fmt.Sprintf(`sum(rate(controller_runtime_reconcile_total{%s}[5m])) by (result)`, filter),
+					Legend: "{{result}}",
+				}},
+			})
+			add(dashboardPanel{
+				Title:       fmt.Sprintf("Reconciliation Error Rate (%s)", controller),
+				Description: "Reconciliation errors per second",
+				Unit:        "ops",
+				Grid:        gridPos{X: 8, Y: y, W: 8, H: panelHeight},
+				Targets: []dashboardTarget{{
+					RefID:  "A",
+					Expr:   fmt.Sprintf(`sum(rate(controller_runtime_reconcile_errors_total{%s}[5m]))`, filter),
+					Legend: "errors",
+				}},
+			})
+			add(dashboardPanel{
+				Title:       fmt.Sprintf("Reconciliation Latency (%s) (P50, P90, P99)", controller),
+				Description: "Time spent per reconciliation",
+				Unit:        "s",
+				Grid:        gridPos{X: 16, Y: y, W: 8, H: panelHeight},
+				Targets: quantileTargets(
+					fmt.Sprintf(`controller_runtime_reconcile_time_seconds_bucket{%s}`, filter), "le", ""),
+			})
+			y += panelHeight
+		}
+
+		if len(webhookPaths) == 0 {
+			continue
+		}
+		filter := fmt.Sprintf(`%s, webhook=~%q`, selector, strings.Join(webhookPaths, "|"))
+		add(dashboardPanel{
+			Title:       fmt.Sprintf("Webhook Latency (%s) (P50, P90, P99)", res.Kind),
+			Description: "Time spent per admission request by webhook path",
+			Unit:        "s",
+			Grid:        gridPos{X: 0, Y: y, W: 12, H: panelHeight},
+			Targets: quantileTargets(
+				fmt.Sprintf(`controller_runtime_webhook_latency_seconds_bucket{%s}`, filter), "le, webhook", " {{webhook}}"),
+		})
+		add(dashboardPanel{
+			Title: fmt.Sprintf("Webhook Rejections (%s)", res.Kind),
+			Description: "Admission requests rejected by the webhooks, as reported by the API server " +
+				"(requires its metrics to be collected), and requests that failed in the manager",
+			Unit: "ops",
+			Grid: gridPos{X: 12, Y: y, W: 12, H: panelHeight},
+			Targets: []dashboardTarget{
+				{
+					RefID: "A",
+					Expr: fmt.Sprintf(`sum(rate(apiserver_admission_webhook_rejection_count{name=~%q}[5m])) `+
+						`by (name, rejection_code)`, strings.Join(webhookNames, "|")),
+					Legend: "{{name}} rejected ({{rejection_code}})",
+				},
+				{
+					RefID: "B",
+					Expr: fmt.Sprintf(`sum(rate(controller_runtime_webhook_requests_total{%s, code!="200"}[5m])) `+
+						`by (webhook, code)`, filter),
+					Legend: "{{webhook}} failed (HTTP {{code}})",
+				},
+			},
+		})
+		y += panelHeight
+	}
+
+	return panels
+}
+
+// quantileTargets returns the P50, P90 and P99 queries for the histogram buckets, aggregated by the labels
+func quantileTargets(buckets, by, legendSuffix string) []dashboardTarget {
+	targets := make([]dashboardTarget, 0, 3)
+	for i, quantile := range []string{"50", "90", "99"} {
+		targets = append(targets, dashboardTarget{
+			RefID:  string(rune('A' + i)),
+			Expr:   fmt.Sprintf(`histogram_quantile(0.%s, sum(rate(%s[5m])) by (%s))`, quantile, buckets, by),
+			Legend: "P" + quantile + legendSuffix,
+		})
+	}
+	return targets
+}
+
+// webhooks returns the paths and names of the defaulting and validating webhooks of the resource, as set
+// in the markers scaffolded by the go/v4 plugin
+func webhooks(res resource.Resource) ([]string, []string) {
+	var paths, names []string
+	if res.Webhooks == nil {
+		return paths, names
+	}
+
+	group := strings.ReplaceAll(res.QualifiedGroup(), ".", "-")
+	if res.Core && res.QualifiedGroup() == "core" {
+		group = ""
+	}
+	suffix := fmt.Sprintf("%s-%s-%s", group, res.Version, strings.ToLower(res.Kind))
+	name := fmt.Sprintf("%s-%s.kb.io", strings.ToLower(res.Kind), res.Version)
+
+	if res.HasDefaultingWebhook() {
+		path := res.Webhooks.DefaultingPath
+		if path == "" {
+			path = "/mutate-" + suffix
+		}
+		paths = append(paths, path)
+		names = append(names, "m"+name)
+	}
+	if res.HasValidationWebhook() {
+		path := res.Webhooks.ValidationPath
+		if path == "" {
+			path = "/validate-" + suffix
+		}
+		paths = append(paths, path)
+		names = append(names, "v"+name)
+	}
+	return paths, names
+}
+
+var resourceDashboardFns = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"last": func(i int, panels []dashboardPanel) bool {
+		return i == len(panels)-1
+	},
+}
+
+func (f *ResourceDashboardManifest) createTemplate() (string, error) {
+	t := template.Must(template.New("resourceDashboardTemplate").Funcs(resourceDashboardFns).
+		Parse(resourceDashboardTemplate))
+
+	outputTmpl := &bytes.Buffer{}
+	if err := t.Execute(outputTmpl, f.panels()); err != nil {
+		return "", fmt.Errorf("error when generating the per-resource dashboard: %w", err)
+	}
+
+	return outputTmpl.String(), nil
+}
+
+//nolint:lll
+const resourceDashboardTemplate = `{
+  "__inputs": [
+    {
+      "name": "DS_PROMETHEUS",
+      "label": "Prometheus",
+      "description": "",
+      "type": "datasource",
+      "pluginId": "prometheus",
+      "pluginName": "Prometheus"
+    }
+  ],
+  "__requires": [
+    {
+      "type": "datasource",
+      "id": "prometheus",
+      "name": "Prometheus",
+      "version": "1.0.0"
+    }
+  ],
+  "annotations": {
+    "list": [
+      {
+        "builtIn": 1,
+        "datasource": "-- Grafana --",
+        "enable": true,
+        "hide": true,
+        "iconColor": "rgba(0, 211, 255, 1)",
+        "name": "Annotations & Alerts",
+        "target": {
+          "limit": 100,
+          "matchAny": false,
+          "tags": [],
+          "type": "dashboard"
+        },
+        "type": "dashboard"
+      }
+    ]
+  },
+  "editable": true,
+  "fiscalYearStartMonth": 0,
+  "graphTooltip": 0,
+  "links": [],
+  "liveNow": false,
+  "panels": [{{ range $i, $p := . }}
+{{- if $p.Row }}
+    {
+      "collapsed": false,
+      "gridPos": {
+        "h": {{ $p.Grid.H }},
+        "w": {{ $p.Grid.W }},
+        "x": {{ $p.Grid.X }},
+        "y": {{ $p.Grid.Y }}
+      },
+      "id": {{ $p.ID }},
+      "panels": [],
+      "title": {{ json $p.Title }},
+      "type": "row"
+    }
+{{- else }}
+    {
+      "datasource": "${DS_PROMETHEUS}",
+      "description": {{ json $p.Description }},
+      "fieldConfig": {
+        "defaults": {
+          "color": {
+            "mode": "palette-classic"
+          },
+          "custom": {
+            "axisLabel": "",
+            "axisPlacement": "auto",
+            "barAlignment": 0,
+            "drawStyle": "line",
+            "fillOpacity": 20,
+            "gradientMode": "none",
+            "hideFrom": {
+              "legend": false,
+              "tooltip": false,
+              "viz": false
+            },
+            "lineInterpolation": "smooth",
+            "lineWidth": 2,
+            "pointSize": 5,
+            "scaleDistribution": {
+              "type": "linear"
+            },
+            "showPoints": "auto",
+            "spanNulls": false,
+            "stacking": {
+              "group": "A",
+              "mode": "none"
+            },
+            "thresholdsStyle": {
+              "mode": "off"
+            }
+          },
+          "mappings": [],
+          "thresholds": {
+            "mode": "absolute",
+            "steps": [
+              {
+                "color": "green",
+                "value": null
+              }
+            ]
+          },
+          "unit": {{ json $p.Unit }}
+        },
+        "overrides": []
+      },
+      "gridPos": {
+        "h": {{ $p.Grid.H }},
+        "w": {{ $p.Grid.W }},
+        "x": {{ $p.Grid.X }},
+        "y": {{ $p.Grid.Y }}
+      },
+      "id": {{ $p.ID }},
+      "interval": "1m",
+      "options": {
+        "legend": {
+          "calcs": [],
+          "displayMode": "list",
+          "placement": "bottom",
+          "showLegend": true
+        },
+        "tooltip": {
+          "mode": "multi",
+          "sort": "none"
+        }
+      },
+      "targets": [{{ range $j, $t := $p.Targets }}{{ if $j }},{{ end }}
+        {
+          "datasource": "${DS_PROMETHEUS}",
+          "exemplar": true,
+          "expr": {{ json $t.Expr }},
+          "interval": "",
+          "legendFormat": {{ json $t.Legend }},
+          "refId": {{ json $t.RefID }}
+        }{{ end }}
+      ],
+      "title": {{ json $p.Title }},
+      "type": "timeseries"
+    }
+{{- end }}{{ if not (last $i $) }},{{ end }}{{ end }}
+  ],
+  "refresh": "",
+  "style": "dark",
+  "tags": [],
+  "templating": {
+    "list": [
+      {
+        "datasource": "${DS_PROMETHEUS}",
+        "definition": "label_values(controller_runtime_reconcile_total{namespace=~\"$namespace\"}, job)",
+        "hide": 0,
+        "includeAll": false,
+        "multi": false,
+        "name": "job",
+        "options": [],
+        "query": {
+          "query": "label_values(controller_runtime_reconcile_total{namespace=~\"$namespace\"}, job)",
+          "refId": "StandardVariableQuery"
+        },
+        "refresh": 2,
+        "regex": "",
+        "skipUrlSync": false,
+        "sort": 0,
+        "type": "query"
+      },
+      {
+        "current": {
+          "selected": false,
+          "text": "observability",
+          "value": "observability"
+        },
+        "datasource": "${DS_PROMETHEUS}",
+        "definition": "label_values(controller_runtime_reconcile_total, namespace)",
+        "hide": 0,
+        "includeAll": false,
+        "multi": false,
+        "name": "namespace",
+        "options": [],
+        "query": {
+          "query": "label_values(controller_runtime_reconcile_total, namespace)",
+          "refId": "StandardVariableQuery"
+        },
+        "refresh": 1,
+        "regex": "",
+        "skipUrlSync": false,
+        "sort": 0,
+        "type": "query"
+      }
+    ]
+  },
+  "time": {
+    "from": "now-15m",
+    "to": "now"
+  },
+  "timepicker": {},
+  "timezone": "",
+  "title": "Controller-Per-Resource-Metrics",
+  "weekStart": ""
+}
+`
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/grafana/v1alpha/scaffolds/internal/templates"
+)
+
+var _ plugins.Scaffolder = &resourceDashboardScaffolder{}
+
+type resourceDashboardScaffolder struct {
+	config config.Config
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewResourceDashboardScaffolder returns a new Scaffolder that regenerates the per-resource dashboard
+// with the resources of the project
+func NewResourceDashboardScaffolder(cfg config.Config) plugins.Scaffolder {
+	return &resourceDashboardScaffolder{config: cfg}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *resourceDashboardScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *resourceDashboardScaffolder) Scaffold() error {
+	log.Info("Updating the Grafana per-resource dashboard...")
+
+	dashboard, err := resourceDashboard(s.config)
+	if err != nil {
+		return err
+	}
+
+	scaffold := machinery.NewScaffold(s.fs, machinery.WithConfig(s.config))
+	if err = scaffold.Execute(dashboard); err != nil {
+		return fmt.Errorf("error scaffolding Grafana per-resource dashboard: %w", err)
+	}
+	return nil
+}
+
+// resourceDashboard returns the template of the per-resource dashboard for the resources of the project
+func resourceDashboard(cfg config.Config) (*templates.ResourceDashboardManifest, error) {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources: %w", err)
+	}
+	return &templates.ResourceDashboardManifest{Resources: resources}, nil
+}
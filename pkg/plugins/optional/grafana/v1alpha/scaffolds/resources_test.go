@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Resource Dashboard Scaffolder", func() {
+	type target struct {
+		Expr   string `json:"expr"`
+		Legend string `json:"legendFormat"`
+	}
+	type panel struct {
+		Title   string   `json:"title"`
+		Type    string   `json:"type"`
+		Targets []target `json:"targets"`
+	}
+
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+	)
+
+	dashboardPath := filepath.Join("grafana", "controller-per-resource-metrics.json")
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = cfgv3.New()
+		Expect(cfg.SetDomain("example.org")).To(Succeed())
+		Expect(cfg.SetRepository("example.org/project")).To(Succeed())
+	})
+
+	addResource := func(group, version, kind string, update func(*resource.Resource)) {
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: group, Domain: "example.org", Version: version, Kind: kind},
+			Plural: resource.RegularPlural(kind),
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+		if update != nil {
+			update(&res)
+		}
+		Expect(cfg.AddResource(res)).To(Succeed())
+	}
+
+	scaffoldPanels := func() []panel {
+		scaffolder := NewResourceDashboardScaffolder(cfg)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		content, err := afero.ReadFile(fs.FS, dashboardPath)
+		Expect(err).NotTo(HaveOccurred())
+		var dashboard struct {
+			Panels []panel `json:"panels"`
+		}
+		Expect(json.Unmarshal(content, &dashboard)).To(Succeed(), "the dashboard should be valid JSON")
+		return dashboard.Panels
+	}
+
+	titles := func(panels []panel) []string {
+		result := make([]string, 0, len(panels))
+		for _, p := range panels {
+			result = append(result, p.Title)
+		}
+		return result
+	}
+
+	It("should add a row with the reconciliation panels of each controller", func() {
+		addResource("crew", "v1", "Captain", func(res *resource.Resource) { res.Controller = true })
+		addResource("crew", "v1", "Admiral", nil)
+
+		panels := scaffoldPanels()
+		Expect(titles(panels)).To(Equal([]string{
+			"Captain (crew.example.org/v1)",
+			"Reconciliation Rate (captain)",
+			"Reconciliation Error Rate (captain)",
+			"Reconciliation Latency (captain) (P50, P90, P99)",
+		}))
+		Expect(panels[0].Type).To(Equal("row"))
+		Expect(panels[1].Targets[0].Expr).To(Equal(`sum(rate(controller_runtime_reconcile_total{job="$job", ` +
+			`namespace="$namespace", controller="captain"}[5m])) by (result)`))
+		Expect(panels[1].Targets[0].Legend).To(Equal("{{result}}"))
+		Expect(panels[3].Targets).To(HaveLen(3))
+	})
+
+	It("should use the controller names set in Named() for named controllers in multigroup projects", func() {
+		Expect(cfg.SetMultiGroup()).To(Succeed())
+		addResource("crew", "v1", "Captain", func(res *resource.Resource) {
+			res.Controllers = &resource.Controllers{}
+			Expect(res.Controllers.AddController("captain")).To(Succeed())
+			Expect(res.Controllers.AddController("captain-backup")).To(Succeed())
+		})
+
+		panels := scaffoldPanels()
+		Expect(titles(panels)).To(ContainElements(
+			"Reconciliation Rate (crew-captain)",
+			"Reconciliation Rate (crew-captain-backup)",
+		))
+		Expect(panels[1].Targets[0].Expr).To(ContainSubstring(`controller="crew-captain"`))
+	})
+
+	It("should add the webhook panels for the resources with webhooks", func() {
+		addResource("ship", "v1", "Frigate", func(res *resource.Resource) {
+			res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true, Validation: true}
+		})
+		addResource("ship", "v2", "Cruiser", func(res *resource.Resource) {
+			res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Validation: true, ValidationPath: "/custom"}
+		})
+		addResource("ship", "v1", "Destroyer", func(res *resource.Resource) {
+			res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true}
+		})
+
+		panels := scaffoldPanels()
+		Expect(titles(panels)).To(Equal([]string{
+			"Frigate (ship.example.org/v1)",
+			"Webhook Latency (Frigate) (P50, P90, P99)",
+			"Webhook Rejections (Frigate)",
+			"Cruiser (ship.example.org/v2)",
+			"Webhook Latency (Cruiser) (P50, P90, P99)",
+			"Webhook Rejections (Cruiser)",
+		}))
+		Expect(panels[1].Targets[0].Expr).To(ContainSubstring(
+			`webhook=~"/mutate-ship-example-org-v1-frigate|/validate-ship-example-org-v1-frigate"`))
+		Expect(panels[2].Targets[0].Expr).To(ContainSubstring(`name=~"mfrigate-v1.kb.io|vfrigate-v1.kb.io"`))
+		Expect(panels[2].Targets[1].Expr).To(ContainSubstring(`code!="200"`))
+		Expect(panels[4].Targets[0].Expr).To(ContainSubstring(`webhook=~"/custom"`))
+	})
+
+	It("should generate an empty dashboard for projects without resources", func() {
+		Expect(scaffoldPanels()).To(BeEmpty())
+	})
+
+	It("should be generated by the edit scaffolder when the project configuration is set", func() {
+		addResource("crew", "v1", "Captain", func(res *resource.Resource) { res.Controller = true })
+
+		scaffolder := NewEditScaffolder(cfg)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		content, err := afero.ReadFile(fs.FS, dashboardPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`Captain (crew.example.org/v1)`))
+	})
+})
@@ -19,7 +19,6 @@ package v1alpha
 import (
 	"fmt"
 	log "log/slog"
-	"os"
 	"path/filepath"
 
 	"github.com/spf13/pflag"
@@ -36,6 +35,9 @@ var _ plugin.EditSubcommand = &editSubcommand{}
 type editSubcommand struct {
 	config config.Config
 	force  bool
+
+	// fs is the filesystem injected in Scaffold, also used to edit the project files in PostScaffold
+	fs machinery.Filesystem
 }
 
 //nolint:lll
@@ -48,7 +50,7 @@ the latest Helm chart with your most recent changes.`
 	subcmdMeta.Examples = fmt.Sprintf(`# Initialize or update a Helm chart to distribute the project under the dist/ directory
   %[1]s edit --plugins=%[2]s
 
-# Update the Helm chart under the dist/ directory and overwrite all files
+# Update the Helm chart under the dist/ directory and regenerate the preserved files, merging manual edits
   %[1]s edit --plugins=%[2]s --force
 
 **IMPORTANT**: If the "--force" flag is not used, the following files will not be updated to preserve your customizations:
@@ -79,6 +81,8 @@ func (p *editSubcommand) InjectConfig(c config.Config) error {
 }
 
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
+	p.fs = fs
+
 	scaffolder := scaffolds.NewHelmScaffolder(p.config, p.force)
 	scaffolder.InjectFS(fs)
 	err := scaffolder.Scaffold()
@@ -96,7 +100,7 @@ func (p *editSubcommand) PostScaffold() error {
 
 	if hasWebhooks {
 		workflowFile := filepath.Join(".github", "workflows", "test-chart.yml")
-		if _, err := os.Stat(workflowFile); err != nil {
+		if _, err := p.fs.FS.Stat(workflowFile); err != nil {
 			log.Info(
 				"Workflow file not found, unable to uncomment cert-manager installation",
 				"error", err,
@@ -117,8 +121,9 @@ func (p *editSubcommand) PostScaffold() error {
 #          kubectl wait --namespace cert-manager --for=condition=available --timeout=300s deployment/cert-manager
 #          kubectl wait --namespace cert-manager --for=condition=available --timeout=300s deployment/cert-manager-cainjector
 #          kubectl wait --namespace cert-manager --for=condition=available --timeout=300s deployment/cert-manager-webhook`
-		if err := util.UncommentCode(workflowFile, target, "#"); err != nil {
-			hasUncommented, errCheck := util.HasFileContentWith(workflowFile, "- name: Install cert-manager via Helm")
+		editor := util.NewFileEditor(p.fs)
+		if err := editor.UncommentCode(workflowFile, target, "#"); err != nil {
+			hasUncommented, errCheck := editor.HasFileContentWith(workflowFile, "- name: Install cert-manager via Helm")
 			if !hasUncommented || errCheck != nil {
 				log.Warn("Failed to uncomment cert-manager installation in workflow file", "error", err, "file", workflowFile)
 			}
@@ -28,6 +28,7 @@ var _ machinery.Template = &Deployment{}
 type Deployment struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	// DeployImages if true will scaffold the env with the images
 	DeployImages bool
@@ -46,7 +47,7 @@ func (f *Deployment) SetTemplateDefaults() error {
 	f.TemplateBody = managerDeploymentTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
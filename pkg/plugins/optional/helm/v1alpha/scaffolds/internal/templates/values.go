@@ -27,6 +27,7 @@ var _ machinery.Template = &HelmValues{}
 type HelmValues struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	// DeployImages stores the images used for the DeployImage plugin
 	DeployImages map[string]string
@@ -44,7 +45,7 @@ func (f *HelmValues) SetTemplateDefaults() error {
 	f.TemplateBody = helmValuesTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
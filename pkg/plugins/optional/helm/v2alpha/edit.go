@@ -51,6 +51,9 @@ type editSubcommand struct {
 	force         bool
 	manifestsFile string
 	outputDir     string
+
+	// fs is the filesystem injected in Scaffold, also used to edit the project files in PostScaffold
+	fs machinery.Filesystem
 }
 
 //nolint:lll
@@ -64,7 +67,7 @@ when the kustomize output does not provide them. When enabled, adds Helm helpers
 	subcmdMeta.Examples = fmt.Sprintf(`# Generate Helm chart from default manifests (dist/install.yaml) to default output (dist/)
   %[1]s edit --plugins=%[2]s
 
-# Generate Helm chart and overwrite existing files (useful for updates)
+# Generate Helm chart and regenerate existing files, merging manual edits (useful for updates)
   %[1]s edit --plugins=%[2]s --force
 
 # Generate Helm chart from a custom manifests file
@@ -85,7 +88,8 @@ Without --force, the plugin also preserves values.yaml, NOTES.txt, _helpers.tpl,
 .github/workflows/test-chart.yml, network-policy/allow-metrics-traffic.yaml, and
 network-policy/allow-webhook-traffic.yaml.
 All other template files in templates/ are always regenerated to match your current
-kustomize output. Use --force to regenerate all files except Chart.yaml.
+kustomize output. Use --force to also regenerate the preserved files except Chart.yaml: manual edits
+are merged with the pristine output stored under .kubebuilder/pristine/, and conflicts are left between markers.
 
 The generated chart structure mirrors your config/ directory:
 <output>/chart/
@@ -118,6 +122,8 @@ func (p *editSubcommand) InjectConfig(c config.Config) error {
 }
 
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
+	p.fs = fs
+
 	// If using default manifests file, ensure it exists by running make build-installer
 	if p.manifestsFile == DefaultManifestsFile {
 		if err := p.ensureManifestsExist(); err != nil {
@@ -213,7 +219,7 @@ func (p *editSubcommand) PostScaffold() error {
 
 	if hasWebhooks {
 		workflowFile := filepath.Join(".github", "workflows", "test-chart.yml")
-		if _, err := os.Stat(workflowFile); err != nil {
+		if _, err := p.fs.FS.Stat(workflowFile); err != nil {
 			slog.Info(
 				"Workflow file not found, unable to uncomment cert-manager installation",
 				"error", err,
@@ -232,14 +238,15 @@ func (p *editSubcommand) PostScaffold() error {
 #            --set crds.enabled=true \
 #            --wait \
 #            --timeout 300s`
-		if err := util.UncommentCode(workflowFile, target, "#"); err != nil {
-			hasUncommented, errCheck := util.HasFileContentWith(workflowFile, "- name: Install cert-manager via Helm")
+		editor := util.NewFileEditor(p.fs)
+		if err := editor.UncommentCode(workflowFile, target, "#"); err != nil {
+			hasUncommented, errCheck := editor.HasFileContentWith(workflowFile, "- name: Install cert-manager via Helm")
 			if !hasUncommented || errCheck != nil {
 				slog.Warn("Failed to uncomment cert-manager installation in workflow file", "error", err, "file", workflowFile)
 			}
 		} else {
 			target = `# TODO: Uncomment if cert-manager is enabled`
-			_ = util.ReplaceInFile(workflowFile, target, "")
+			_ = editor.ReplaceInFile(workflowFile, target, "")
 		}
 	}
 	return nil
@@ -247,7 +254,7 @@ func (p *editSubcommand) PostScaffold() error {
 
 func (p *editSubcommand) addHelmMakefileTargets(namespace string) error {
 	makefilePath := "Makefile"
-	if _, err := os.Stat(makefilePath); os.IsNotExist(err) {
+	if _, err := p.fs.FS.Stat(makefilePath); os.IsNotExist(err) {
 		return fmt.Errorf("makefile not found")
 	}
 
@@ -255,7 +262,7 @@ func (p *editSubcommand) addHelmMakefileTargets(namespace string) error {
 	helmTargets := getHelmMakefileTargets(p.config.GetProjectName(), namespace, p.outputDir)
 
 	// Append the targets if they don't already exist
-	if err := util.AppendCodeIfNotExist(makefilePath, helmTargets); err != nil {
+	if err := util.NewFileEditor(p.fs).AppendCodeIfNotExist(makefilePath, helmTargets); err != nil {
 		return fmt.Errorf("failed to append Helm targets to Makefile: %w", err)
 	}
 
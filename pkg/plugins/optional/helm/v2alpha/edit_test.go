@@ -64,6 +64,7 @@ version: "3"
 		// Create edit subcommand
 		editCmd = &editSubcommand{
 			config: cfg,
+			fs:     fs,
 		}
 	})
 
@@ -282,6 +283,7 @@ jobs:
 			Expect(err).NotTo(HaveOccurred())
 
 			editCmd.outputDir = common.DefaultOutputDir
+			editCmd.fs = machinery.Filesystem{FS: afero.NewOsFs()}
 		})
 
 		AfterEach(func() {
@@ -31,6 +31,7 @@ const (
 	KindRoleBinding        = "RoleBinding"
 	KindClusterRoleBinding = "ClusterRoleBinding"
 	KindServiceMonitor     = "ServiceMonitor"
+	KindPrometheusRule     = "PrometheusRule"
 	KindIssuer             = "Issuer"
 	KindValidatingWebhook  = "ValidatingWebhookConfiguration"
 	KindMutatingWebhook    = "MutatingWebhookConfiguration"
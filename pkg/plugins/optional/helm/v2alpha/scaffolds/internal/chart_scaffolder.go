@@ -19,8 +19,11 @@ package internal
 import (
 	"fmt"
 	"log/slog"
+	"os"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/helm/v2alpha/scaffolds/internal/extractor"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/helm/v2alpha/scaffolds/internal/kustomize"
@@ -29,6 +32,9 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/helm/v2alpha/scaffolds/internal/templates/github"
 )
 
+// prometheusRulesPath is the PrometheusRule scaffolded under config/prometheus by the Grafana plugin.
+const prometheusRulesPath = "config/prometheus/rules.yaml"
+
 // ChartScaffolderConfig contains configuration for Helm chart generation.
 type ChartScaffolderConfig struct {
 	ProjectName   string
@@ -109,6 +115,17 @@ func (s *ChartScaffolder) PrepareTemplates(_ machinery.Filesystem) ([]machinery.
 		return nil, fmt.Errorf("unable to generate the chart: %w", err)
 	}
 
+	// Add the PrometheusRule scaffolded under config/prometheus when the kustomize output doesn't provide one,
+	// as config/default does not enable the prometheus kustomization by default
+	if len(resources.PrometheusRules) == 0 {
+		rules, errRules := loadPrometheusRules(
+			prometheusRulesPath, extraction.Metadata.DetectedPrefix, extraction.Metadata.ManagerNamespace)
+		if errRules != nil {
+			return nil, fmt.Errorf("unable to generate the chart: %w", errRules)
+		}
+		resources.PrometheusRules = rules
+	}
+
 	chartConverter := kustomize.NewChartConverter(
 		resources,
 		extraction.Metadata.DetectedPrefix,
@@ -179,3 +196,23 @@ func (s *ChartScaffolder) PrepareTemplates(_ machinery.Filesystem) ([]machinery.
 
 	return builders, nil
 }
+
+// loadPrometheusRules parses the PrometheusRules of the provided file, if it exists. Their name and namespace are
+// set as kustomize would do for the manager, so that they are templated like the rest of the kustomize output.
+func loadPrometheusRules(path, namePrefix, namespace string) ([]*unstructured.Unstructured, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	resources, err := kustomize.NewParser(path).Parse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for _, rule := range resources.PrometheusRules {
+		rule.SetName(namePrefix + "-" + rule.GetName())
+		rule.SetNamespace(namespace)
+	}
+
+	return resources.PrometheusRules, nil
+}
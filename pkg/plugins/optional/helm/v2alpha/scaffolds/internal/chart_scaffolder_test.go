@@ -204,6 +204,29 @@ var _ = Describe("ChartScaffolder", func() {
 			Expect(string(values)).To(ContainSubstring("networkPolicy:\n  enabled: false"))
 		})
 
+		It("should add the PrometheusRule from config/prometheus when the kustomize output lacks one", func() {
+			projectDir := GinkgoT().TempDir()
+			GinkgoT().Chdir(projectDir)
+			Expect(os.MkdirAll(filepath.Join("config", "prometheus"), 0o755)).To(Succeed())
+			Expect(os.WriteFile(prometheusRulesPath, []byte(prometheusRules), 0o600)).To(Succeed())
+
+			manifestsPath := filepath.Join(projectDir, "install.yaml")
+			Expect(os.WriteFile(manifestsPath, []byte(manifestsWithoutNetworkPolicy), 0o600)).To(Succeed())
+
+			fs := executeChartScaffolder(manifestsPath)
+
+			content, err := afero.ReadFile(fs, "dist/chart/templates/prometheus/controller-manager-rules.yaml")
+			Expect(err).NotTo(HaveOccurred())
+
+			rendered := string(content)
+			Expect(rendered).To(HavePrefix("{{- if .Values.prometheus.enabled }}"))
+			Expect(rendered).To(ContainSubstring(
+				`name: {{ include "test-project.resourceName" (dict "suffix" "controller-manager-rules" "context" $) }}`))
+			Expect(rendered).To(ContainSubstring("namespace: {{ .Release.Namespace }}"))
+			Expect(rendered).To(ContainSubstring(`job="{{ include "test-project.resourceName" ` +
+				`(dict "suffix" "controller-manager-metrics-service" "context" $) }}"`))
+		})
+
 		It("should error when no Deployment is found in the kustomize output", func() {
 			manifestsPath := filepath.Join(GinkgoT().TempDir(), "install.yaml")
 			Expect(os.WriteFile(manifestsPath, []byte(manifestsWithNoDeployment), 0o600)).To(Succeed())
@@ -469,3 +492,18 @@ spec:
       - name: worker
         image: worker:latest
 `
+
+const prometheusRules = `# Prometheus Rules (Recording & Alerting)
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: controller-manager-rules
+  namespace: system
+spec:
+  groups:
+  - name: test-project-recording-rules
+    rules:
+    - record: name:workqueue_depth:sum
+      expr: |-
+        sum by (name) (workqueue_depth{job="test-project-controller-manager-metrics-service"})
+`
@@ -146,7 +146,12 @@ func (c *ResourceCategorizer) collectMetricsResources() []*unstructured.Unstruct
 
 // collectPrometheusResources gathers prometheus related resources.
 func (c *ResourceCategorizer) collectPrometheusResources() []*unstructured.Unstructured {
-	return c.resources.ServiceMonitors
+	var prometheusResources []*unstructured.Unstructured
+
+	prometheusResources = append(prometheusResources, c.resources.ServiceMonitors...)
+	prometheusResources = append(prometheusResources, c.resources.PrometheusRules...)
+
+	return prometheusResources
 }
 
 // collectNetworkPolicyResources gathers network policy related resources.
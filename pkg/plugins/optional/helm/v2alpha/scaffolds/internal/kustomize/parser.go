@@ -55,6 +55,7 @@ type ParsedResources struct {
 
 	// Monitoring resources
 	ServiceMonitors []*unstructured.Unstructured
+	PrometheusRules []*unstructured.Unstructured
 
 	// Network policy resources
 	NetworkPolicies []*unstructured.Unstructured
@@ -102,6 +103,7 @@ func (p *Parser) ParseFromReader(reader io.Reader) (*ParsedResources, error) {
 		Certificates:              make([]*unstructured.Unstructured, 0),
 		WebhookConfigurations:     make([]*unstructured.Unstructured, 0),
 		ServiceMonitors:           make([]*unstructured.Unstructured, 0),
+		PrometheusRules:           make([]*unstructured.Unstructured, 0),
 		NetworkPolicies:           make([]*unstructured.Unstructured, 0),
 		CustomResources:           make([]*unstructured.Unstructured, 0),
 		Other:                     make([]*unstructured.Unstructured, 0),
@@ -171,6 +173,8 @@ func (p *Parser) categorizeResource(obj *unstructured.Unstructured, resources *P
 		resources.WebhookConfigurations = append(resources.WebhookConfigurations, obj)
 	case kind == "ServiceMonitor" && apiVersion == "monitoring.coreos.com/v1":
 		resources.ServiceMonitors = append(resources.ServiceMonitors, obj)
+	case kind == "PrometheusRule" && apiVersion == "monitoring.coreos.com/v1":
+		resources.PrometheusRules = append(resources.PrometheusRules, obj)
 	case kind == "NetworkPolicy" && apiVersion == "networking.k8s.io/v1":
 		resources.NetworkPolicies = append(resources.NetworkPolicies, obj)
 	default:
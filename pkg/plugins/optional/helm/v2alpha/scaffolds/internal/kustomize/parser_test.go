@@ -214,6 +214,39 @@ spec:
 		})
 	})
 
+	Context("with PrometheusRule", func() {
+		BeforeEach(func() {
+			yamlContent := `---
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: controller-manager-rules
+  namespace: test-system
+spec:
+  groups:
+  - name: test-recording-rules
+    rules:
+    - record: name:workqueue_depth:sum
+      expr: sum by (name) (workqueue_depth)
+`
+			err := os.WriteFile(tempFile, []byte(yamlContent), 0o600)
+			Expect(err).NotTo(HaveOccurred())
+
+			parser = NewParser(tempFile)
+		})
+
+		It("should parse PrometheusRule", func() {
+			resources, err := parser.Parse()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources.PrometheusRules).To(HaveLen(1))
+			Expect(resources.Other).To(BeEmpty())
+
+			rule := resources.PrometheusRules[0]
+			Expect(rule.GetKind()).To(Equal("PrometheusRule"))
+		})
+	})
+
 	Context("with NetworkPolicy", func() {
 		BeforeEach(func() {
 			yamlContent := `---
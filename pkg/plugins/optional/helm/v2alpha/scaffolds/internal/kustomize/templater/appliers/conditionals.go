@@ -44,7 +44,8 @@ func AddConditionalWrappers(yamlContent string, resource *unstructured.Unstructu
 		return HandleCertificateConditionalWrappers(yamlContent, name)
 	case kind == common.KindIssuer && apiVersion == common.APIVersionCertManager:
 		return fmt.Sprintf("{{- if .Values.certManager.enabled }}\n%s\n{{- end }}", yamlContent)
-	case kind == common.KindServiceMonitor && apiVersion == common.APIVersionMonitoring:
+	case (kind == common.KindServiceMonitor || kind == common.KindPrometheusRule) &&
+		apiVersion == common.APIVersionMonitoring:
 		// CRITICAL: newline before {{- end }} prevents whitespace chomping from eating content
 		return fmt.Sprintf("{{- if .Values.prometheus.enabled }}\n%s\n{{- end }}", yamlContent)
 	case kind == common.KindNetworkPolicy && apiVersion == common.APIVersionNetworking:
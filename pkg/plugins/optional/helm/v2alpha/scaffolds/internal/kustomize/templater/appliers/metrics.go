@@ -39,6 +39,13 @@ func TemplateServiceMonitor(yamlContent string) string {
 	return yamlContent
 }
 
+// TemplatePrometheusRule templates the metrics Service name that the rule expressions select as the job of the
+// series, since it changes with the release name.
+func TemplatePrometheusRule(detectedPrefix, chartName, yamlContent string) string {
+	const suffix = "controller-manager-metrics-service"
+	return strings.ReplaceAll(yamlContent, detectedPrefix+"-"+suffix, ResourceNameTemplate(chartName, suffix))
+}
+
 // MakeServiceMonitorTLSConditional wraps ServiceMonitor tlsConfig fields with appropriate conditionals.
 // Adds metrics.secure wrapper and cert-manager conditionals around cert fields when found.
 func MakeServiceMonitorTLSConditional(yamlContent string) string {
@@ -93,6 +93,9 @@ func (t *Templater) ApplyHelmSubstitutions(yamlContent string, resource *unstruc
 	if resource.GetKind() == common.KindServiceMonitor {
 		yamlContent = appliers.TemplateServiceMonitor(yamlContent)
 	}
+	if resource.GetKind() == common.KindPrometheusRule {
+		yamlContent = appliers.TemplatePrometheusRule(t.detectedPrefix, t.chartName, yamlContent)
+	}
 	yamlContent = appliers.CollapseBlankLineAfterIf(yamlContent)
 
 	return yamlContent
@@ -566,6 +566,33 @@ metadata:
 			Expect(result).To(ContainSubstring("{{- end }}"))
 		})
 
+		It("should add prometheus conditional and template the metrics job for PrometheusRule resources", func() {
+			prometheusRuleResource := &unstructured.Unstructured{}
+			prometheusRuleResource.SetAPIVersion("monitoring.coreos.com/v1")
+			prometheusRuleResource.SetKind("PrometheusRule")
+			prometheusRuleResource.SetName("test-project-controller-manager-rules")
+
+			content := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test-project-controller-manager-rules
+spec:
+  groups:
+  - name: alerting-rules
+    rules:
+    - alert: WorkqueueDepthGrowing
+      expr: sum by (name) (workqueue_depth{job="test-project-controller-manager-metrics-service"}) > 100
+      annotations:
+        summary: 'Workqueue {{ $labels.name }} keeps growing'`
+
+			result := templater.ApplyHelmSubstitutions(content, prometheusRuleResource)
+
+			Expect(result).To(HavePrefix("{{- if .Values.prometheus.enabled }}"))
+			Expect(result).To(ContainSubstring(`workqueue_depth{job="{{ include "test-project.resourceName" ` +
+				`(dict "suffix" "controller-manager-metrics-service" "context" $) }}"}`))
+			Expect(result).To(ContainSubstring(`{{ "{{ $labels.name }}" }}`))
+		})
+
 		It("should add networkPolicy conditional for NetworkPolicy resources", func() {
 			networkPolicyResource := &unstructured.Unstructured{}
 			networkPolicyResource.SetAPIVersion("networking.k8s.io/v1")
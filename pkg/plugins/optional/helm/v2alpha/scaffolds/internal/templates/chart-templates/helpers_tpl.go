@@ -30,6 +30,7 @@ var _ machinery.Template = &HelmHelpers{}
 type HelmHelpers struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	// OutputDir specifies the output directory for the chart
 	OutputDir string
@@ -50,7 +51,7 @@ func (f *HelmHelpers) SetTemplateDefaults() error {
 	f.TemplateBody = f.generateHelpersTemplate()
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
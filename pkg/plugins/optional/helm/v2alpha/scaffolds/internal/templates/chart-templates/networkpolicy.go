@@ -30,6 +30,7 @@ var _ machinery.Template = &NetworkPolicy{}
 type NetworkPolicy struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	// Webhook generates the webhook ingress policy instead of the metrics ingress policy.
 	Webhook bool
@@ -61,7 +62,7 @@ func (f *NetworkPolicy) SetTemplateDefaults() error {
 	}
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
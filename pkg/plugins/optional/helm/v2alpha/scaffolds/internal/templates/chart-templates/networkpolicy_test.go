@@ -52,10 +52,10 @@ var _ = Describe("NetworkPolicy", func() {
 			Expect(networkPolicy.Path).To(Equal("dist/chart/templates/network-policy/allow-metrics-traffic.yaml"))
 		})
 
-		It("should set OverwriteFile action when Force is true", func() {
+		It("should set MergeFile action when Force is true", func() {
 			err := networkPolicy.SetTemplateDefaults()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(networkPolicy.IfExistsAction).To(Equal(machinery.OverwriteFile))
+			Expect(networkPolicy.IfExistsAction).To(Equal(machinery.MergeFile))
 		})
 
 		It("should set SkipFile action when Force is false", func() {
@@ -29,6 +29,7 @@ var _ machinery.Template = &Notes{}
 type Notes struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	// OutputDir specifies the output directory for the chart
 	OutputDir string
@@ -49,7 +50,7 @@ func (f *Notes) SetTemplateDefaults() error {
 	f.TemplateBody = f.generateNotesTemplate()
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
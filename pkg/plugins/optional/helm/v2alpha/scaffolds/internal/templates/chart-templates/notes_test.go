@@ -50,11 +50,11 @@ var _ = Describe("Notes", func() {
 			Expect(notes.Path).To(Equal("dist/chart/templates/NOTES.txt"))
 		})
 
-		It("should set OverwriteFile action when Force is true", func() {
+		It("should set MergeFile action when Force is true", func() {
 			notes.Force = true
 			err := notes.SetTemplateDefaults()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(notes.IfExistsAction).To(Equal(machinery.OverwriteFile))
+			Expect(notes.IfExistsAction).To(Equal(machinery.MergeFile))
 		})
 
 		It("should set SkipFile action when Force is false", func() {
@@ -28,6 +28,7 @@ var _ machinery.Template = &HelmChartCI{}
 type HelmChartCI struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	// Force if true allows overwriting the scaffolded file
 	Force bool
@@ -42,7 +43,7 @@ func (f *HelmChartCI) SetTemplateDefaults() error {
 	f.TemplateBody = testChartTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
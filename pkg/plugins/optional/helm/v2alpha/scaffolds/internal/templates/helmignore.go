@@ -28,6 +28,7 @@ var _ machinery.Template = &HelmIgnore{}
 // HelmIgnore scaffolds a file that defines the .helmignore for Helm packaging
 type HelmIgnore struct {
 	machinery.TemplateMixin
+	machinery.MergeableMixin
 
 	// OutputDir specifies the output directory for the chart
 	OutputDir string
@@ -48,7 +49,7 @@ func (f *HelmIgnore) SetTemplateDefaults() error {
 	f.TemplateBody = helmIgnoreTemplate
 
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	} else {
 		f.IfExistsAction = machinery.SkipFile
 	}
@@ -37,6 +37,7 @@ var _ machinery.Template = &HelmValues{}
 type HelmValues struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.MergeableMixin
 
 	// Extraction contains all extracted information from parsed resources
 	Extraction *extractor.Extraction
@@ -60,7 +61,7 @@ func (f *HelmValues) SetTemplateDefaults() error {
 
 	f.IfExistsAction = machinery.SkipFile
 	if f.Force {
-		f.IfExistsAction = machinery.OverwriteFile
+		f.IfExistsAction = machinery.MergeFile
 	}
 
 	return nil